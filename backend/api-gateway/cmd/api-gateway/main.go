@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/diploma/api-gateway/internal/auth"
+	"github.com/diploma/api-gateway/internal/calendar"
+	"github.com/diploma/api-gateway/internal/client"
+	"github.com/diploma/api-gateway/internal/config"
+	"github.com/diploma/api-gateway/internal/handler"
+	"github.com/diploma/api-gateway/internal/middleware"
+	"github.com/diploma/authz"
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	"github.com/joho/godotenv"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func main() {
+	_ = godotenv.Load()
+
+	cfg := config.Load()
+
+	authClient, err := client.NewAuthClient(cfg.AuthServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create auth client: %v", err)
+	}
+	defer authClient.Close()
+
+	venueClient, err := client.NewVenueClient(cfg.VenueServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create venue client: %v", err)
+	}
+	defer venueClient.Close()
+
+	reservationClient, err := client.NewReservationClient(cfg.ReservationServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create reservation client: %v", err)
+	}
+	defer reservationClient.Close()
+
+	sessionClient, err := client.NewSessionClient(cfg.SessionServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create session client: %v", err)
+	}
+	defer sessionClient.Close()
+
+	paymentClient, err := client.NewPaymentClient(cfg.PaymentServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create payment client: %v", err)
+	}
+	defer paymentClient.Close()
+
+	notificationClient, err := client.NewNotificationClient(cfg.NotificationServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create notification client: %v", err)
+	}
+	defer notificationClient.Close()
+
+	revocations := auth.NewRevocationList(cfg.JWT.MaxTokenAge)
+	verifier := auth.NewCachingVerifier(
+		authz.NewJWKSVerifier(authClient, cfg.JWT.Issuer, cfg.JWT.KeyCacheTTL),
+		revocations,
+		cfg.JWT.TokenCacheSize,
+	)
+
+	subscriberCtx, stopSubscriber := context.WithCancel(context.Background())
+	defer stopSubscriber()
+
+	// Keep retrying in the background: refusing to start would take the API down with NATS, and
+	// giving up would leave revocations unhonoured until the next restart
+	natsConn, err := nats.Connect(cfg.NATSURL, nats.MaxReconnects(-1), nats.RetryOnFailedConnect(true))
+	if err != nil {
+		log.Fatalf("Failed to connect to NATS: %v", err)
+	}
+	defer natsConn.Close()
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		log.Fatalf("Failed to create JetStream context: %v", err)
+	}
+	go auth.NewRevocationSubscriber(js, revocations).Run(subscriberCtx)
+
+	authMiddleware := middleware.NewAuthMiddleware(verifier)
+
+	authHandler := handler.NewAuthHandler(authClient)
+	venueHandler := handler.NewVenueHandler(venueClient)
+	reservationHandler := handler.NewReservationHandler(reservationClient)
+	sessionHandler := handler.NewSessionHandler(sessionClient)
+	paymentHandler := handler.NewPaymentHandler(paymentClient)
+	notificationHandler := handler.NewNotificationHandler(notificationClient)
+	if cfg.JWT.ServiceKey == "" {
+		log.Println("INTERNAL_SERVICE_KEY is not set; calendar feeds will be unable to read reservations and sessions")
+	}
+	calendarHandler := handler.NewCalendarHandler(
+		calendar.NewFeedTokens(calendarFeedSecret(cfg)),
+		authClient,
+		calendar.NewFeedBuilder(reservationClient, sessionClient, venueClient),
+		cfg.PublicURL,
+		cfg.JWT.ServiceKey,
+	)
+
+	r := chi.NewRouter()
+	r.Use(chimiddleware.RequestID)
+	r.Use(chimiddleware.Logger)
+	r.Use(chimiddleware.Recoverer)
+	r.Use(cors.Handler(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type"},
+		MaxAge:         300,
+	}))
+
+	r.Get("/.well-known/jwks.json", authHandler.JWKS)
+
+	r.Route("/api/v1", func(r chi.Router) {
+		r.Post("/auth/register", authHandler.Register)
+		r.Post("/auth/login", authHandler.Login)
+		r.Post("/auth/refresh", authHandler.RefreshToken)
+		r.Post("/auth/logout", authHandler.Logout)
+		r.Post("/auth/verify-email", authHandler.VerifyEmail)
+		r.Post("/auth/password-reset/request", authHandler.RequestPasswordReset)
+		r.Post("/auth/password-reset", authHandler.ResetPassword)
+
+		r.Get("/venues", venueHandler.ListVenues)
+		r.Get("/venues/{id}", venueHandler.GetVenue)
+		r.Get("/venues/{id}/resources", venueHandler.ListResources)
+		r.Get("/venues/{id}/resources/{rid}/availability", venueHandler.GetResourceAvailability)
+
+		r.Get("/sessions/open", sessionHandler.ListOpenSessions)
+
+		r.Get("/users/me/calendar.ics", calendarHandler.GetFeed)
+
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.Authenticate)
+
+			r.Get("/profile", authHandler.GetProfile)
+			r.Post("/auth/logout-all", authHandler.LogoutAllDevices)
+			r.Post("/auth/verify-email/request", authHandler.RequestEmailVerification)
+			r.Put("/admin/users/{id}/role", authHandler.SetUserRole)
+
+			r.Post("/reservations", reservationHandler.CreateReservation)
+			r.Get("/reservations", reservationHandler.ListMyReservations)
+			r.Get("/reservations/{id}", reservationHandler.GetReservation)
+			r.Delete("/reservations/{id}", reservationHandler.CancelReservation)
+
+			r.With(middleware.RequireVerifiedEmail).Post("/sessions", sessionHandler.CreateSession)
+			r.Post("/sessions/{id}/join", sessionHandler.JoinSession)
+			r.Delete("/sessions/{id}", sessionHandler.CancelSession)
+
+			r.With(middleware.RequireVerifiedEmail).Post("/sessions/{id}/pay", paymentHandler.StartPayment)
+			r.Get("/sessions/{id}/payments", paymentHandler.GetPaymentsBySession)
+
+			r.Get("/notifications", notificationHandler.ListNotifications)
+			r.Post("/notifications/read", notificationHandler.MarkRead)
+			r.Get("/notifications/preferences", notificationHandler.GetPreferences)
+			r.Put("/notifications/preferences", notificationHandler.UpdatePreferences)
+
+			r.Get("/users/me/calendar", calendarHandler.GetFeedLink)
+			r.Post("/users/me/calendar/regenerate", calendarHandler.RegenerateFeedLink)
+		})
+	})
+
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%s", cfg.Port),
+		Handler:      r,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+	}
+
+	log.Printf("Starting API gateway on %s", srv.Addr)
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to serve: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down server...")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Server shutdown error: %v", err)
+	}
+	log.Println("Server stopped")
+}
+
+// calendarFeedSecret falls back to a random secret outside production, which only means feed URLs stop working on restart
+func calendarFeedSecret(cfg *config.Config) string {
+	if cfg.CalendarFeedSecret != "" {
+		return cfg.CalendarFeedSecret
+	}
+	if cfg.Environment == "production" {
+		log.Fatal("CALENDAR_FEED_SECRET must be set in production")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("Failed to generate calendar feed secret: %v", err)
+	}
+	log.Println("CALENDAR_FEED_SECRET is not set; calendar feed URLs will not survive a restart")
+	return hex.EncodeToString(secret)
+}
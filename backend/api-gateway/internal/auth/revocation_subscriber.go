@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const resubscribeInterval = 5 * time.Second
+
+// RevocationSubscriber feeds user.tokens_revoked events into the revocation list. Every gateway
+// instance needs every event, so it reads through its own ordered consumer instead of a shared
+// durable one, replaying the retention window on start so a restart does not forget recent revocations.
+type RevocationSubscriber struct {
+	js          jetstream.JetStream
+	revocations *RevocationList
+}
+
+func NewRevocationSubscriber(js jetstream.JetStream, revocations *RevocationList) *RevocationSubscriber {
+	return &RevocationSubscriber{
+		js:          js,
+		revocations: revocations,
+	}
+}
+
+// Run keeps trying to subscribe until ctx is done; NATS may be unreachable or auth-svc may not have
+// created its stream yet when the gateway starts
+func (s *RevocationSubscriber) Run(ctx context.Context) {
+	for {
+		consumeCtx, err := s.subscribe(ctx)
+		if err == nil {
+			log.Printf("Consuming %s events", sharedevents.SubjectUserTokensRevoked)
+			<-ctx.Done()
+			consumeCtx.Stop()
+			return
+		}
+		log.Printf("Failed to subscribe to token revocations, retrying in %v: %v", resubscribeInterval, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resubscribeInterval):
+		}
+	}
+}
+
+func (s *RevocationSubscriber) subscribe(ctx context.Context) (jetstream.ConsumeContext, error) {
+	// The stream belongs to auth-svc, which creates it with the retention the events need
+	stream, err := s.js.Stream(ctx, sharedevents.StreamUsers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up stream %s: %w", sharedevents.StreamUsers, err)
+	}
+
+	since := time.Now().Add(-s.revocations.retention)
+	consumer, err := stream.OrderedConsumer(ctx, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{sharedevents.SubjectUserTokensRevoked},
+		DeliverPolicy:  jetstream.DeliverByStartTimePolicy,
+		OptStartTime:   &since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	return consumer.Consume(func(msg jetstream.Msg) {
+		if err := s.Handle(msg.Data()); err != nil {
+			log.Printf("Dropping malformed %s event: %v", msg.Subject(), err)
+		}
+	})
+}
+
+func (s *RevocationSubscriber) Handle(data []byte) error {
+	envelope, err := sharedevents.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	var payload sharedevents.UserTokensRevoked
+	if err := envelope.UnmarshalPayload(&payload); err != nil {
+		return err
+	}
+	if payload.UserID == "" || payload.RevokedBefore.IsZero() {
+		return errors.New("revocation without user or cut-off")
+	}
+
+	s.revocations.Revoke(payload.UserID, payload.RevokedBefore)
+	return nil
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/diploma/authz"
+)
+
+// RevocationList remembers, per user, the moment before which their access tokens are no longer accepted
+type RevocationList struct {
+	retention time.Duration
+
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+// NewRevocationList forgets a revocation once retention has passed, by which time every token it covers has expired
+func NewRevocationList(retention time.Duration) *RevocationList {
+	return &RevocationList{
+		retention: retention,
+		revoked:   map[string]time.Time{},
+	}
+}
+
+func (l *RevocationList) Revoke(userID string, before time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Events can be replayed out of order; the latest cut-off wins
+	if current, ok := l.revoked[userID]; !ok || before.After(current) {
+		l.revoked[userID] = before
+	}
+	l.pruneLocked(time.Now())
+}
+
+// IsRevoked compares at the one-second resolution of the iat claim, so a token issued in the
+// same second as the revocation, e.g. by the refresh that follows a role change, stays valid
+func (l *RevocationList) IsRevoked(claims *authz.Claims) bool {
+	l.mu.RLock()
+	before, ok := l.revoked[claims.UserID]
+	l.mu.RUnlock()
+	if !ok {
+		return false
+	}
+
+	if claims.IssuedAt == nil {
+		return true
+	}
+	return claims.IssuedAt.Time.Before(before.Truncate(time.Second))
+}
+
+func (l *RevocationList) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.revoked)
+}
+
+func (l *RevocationList) pruneLocked(now time.Time) {
+	for userID, before := range l.revoked {
+		if now.Sub(before) > l.retention {
+			delete(l.revoked, userID)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/diploma/authz"
+)
+
+var ErrTokenRevoked = errors.New("token revoked")
+
+// CachingVerifier verifies access tokens locally and remembers each verified token for the rest of
+// its lifetime, so a hot token costs one map lookup. Revocations are checked on every call, cached or not.
+type CachingVerifier struct {
+	verifier    authz.Verifier
+	revocations *RevocationList
+	maxEntries  int
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*authz.Claims
+}
+
+func NewCachingVerifier(verifier authz.Verifier, revocations *RevocationList, maxEntries int) *CachingVerifier {
+	return &CachingVerifier{
+		verifier:    verifier,
+		revocations: revocations,
+		maxEntries:  maxEntries,
+		entries:     map[[sha256.Size]byte]*authz.Claims{},
+	}
+}
+
+func (v *CachingVerifier) Verify(token string) (*authz.Claims, error) {
+	claims, err := v.verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.revocations.IsRevoked(claims) {
+		return nil, fmt.Errorf("%w: %w", authz.ErrInvalidToken, ErrTokenRevoked)
+	}
+	return claims, nil
+}
+
+func (v *CachingVerifier) verify(token string) (*authz.Claims, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+
+	v.mu.Lock()
+	claims, ok := v.entries[key]
+	if ok && !claims.ExpiresAt.Time.After(now) {
+		delete(v.entries, key)
+		ok = false
+	}
+	v.mu.Unlock()
+	if ok {
+		return claims, nil
+	}
+
+	claims, err := v.verifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(v.entries) >= v.maxEntries {
+		v.evictExpiredLocked(now)
+	}
+	// Still full of live tokens: verify this one again next time rather than grow without bound
+	if len(v.entries) < v.maxEntries {
+		v.entries[key] = claims
+	}
+
+	return claims, nil
+}
+
+func (v *CachingVerifier) evictExpiredLocked(now time.Time) {
+	for key, claims := range v.entries {
+		if !claims.ExpiresAt.Time.After(now) {
+			delete(v.entries, key)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package calendar
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	reservationv1 "github.com/diploma/api-gateway/api/proto/reservation/v1"
+	sessionv1 "github.com/diploma/api-gateway/api/proto/session/v1"
+	venuev1 "github.com/diploma/api-gateway/api/proto/venue/v1"
+	"github.com/diploma/ical"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// History keeps recently played games in the feed; anything older is dropped
+	History         = 30 * 24 * time.Hour
+	RefreshInterval = time.Hour
+
+	sessionPageSize = 100
+	maxSessionPages = 10
+)
+
+type ReservationSource interface {
+	ListReservationsByUser(ctx context.Context, req *reservationv1.ListReservationsByUserRequest) (*reservationv1.ListReservationsByUserResponse, error)
+	GetReservation(ctx context.Context, req *reservationv1.GetReservationRequest) (*reservationv1.GetReservationResponse, error)
+}
+
+type SessionSource interface {
+	ListUserSessions(ctx context.Context, req *sessionv1.ListUserSessionsRequest) (*sessionv1.ListUserSessionsResponse, error)
+}
+
+type VenueSource interface {
+	GetResource(ctx context.Context, req *venuev1.GetResourceRequest) (*venuev1.GetResourceResponse, error)
+	GetVenue(ctx context.Context, req *venuev1.GetVenueRequest) (*venuev1.GetVenueResponse, error)
+}
+
+// FeedBuilder assembles a user's reservations and joined sessions into one subscribable calendar
+type FeedBuilder struct {
+	reservations ReservationSource
+	sessions     SessionSource
+	venues       VenueSource
+}
+
+func NewFeedBuilder(reservations ReservationSource, sessions SessionSource, venues VenueSource) *FeedBuilder {
+	return &FeedBuilder{
+		reservations: reservations,
+		sessions:     sessions,
+		venues:       venues,
+	}
+}
+
+type place struct {
+	resourceName string
+	location     string
+	geo          *ical.Geo
+}
+
+// feedRequest caches venue lookups for the duration of one feed build
+type feedRequest struct {
+	builder *FeedBuilder
+	places  map[string]*place
+	cutoff  time.Time
+	now     time.Time // Also the SEQUENCE of every event, so a refresh supersedes invites received earlier
+}
+
+func (b *FeedBuilder) Build(ctx context.Context, userID string, now time.Time) (*ical.Calendar, error) {
+	req := &feedRequest{
+		builder: b,
+		places:  make(map[string]*place),
+		cutoff:  now.Add(-History),
+		now:     now,
+	}
+
+	sessions, err := req.listSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := b.reservations.ListReservationsByUser(ctx, &reservationv1.ListReservationsByUserRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ical.Event, 0, len(sessions)+len(reservations.Items))
+	bookedForSession := make(map[string]bool, len(sessions))
+	for _, session := range sessions {
+		if session.ReservationId != "" {
+			bookedForSession[session.ReservationId] = true
+		}
+		if event, ok := req.sessionEvent(ctx, session); ok {
+			events = append(events, event)
+		}
+	}
+	for _, reservation := range reservations.Items {
+		// A host's booking shows up once, as the session played on it
+		if bookedForSession[reservation.Id] {
+			continue
+		}
+		if event, ok := req.reservationEvent(ctx, reservation); ok {
+			events = append(events, event)
+		}
+	}
+
+	return &ical.Calendar{
+		Method:          ical.MethodPublish,
+		Name:            "SportsApp",
+		RefreshInterval: RefreshInterval,
+		Stamp:           now,
+		Events:          events,
+	}, nil
+}
+
+func (r *feedRequest) listSessions(ctx context.Context, userID string) ([]*sessionv1.GetSessionResponse, error) {
+	var sessions []*sessionv1.GetSessionResponse
+	for page := int32(1); page <= maxSessionPages; page++ {
+		resp, err := r.builder.sessions.ListUserSessions(ctx, &sessionv1.ListUserSessionsRequest{
+			UserId:   userID,
+			Page:     page,
+			PageSize: sessionPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, resp.Items...)
+		if len(resp.Items) < sessionPageSize || len(sessions) >= int(resp.TotalCount) {
+			break
+		}
+	}
+	return sessions, nil
+}
+
+func (r *feedRequest) sessionEvent(ctx context.Context, session *sessionv1.GetSessionResponse) (ical.Event, bool) {
+	start, end, ok := r.window(session.StartTime, session.EndTime)
+	if !ok {
+		return ical.Event{}, false
+	}
+
+	event := ical.Event{
+		UID:         ical.SessionUID(session.Id),
+		Sequence:    ical.SequenceAt(r.now),
+		Status:      ical.StatusConfirmed,
+		Summary:     sessionName(session.SportType, session.SkillLevel),
+		Description: session.Description,
+		Start:       start,
+		End:         end,
+	}
+	if session.Status == sessionv1.SessionStatus_SESSION_STATUS_CANCELLED {
+		event.Status = ical.StatusCancelled
+	}
+	if updatedAt, err := time.Parse(time.RFC3339, session.UpdatedAt); err == nil {
+		event.LastModified = updatedAt
+	}
+
+	if session.ReservationId != "" {
+		reservation, err := r.builder.reservations.GetReservation(ctx, &reservationv1.GetReservationRequest{ReservationId: session.ReservationId})
+		if err != nil {
+			log.Printf("Calendar feed: no reservation %s for session %s: %v", session.ReservationId, session.Id, err)
+		} else if p := r.place(ctx, reservation.ResourceId); p != nil {
+			event.Location, event.Geo = p.location, p.geo
+		}
+	}
+
+	return event, true
+}
+
+func (r *feedRequest) reservationEvent(ctx context.Context, reservation *reservationv1.GetReservationResponse) (ical.Event, bool) {
+	var eventStatus ical.Status
+	switch reservation.Status {
+	case "CONFIRMED":
+		eventStatus = ical.StatusConfirmed
+	case "PENDING":
+		eventStatus = ical.StatusTentative
+	case "CANCELLED":
+		eventStatus = ical.StatusCancelled
+	default:
+		// Expired bookings were never confirmed, so there is nothing to take back
+		return ical.Event{}, false
+	}
+
+	start, end, ok := r.window(reservation.StartTime, reservation.EndTime)
+	if !ok {
+		return ical.Event{}, false
+	}
+
+	event := ical.Event{
+		UID:         ical.ReservationUID(reservation.Id),
+		Sequence:    ical.SequenceAt(r.now),
+		Status:      eventStatus,
+		Summary:     "Reservation",
+		Description: reservation.Comment,
+		Start:       start,
+		End:         end,
+	}
+	if p := r.place(ctx, reservation.ResourceId); p != nil {
+		event.Summary = p.resourceName
+		event.Location, event.Geo = p.location, p.geo
+	}
+
+	return event, true
+}
+
+// window parses an event's times and reports whether it is recent enough to publish
+func (r *feedRequest) window(startTime, endTime string) (time.Time, time.Time, bool) {
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		end = time.Time{}
+	}
+
+	last := end
+	if last.IsZero() {
+		last = start
+	}
+	return start, end, !last.Before(r.cutoff)
+}
+
+// place resolves where a resource is; a venue that cannot be loaded leaves the event without a location
+func (r *feedRequest) place(ctx context.Context, resourceID string) *place {
+	if p, ok := r.places[resourceID]; ok {
+		return p
+	}
+
+	p, err := r.lookupPlace(ctx, resourceID)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Printf("Calendar feed: failed to load venue for resource %s: %v", resourceID, err)
+		}
+		p = nil
+	}
+	r.places[resourceID] = p
+	return p
+}
+
+func (r *feedRequest) lookupPlace(ctx context.Context, resourceID string) (*place, error) {
+	resource, err := r.builder.venues.GetResource(ctx, &venuev1.GetResourceRequest{ResourceId: resourceID})
+	if err != nil {
+		return nil, err
+	}
+	venue, err := r.builder.venues.GetVenue(ctx, &venuev1.GetVenueRequest{VenueId: resource.VenueId})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &place{
+		resourceName: resource.Name + ", " + venue.Name,
+		location:     joinNonEmpty(", ", venue.Name, venue.Address, venue.City),
+	}
+	if venue.Latitude != 0 || venue.Longitude != 0 {
+		p.geo = &ical.Geo{Latitude: venue.Latitude, Longitude: venue.Longitude}
+	}
+	return p, nil
+}
+
+// sessionName matches the label notification emails use, e.g. "Football (Intermediate)"
+func sessionName(sportType, skillLevel string) string {
+	name := titleCase(sportType)
+	if skillLevel != "" {
+		name += " (" + titleCase(skillLevel) + ")"
+	}
+	return name
+}
+
+func titleCase(value string) string {
+	words := strings.Fields(strings.ToLower(strings.ReplaceAll(value, "_", " ")))
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,84 @@
+package calendar
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	authv1 "github.com/diploma/api-gateway/api/proto/auth/v1"
+)
+
+var ErrInvalidFeedToken = errors.New("invalid calendar feed token")
+
+// FeedVersionSource holds each user's feed version; auth-svc bumps it on password reset, logout-all
+// and "regenerate link", which revokes every URL signed with an older one
+type FeedVersionSource interface {
+	GetCalendarFeedVersion(ctx context.Context, req *authv1.GetCalendarFeedVersionRequest) (*authv1.GetCalendarFeedVersionResponse, error)
+	RotateCalendarFeed(ctx context.Context, req *authv1.RotateCalendarFeedRequest) (*authv1.RotateCalendarFeedResponse, error)
+}
+
+// FeedTokens signs the user ID and feed version into the feed URL, since calendar apps poll without an
+// Authorization header. Version 0 tokens carry no version part, so links issued before versioning keep
+// working until the user's first rotation; rotating the secret invalidates every subscription at once.
+type FeedTokens struct {
+	secret []byte
+}
+
+func NewFeedTokens(secret string) *FeedTokens {
+	return &FeedTokens{secret: []byte(secret)}
+}
+
+func (t *FeedTokens) Issue(userID string, version int) string {
+	encoding := base64.RawURLEncoding
+	token := encoding.EncodeToString([]byte(userID)) + "."
+	if version > 0 {
+		token += strconv.Itoa(version) + "."
+	}
+	return token + encoding.EncodeToString(t.sign(userID, version))
+}
+
+// Verify returns the user and feed version the token was issued for; callers still have to check the
+// version is current
+func (t *FeedTokens) Verify(token string) (string, int, error) {
+	parts := strings.Split(token, ".")
+	version := 0
+	switch len(parts) {
+	case 2:
+	case 3:
+		v, err := strconv.Atoi(parts[1])
+		if err != nil || v <= 0 || parts[1] != strconv.Itoa(v) {
+			return "", 0, ErrInvalidFeedToken
+		}
+		version = v
+	default:
+		return "", 0, ErrInvalidFeedToken
+	}
+
+	userID, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || len(userID) == 0 {
+		return "", 0, ErrInvalidFeedToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[len(parts)-1])
+	if err != nil {
+		return "", 0, ErrInvalidFeedToken
+	}
+
+	if !hmac.Equal(signature, t.sign(string(userID), version)) {
+		return "", 0, ErrInvalidFeedToken
+	}
+	return string(userID), version, nil
+}
+
+func (t *FeedTokens) sign(userID string, version int) []byte {
+	message := "calendar-feed:" + userID
+	if version > 0 {
+		message += ":" + strconv.Itoa(version)
+	}
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	authv1 "github.com/diploma/api-gateway/api/proto/auth/v1"
+	"github.com/diploma/authz"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -14,7 +15,7 @@ type AuthClient struct {
 }
 
 func NewAuthClient(address string) (*AuthClient, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(forwardBearerToken, forwardClientIP))
 	if err != nil {
 		return nil, err
 	}
@@ -33,15 +34,83 @@ func (c *AuthClient) Register(ctx context.Context, req *authv1.RegisterRequest)
 	return c.client.Register(ctx, req)
 }
 
-func (c *AuthClient) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
-	return c.client.Login(ctx, req)
+// Login reports how long a throttled caller must wait in the retry-after trailer
+func (c *AuthClient) Login(ctx context.Context, req *authv1.LoginRequest, opts ...grpc.CallOption) (*authv1.LoginResponse, error) {
+	return c.client.Login(ctx, req, opts...)
 }
 
 func (c *AuthClient) ValidateToken(ctx context.Context, req *authv1.ValidateTokenRequest) (*authv1.ValidateTokenResponse, error) {
 	return c.client.ValidateToken(ctx, req)
 }
 
+func (c *AuthClient) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.RefreshTokenResponse, error) {
+	return c.client.RefreshToken(ctx, req)
+}
+
+func (c *AuthClient) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	return c.client.Logout(ctx, req)
+}
+
+func (c *AuthClient) LogoutAllDevices(ctx context.Context, req *authv1.LogoutAllDevicesRequest) (*authv1.LogoutAllDevicesResponse, error) {
+	return c.client.LogoutAllDevices(ctx, req)
+}
+
 func (c *AuthClient) GetUserProfile(ctx context.Context, req *authv1.GetUserProfileRequest) (*authv1.GetUserProfileResponse, error) {
 	return c.client.GetUserProfile(ctx, req)
 }
 
+
+func (c *AuthClient) SetUserRole(ctx context.Context, req *authv1.SetUserRoleRequest) (*authv1.SetUserRoleResponse, error) {
+	return c.client.SetUserRole(ctx, req)
+}
+
+func (c *AuthClient) RequestEmailVerification(ctx context.Context, req *authv1.RequestEmailVerificationRequest) (*authv1.RequestEmailVerificationResponse, error) {
+	return c.client.RequestEmailVerification(ctx, req)
+}
+
+func (c *AuthClient) VerifyEmail(ctx context.Context, req *authv1.VerifyEmailRequest) (*authv1.VerifyEmailResponse, error) {
+	return c.client.VerifyEmail(ctx, req)
+}
+
+func (c *AuthClient) RequestPasswordReset(ctx context.Context, req *authv1.RequestPasswordResetRequest) (*authv1.RequestPasswordResetResponse, error) {
+	return c.client.RequestPasswordReset(ctx, req)
+}
+
+func (c *AuthClient) ResetPassword(ctx context.Context, req *authv1.ResetPasswordRequest) (*authv1.ResetPasswordResponse, error) {
+	return c.client.ResetPassword(ctx, req)
+}
+
+func (c *AuthClient) GetJWKS(ctx context.Context, req *authv1.GetJWKSRequest) (*authv1.GetJWKSResponse, error) {
+	return c.client.GetJWKS(ctx, req)
+}
+
+// FetchJWKS makes AuthClient the authz.KeySource the gateway verifies access tokens with
+func (c *AuthClient) FetchJWKS(ctx context.Context) (*authz.JWKS, error) {
+	resp, err := c.client.GetJWKS(ctx, &authv1.GetJWKSRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := &authz.JWKS{Keys: make([]authz.JWK, 0, len(resp.Keys))}
+	for _, key := range resp.Keys {
+		jwks.Keys = append(jwks.Keys, authz.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return jwks, nil
+}
+
+func (c *AuthClient) GetCalendarFeedVersion(ctx context.Context, req *authv1.GetCalendarFeedVersionRequest) (*authv1.GetCalendarFeedVersionResponse, error) {
+	return c.client.GetCalendarFeedVersion(ctx, req)
+}
+
+func (c *AuthClient) RotateCalendarFeed(ctx context.Context, req *authv1.RotateCalendarFeedRequest) (*authv1.RotateCalendarFeedResponse, error) {
+	return c.client.RotateCalendarFeed(ctx, req)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type bearerTokenKey struct{}
+
+type clientIPKey struct{}
+
+// WithBearerToken remembers the caller's access token so calls made with ctx carry it to the services
+func WithBearerToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, bearerTokenKey{}, token)
+}
+
+// forwardBearerToken passes the caller's access token on as metadata; each service authorizes it itself
+func forwardBearerToken(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if token, ok := ctx.Value(bearerTokenKey{}).(string); ok && token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// WithClientIP remembers the caller's address for services that throttle by it
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// forwardClientIP passes the caller's address on as x-forwarded-for; behind the gateway the services
+// would otherwise only see the gateway's own address
+func forwardClientIP(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok && ip != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", ip)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+
+	notificationv1 "github.com/diploma/api-gateway/api/proto/notification/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type NotificationClient struct {
+	client notificationv1.NotificationServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewNotificationClient(address string) (*NotificationClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(forwardBearerToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationClient{
+		client: notificationv1.NewNotificationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *NotificationClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *NotificationClient) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	return c.client.ListNotifications(ctx, req)
+}
+
+func (c *NotificationClient) MarkRead(ctx context.Context, req *notificationv1.MarkReadRequest) (*notificationv1.MarkReadResponse, error) {
+	return c.client.MarkRead(ctx, req)
+}
+
+func (c *NotificationClient) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest) (*notificationv1.GetPreferencesResponse, error) {
+	return c.client.GetPreferences(ctx, req)
+}
+
+func (c *NotificationClient) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest) (*notificationv1.UpdatePreferencesResponse, error) {
+	return c.client.UpdatePreferences(ctx, req)
+}
@@ -14,7 +14,7 @@ type PaymentClient struct {
 }
 
 func NewPaymentClient(address string) (*PaymentClient, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(forwardBearerToken))
 	if err != nil {
 		return nil, err
 	}
@@ -14,7 +14,7 @@ type ReservationClient struct {
 }
 
 func NewReservationClient(address string) (*ReservationClient, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(forwardBearerToken))
 	if err != nil {
 		return nil, err
 	}
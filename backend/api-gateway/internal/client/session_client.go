@@ -14,7 +14,7 @@ type SessionClient struct {
 }
 
 func NewSessionClient(address string) (*SessionClient, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(forwardBearerToken))
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +41,10 @@ func (c *SessionClient) ListOpenSessions(ctx context.Context, req *sessionv1.Lis
 	return c.client.ListOpenSessions(ctx, req)
 }
 
+func (c *SessionClient) ListUserSessions(ctx context.Context, req *sessionv1.ListUserSessionsRequest) (*sessionv1.ListUserSessionsResponse, error) {
+	return c.client.ListUserSessions(ctx, req)
+}
+
 func (c *SessionClient) JoinSession(ctx context.Context, req *sessionv1.JoinSessionRequest) (*sessionv1.JoinSessionResponse, error) {
 	return c.client.JoinSession(ctx, req)
 }
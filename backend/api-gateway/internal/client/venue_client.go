@@ -14,7 +14,7 @@ type VenueClient struct {
 }
 
 func NewVenueClient(address string) (*VenueClient, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(forwardBearerToken))
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +41,10 @@ func (c *VenueClient) ListResourcesByVenue(ctx context.Context, req *venuev1.Lis
 	return c.client.ListResourcesByVenue(ctx, req)
 }
 
+func (c *VenueClient) GetResource(ctx context.Context, req *venuev1.GetResourceRequest) (*venuev1.GetResourceResponse, error) {
+	return c.client.GetResource(ctx, req)
+}
+
+func (c *VenueClient) GetResourceAvailability(ctx context.Context, req *venuev1.GetResourceAvailabilityRequest) (*venuev1.GetResourceAvailabilityResponse, error) {
+	return c.client.GetResourceAvailability(ctx, req)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -13,10 +14,25 @@ type Config struct {
 	SessionServiceURL      string
 	PaymentServiceURL      string
 	NotificationServiceURL string
+	PublicURL              string
+	CalendarFeedSecret     string
+	NATSURL                string
+	JWT                    JWTConfig
 	Environment            string
 	LogLevel               string
 }
 
+// JWTConfig controls local verification of access tokens
+type JWTConfig struct {
+	Issuer         string
+	KeyCacheTTL    time.Duration
+	TokenCacheSize int
+	// MaxTokenAge bounds how long a revocation has to be remembered; keep it above auth-svc's access token TTL
+	MaxTokenAge time.Duration
+	// ServiceKey is the shared internal key the calendar feed reads reservations and sessions with
+	ServiceKey string
+}
+
 func Load() *Config {
 	return &Config{
 		Port:                   getEnv("PORT", "8080"),
@@ -26,8 +42,18 @@ func Load() *Config {
 		SessionServiceURL:      getEnv("SESSION_SERVICE_URL", "localhost:50054"),
 		PaymentServiceURL:      getEnv("PAYMENT_SERVICE_URL", "localhost:50055"),
 		NotificationServiceURL: getEnv("NOTIFICATION_SERVICE_URL", "localhost:50056"),
+		PublicURL:              getEnv("PUBLIC_URL", "http://localhost:8080"),
+		CalendarFeedSecret:     getEnv("CALENDAR_FEED_SECRET", ""),
 		Environment:            getEnv("ENVIRONMENT", "development"),
 		LogLevel:               getEnv("LOG_LEVEL", "info"),
+		NATSURL:                getEnv("NATS_URL", "nats://localhost:4222"),
+		JWT: JWTConfig{
+			Issuer:         getEnv("JWT_ISSUER", "auth-svc"),
+			KeyCacheTTL:    getEnvDuration("JWT_KEY_CACHE_TTL", 10*time.Minute),
+			TokenCacheSize: getEnvInt("TOKEN_CACHE_SIZE", 10000),
+			MaxTokenAge:    getEnvDuration("JWT_MAX_TOKEN_AGE", time.Hour),
+			ServiceKey:     getEnv("INTERNAL_SERVICE_KEY", ""),
+		},
 	}
 }
 
@@ -47,3 +73,11 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
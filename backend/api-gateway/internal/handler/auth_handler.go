@@ -2,11 +2,16 @@ package handler
 
 import (
 	"encoding/json"
+	"net"
 	"net/http"
 
 	authv1 "github.com/diploma/api-gateway/api/proto/auth/v1"
 	"github.com/diploma/api-gateway/internal/client"
+	"github.com/diploma/api-gateway/internal/middleware"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -70,11 +75,16 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := h.authClient.Login(r.Context(), &authv1.LoginRequest{
+	// auth-svc throttles failed sign-ins per account and per address and says when to retry
+	var trailer metadata.MD
+	resp, err := h.authClient.Login(client.WithClientIP(r.Context(), clientIP(r)), &authv1.LoginRequest{
 		Email:    req.Email,
 		Password: req.Password,
-	})
+	}, grpc.Trailer(&trailer))
 	if err != nil {
+		if retryAfter := trailer.Get("retry-after"); len(retryAfter) > 0 {
+			w.Header().Set("Retry-After", retryAfter[0])
+		}
 		writeGRPCError(w, err)
 		return
 	}
@@ -86,16 +96,168 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.authClient.RefreshToken(r.Context(), &authv1.RefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, RefreshTokenResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	})
+}
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.authClient.Logout(r.Context(), &authv1.LogoutRequest{
+		RefreshToken: req.RefreshToken,
+	}); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type LogoutAllDevicesResponse struct {
+	SessionsRevoked int32 `json:"sessions_revoked"`
+}
+
+func (h *AuthHandler) LogoutAllDevices(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	resp, err := h.authClient.LogoutAllDevices(r.Context(), &authv1.LogoutAllDevicesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, LogoutAllDevicesResponse{SessionsRevoked: resp.SessionsRevoked})
+}
+
+type RequestEmailVerificationResponse struct {
+	AlreadyVerified bool `json:"already_verified"`
+}
+
+func (h *AuthHandler) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	resp, err := h.authClient.RequestEmailVerification(r.Context(), &authv1.RequestEmailVerificationRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, RequestEmailVerificationResponse{AlreadyVerified: resp.AlreadyVerified})
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyEmail also revokes the caller's access tokens, so clients refresh to pick up the verified claim
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.authClient.VerifyEmail(r.Context(), &authv1.VerifyEmailRequest{
+		Token: req.Token,
+	}); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+// RequestPasswordReset answers the same whether or not the email is registered
+func (h *AuthHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req RequestPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.authClient.RequestPasswordReset(r.Context(), &authv1.RequestPasswordResetRequest{
+		Email: req.Email,
+	}); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.authClient.ResetPassword(r.Context(), &authv1.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 type UserProfileResponse struct {
-	UserID    string `json:"user_id"`
-	FullName  string `json:"full_name"`
-	Email     string `json:"email"`
-	Phone     string `json:"phone"`
-	CreatedAt string `json:"created_at"`
+	UserID        string `json:"user_id"`
+	FullName      string `json:"full_name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Phone         string `json:"phone"`
+	Role          string `json:"role"`
+	CreatedAt     string `json:"created_at"`
 }
 
 func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("userID").(string)
+	userID := middleware.GetUserID(r.Context())
 
 	resp, err := h.authClient.GetUserProfile(r.Context(), &authv1.GetUserProfileRequest{
 		UserId: userID,
@@ -106,14 +268,91 @@ func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSON(w, http.StatusOK, UserProfileResponse{
-		UserID:    resp.UserId,
-		FullName:  resp.FullName,
-		Email:     resp.Email,
-		Phone:     resp.Phone,
-		CreatedAt: resp.CreatedAt,
+		UserID:        resp.UserId,
+		FullName:      resp.FullName,
+		Email:         resp.Email,
+		EmailVerified: resp.EmailVerified,
+		Phone:         resp.Phone,
+		Role:          resp.Role,
+		CreatedAt:     resp.CreatedAt,
 	})
 }
 
+type SetUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// SetUserRole is admin-only; auth-svc checks the caller's role from the forwarded token
+func (h *AuthHandler) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	var req SetUserRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.authClient.SetUserRole(r.Context(), &authv1.SetUserRoleRequest{
+		UserId: chi.URLParam(r, "id"),
+		Role:   req.Role,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSResponse struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS publishes the keys access tokens are signed with. Verifiers refetch on an unknown kid,
+// so a short cache lifetime is only a matter of load.
+func (h *AuthHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.authClient.GetJWKS(r.Context(), &authv1.GetJWKSRequest{})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	keys := make([]JSONWebKey, 0, len(resp.Keys))
+	for _, key := range resp.Keys {
+		keys = append(keys, JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, JWKSResponse{Keys: keys})
+}
+
+// clientIP is the connecting address; X-Forwarded-For is not trusted, as any client can set it
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 func writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -151,4 +390,3 @@ func writeGRPCError(w http.ResponseWriter, err error) {
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(map[string]string{"error": st.Message()})
 }
-
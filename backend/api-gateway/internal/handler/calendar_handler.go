@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	authv1 "github.com/diploma/api-gateway/api/proto/auth/v1"
+	"github.com/diploma/api-gateway/internal/calendar"
+	"github.com/diploma/api-gateway/internal/client"
+	"github.com/diploma/api-gateway/internal/middleware"
+	"github.com/diploma/ical"
+)
+
+const calendarFeedPath = "/api/v1/users/me/calendar.ics"
+
+type CalendarHandler struct {
+	tokens     *calendar.FeedTokens
+	versions   calendar.FeedVersionSource
+	builder    *calendar.FeedBuilder
+	publicURL  string
+	serviceKey string
+}
+
+// NewCalendarHandler reads feeds with serviceKey, the internal service key, as calendar apps send no access token
+func NewCalendarHandler(tokens *calendar.FeedTokens, versions calendar.FeedVersionSource, builder *calendar.FeedBuilder, publicURL, serviceKey string) *CalendarHandler {
+	return &CalendarHandler{
+		tokens:     tokens,
+		versions:   versions,
+		builder:    builder,
+		publicURL:  strings.TrimSuffix(publicURL, "/"),
+		serviceKey: serviceKey,
+	}
+}
+
+type CalendarFeedResponse struct {
+	URL       string `json:"url"`
+	WebcalURL string `json:"webcal_url"`
+}
+
+// GetFeedLink hands out the personal feed URL to paste into a calendar app
+func (h *CalendarHandler) GetFeedLink(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	resp, err := h.versions.GetCalendarFeedVersion(r.Context(), &authv1.GetCalendarFeedVersionRequest{UserId: userID})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.feedLinks(userID, int(resp.Version)))
+}
+
+// RegenerateFeedLink revokes the user's existing feed URLs and hands out a fresh one
+func (h *CalendarHandler) RegenerateFeedLink(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	resp, err := h.versions.RotateCalendarFeed(r.Context(), &authv1.RotateCalendarFeedRequest{UserId: userID})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.feedLinks(userID, int(resp.Version)))
+}
+
+func (h *CalendarHandler) feedLinks(userID string, version int) CalendarFeedResponse {
+	feedURL := h.publicURL + calendarFeedPath + "?" + url.Values{"token": {h.tokens.Issue(userID, version)}}.Encode()
+	webcalURL := feedURL
+	if scheme, rest, ok := strings.Cut(feedURL, "://"); ok && (scheme == "http" || scheme == "https") {
+		webcalURL = "webcal://" + rest
+	}
+
+	return CalendarFeedResponse{
+		URL:       feedURL,
+		WebcalURL: webcalURL,
+	}
+}
+
+// GetFeed serves the feed itself; the token stands in for the Authorization header calendar apps cannot send
+func (h *CalendarHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	userID, version, err := h.tokens.Verify(r.URL.Query().Get("token"))
+	if err != nil {
+		http.Error(w, `{"error":"invalid calendar token"}`, http.StatusUnauthorized)
+		return
+	}
+
+	current, err := h.versions.GetCalendarFeedVersion(r.Context(), &authv1.GetCalendarFeedVersionRequest{UserId: userID})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	if int(current.Version) != version {
+		http.Error(w, `{"error":"invalid calendar token"}`, http.StatusUnauthorized)
+		return
+	}
+
+	feed, err := h.builder.Build(client.WithBearerToken(r.Context(), h.serviceKey), userID, time.Now())
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType(ical.MethodPublish))
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(feed.Marshal())
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	notificationv1 "github.com/diploma/api-gateway/api/proto/notification/v1"
+	"github.com/diploma/api-gateway/internal/client"
+	"github.com/diploma/api-gateway/internal/middleware"
+)
+
+type NotificationHandler struct {
+	notificationClient *client.NotificationClient
+}
+
+func NewNotificationHandler(notificationClient *client.NotificationClient) *NotificationHandler {
+	return &NotificationHandler{
+		notificationClient: notificationClient,
+	}
+}
+
+type NotificationResponse struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Read      bool   `json:"read"`
+	ReadAt    string `json:"read_at,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ListNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Total         int64                  `json:"total"`
+	UnreadCount   int64                  `json:"unread_count"`
+}
+
+func (h *NotificationHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	unreadOnly, _ := strconv.ParseBool(r.URL.Query().Get("unread_only"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+	resp, err := h.notificationClient.ListNotifications(r.Context(), &notificationv1.ListNotificationsRequest{
+		UserId:     userID,
+		UnreadOnly: unreadOnly,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	notifications := make([]NotificationResponse, len(resp.Notifications))
+	for i, item := range resp.Notifications {
+		notifications[i] = NotificationResponse{
+			ID:        item.Id,
+			Type:      item.Type,
+			Title:     item.Title,
+			Body:      item.Body,
+			Read:      item.Read,
+			ReadAt:    item.ReadAt,
+			CreatedAt: item.CreatedAt,
+		}
+	}
+
+	writeJSON(w, http.StatusOK, ListNotificationsResponse{
+		Notifications: notifications,
+		Total:         resp.Total,
+		UnreadCount:   resp.UnreadCount,
+	})
+}
+
+type MarkReadRequest struct {
+	NotificationIDs []string `json:"notification_ids"`
+	All             bool     `json:"all"`
+}
+
+type MarkReadResponse struct {
+	Updated     int64 `json:"updated"`
+	UnreadCount int64 `json:"unread_count"`
+}
+
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	var req MarkReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.notificationClient.MarkRead(r.Context(), &notificationv1.MarkReadRequest{
+		UserId:          userID,
+		NotificationIds: req.NotificationIDs,
+		All:             req.All,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, MarkReadResponse{
+		Updated:     resp.Updated,
+		UnreadCount: resp.UnreadCount,
+	})
+}
+
+type ChannelPreference struct {
+	Category string `json:"category"`
+	Channel  string `json:"channel"`
+	Enabled  bool   `json:"enabled"`
+	Delivery string `json:"delivery"`
+}
+
+type PreferencesRequest struct {
+	Timezone        string              `json:"timezone"`
+	QuietHoursStart string              `json:"quiet_hours_start"`
+	QuietHoursEnd   string              `json:"quiet_hours_end"`
+	DigestHour      int32               `json:"digest_hour"`
+	Channels        []ChannelPreference `json:"channels"`
+}
+
+type PreferencesResponse struct {
+	Timezone        string              `json:"timezone"`
+	QuietHoursStart string              `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string              `json:"quiet_hours_end,omitempty"`
+	DigestHour      int32               `json:"digest_hour"`
+	Channels        []ChannelPreference `json:"channels"`
+	UpdatedAt       string              `json:"updated_at,omitempty"`
+}
+
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	resp, err := h.notificationClient.GetPreferences(r.Context(), &notificationv1.GetPreferencesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toPreferencesResponse(resp.Preferences))
+}
+
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	var req PreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	channels := make([]*notificationv1.ChannelPreference, len(req.Channels))
+	for i, rule := range req.Channels {
+		channels[i] = &notificationv1.ChannelPreference{
+			Category: rule.Category,
+			Channel:  rule.Channel,
+			Enabled:  rule.Enabled,
+			Delivery: rule.Delivery,
+		}
+	}
+
+	resp, err := h.notificationClient.UpdatePreferences(r.Context(), &notificationv1.UpdatePreferencesRequest{
+		Preferences: &notificationv1.Preferences{
+			UserId:          userID,
+			Timezone:        req.Timezone,
+			QuietHoursStart: req.QuietHoursStart,
+			QuietHoursEnd:   req.QuietHoursEnd,
+			DigestHour:      req.DigestHour,
+			Channels:        channels,
+		},
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toPreferencesResponse(resp.Preferences))
+}
+
+func toPreferencesResponse(preferences *notificationv1.Preferences) PreferencesResponse {
+	channels := make([]ChannelPreference, len(preferences.GetChannels()))
+	for i, rule := range preferences.GetChannels() {
+		channels[i] = ChannelPreference{
+			Category: rule.Category,
+			Channel:  rule.Channel,
+			Enabled:  rule.Enabled,
+			Delivery: rule.Delivery,
+		}
+	}
+
+	return PreferencesResponse{
+		Timezone:        preferences.GetTimezone(),
+		QuietHoursStart: preferences.GetQuietHoursStart(),
+		QuietHoursEnd:   preferences.GetQuietHoursEnd(),
+		DigestHour:      preferences.GetDigestHour(),
+		Channels:        channels,
+		UpdatedAt:       preferences.GetUpdatedAt(),
+	}
+}
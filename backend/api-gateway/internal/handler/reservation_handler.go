@@ -21,17 +21,22 @@ func NewReservationHandler(reservationClient *client.ReservationClient) *Reserva
 }
 
 type CreateReservationRequest struct {
-	ApartmentID string `json:"apartment_id"`
-	Comment     string `json:"comment"`
+	ResourceID string `json:"resource_id"`
+	StartTime  string `json:"start_time"`
+	EndTime    string `json:"end_time"`
+	Comment    string `json:"comment"`
 }
 
 type ReservationResponse struct {
-	ID          string `json:"id"`
-	UserID      string `json:"user_id"`
-	ApartmentID string `json:"apartment_id"`
-	ReservedAt  string `json:"reserved_at"`
-	Status      string `json:"status"`
-	Comment     string `json:"comment"`
+	ID         string `json:"id"`
+	UserID     string `json:"user_id"`
+	ResourceID string `json:"resource_id"`
+	StartTime  string `json:"start_time"`
+	EndTime    string `json:"end_time"`
+	ReservedAt string `json:"reserved_at"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	Status     string `json:"status"`
+	Comment    string `json:"comment"`
 }
 
 func (h *ReservationHandler) CreateReservation(w http.ResponseWriter, r *http.Request) {
@@ -44,9 +49,11 @@ func (h *ReservationHandler) CreateReservation(w http.ResponseWriter, r *http.Re
 	}
 
 	resp, err := h.reservationClient.CreateReservation(r.Context(), &reservationv1.CreateReservationRequest{
-		UserId:      userID,
-		ApartmentId: req.ApartmentID,
-		Comment:     req.Comment,
+		UserId:     userID,
+		ResourceId: req.ResourceID,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Comment:    req.Comment,
 	})
 	if err != nil {
 		writeGRPCError(w, err)
@@ -70,12 +77,15 @@ func (h *ReservationHandler) ListMyReservations(w http.ResponseWriter, r *http.R
 	reservations := make([]ReservationResponse, len(resp.Items))
 	for i, item := range resp.Items {
 		reservations[i] = ReservationResponse{
-			ID:          item.Id,
-			UserID:      item.UserId,
-			ApartmentID: item.ApartmentId,
-			ReservedAt:  item.ReservedAt,
-			Status:      item.Status,
-			Comment:     item.Comment,
+			ID:         item.Id,
+			UserID:     item.UserId,
+			ResourceID: item.ResourceId,
+			StartTime:  item.StartTime,
+			EndTime:    item.EndTime,
+			ReservedAt: item.ReservedAt,
+			ExpiresAt:  item.ExpiresAt,
+			Status:     item.Status,
+			Comment:    item.Comment,
 		}
 	}
 
@@ -94,12 +104,15 @@ func (h *ReservationHandler) GetReservation(w http.ResponseWriter, r *http.Reque
 	}
 
 	writeJSON(w, http.StatusOK, ReservationResponse{
-		ID:          resp.Id,
-		UserID:      resp.UserId,
-		ApartmentID: resp.ApartmentId,
-		ReservedAt:  resp.ReservedAt,
-		Status:      resp.Status,
-		Comment:     resp.Comment,
+		ID:         resp.Id,
+		UserID:     resp.UserId,
+		ResourceID: resp.ResourceId,
+		StartTime:  resp.StartTime,
+		EndTime:    resp.EndTime,
+		ReservedAt: resp.ReservedAt,
+		ExpiresAt:  resp.ExpiresAt,
+		Status:     resp.Status,
+		Comment:    resp.Comment,
 	})
 }
 
@@ -116,4 +129,3 @@ func (h *ReservationHandler) CancelReservation(w http.ResponseWriter, r *http.Re
 
 	writeJSON(w, http.StatusOK, map[string]bool{"success": true})
 }
-
@@ -133,3 +133,74 @@ func (h *VenueHandler) ListResources(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, map[string]interface{}{"resources": resources})
 }
 
+type AvailabilityCellResponse struct {
+	StartTime string  `json:"start_time"`
+	EndTime   string  `json:"end_time"`
+	Available bool    `json:"available"`
+	Price     float64 `json:"price"`
+}
+
+type ResourceAvailabilityResponse struct {
+	ResourceID string                     `json:"resource_id"`
+	Cells      []AvailabilityCellResponse `json:"cells"`
+}
+
+func (h *VenueHandler) GetResourceAvailability(w http.ResponseWriter, r *http.Request) {
+	venueID := chi.URLParam(r, "id")
+	resourceID := chi.URLParam(r, "rid")
+
+	dateFrom := r.URL.Query().Get("date_from")
+	dateTo := r.URL.Query().Get("date_to")
+	if dateFrom == "" || dateTo == "" {
+		http.Error(w, `{"error":"date_from and date_to are required"}`, http.StatusBadRequest)
+		return
+	}
+
+	granularity := 0
+	if value := r.URL.Query().Get("granularity"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, `{"error":"granularity must be a positive number of minutes"}`, http.StatusBadRequest)
+			return
+		}
+		granularity = parsed
+	}
+
+	resource, err := h.venueClient.GetResource(r.Context(), &venuev1.GetResourceRequest{
+		ResourceId: resourceID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	if resource.VenueId != venueID {
+		http.Error(w, `{"error":"resource not found"}`, http.StatusNotFound)
+		return
+	}
+
+	resp, err := h.venueClient.GetResourceAvailability(r.Context(), &venuev1.GetResourceAvailabilityRequest{
+		ResourceId:         resourceID,
+		DateFrom:           dateFrom,
+		DateTo:             dateTo,
+		GranularityMinutes: int32(granularity),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	cells := make([]AvailabilityCellResponse, len(resp.Cells))
+	for i, cell := range resp.Cells {
+		cells[i] = AvailabilityCellResponse{
+			StartTime: cell.StartTime,
+			EndTime:   cell.EndTime,
+			Available: cell.Available,
+			Price:     cell.Price,
+		}
+	}
+
+	writeJSON(w, http.StatusOK, ResourceAvailabilityResponse{
+		ResourceID: resp.ResourceId,
+		Cells:      cells,
+	})
+}
@@ -2,24 +2,31 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"strings"
 
 	"github.com/diploma/api-gateway/internal/client"
-	authv1 "github.com/diploma/api-gateway/api/proto/auth/v1"
+	"github.com/diploma/authz"
 )
 
 type contextKey string
 
-const UserIDKey contextKey = "userID"
+const (
+	UserIDKey contextKey = "userID"
+	RoleKey   contextKey = "role"
+	EmailKey  contextKey = "email"
+	ClaimsKey contextKey = "claims"
+)
 
+// AuthMiddleware checks access tokens in-process; auth-svc is only consulted for its signing keys
 type AuthMiddleware struct {
-	authClient *client.AuthClient
+	verifier authz.Verifier
 }
 
-func NewAuthMiddleware(authClient *client.AuthClient) *AuthMiddleware {
+func NewAuthMiddleware(verifier authz.Verifier) *AuthMiddleware {
 	return &AuthMiddleware{
-		authClient: authClient,
+		verifier: verifier,
 	}
 }
 
@@ -39,15 +46,17 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 
 		token := parts[1]
 
-		resp, err := m.authClient.ValidateToken(r.Context(), &authv1.ValidateTokenRequest{
-			Token: token,
-		})
+		claims, err := m.verifier.Verify(token)
 		if err != nil {
 			http.Error(w, `{"error":"invalid token"}`, http.StatusUnauthorized)
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), UserIDKey, resp.UserId)
+		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+		ctx = context.WithValue(ctx, RoleKey, string(claims.Role))
+		ctx = context.WithValue(ctx, EmailKey, claims.Email)
+		ctx = context.WithValue(ctx, ClaimsKey, claims)
+		ctx = client.WithBearerToken(ctx, token)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -59,3 +68,38 @@ func GetUserID(ctx context.Context) string {
 	return ""
 }
 
+
+func GetRole(ctx context.Context) string {
+	if role, ok := ctx.Value(RoleKey).(string); ok {
+		return role
+	}
+	return ""
+}
+
+func GetEmail(ctx context.Context) string {
+	if email, ok := ctx.Value(EmailKey).(string); ok {
+		return email
+	}
+	return ""
+}
+
+// GetClaims returns everything the access token asserted about the caller
+func GetClaims(ctx context.Context) (*authz.Claims, bool) {
+	claims, ok := ctx.Value(ClaimsKey).(*authz.Claims)
+	return claims, ok
+}
+
+// RequireVerifiedEmail guards hosting sessions and paying at the edge, so the rule holds even where
+// the backend handler does not enforce it yet; it must run after Authenticate
+func RequireVerifiedEmail(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := GetClaims(r.Context())
+		if !ok || !claims.EmailVerified {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]string{"error": authz.ErrEmailNotVerified.Error()})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
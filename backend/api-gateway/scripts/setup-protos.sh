@@ -18,6 +18,7 @@ cp ../venue-svc/api/v1/venue.proto api/proto/venue/v1/ 2>/dev/null || echo "Warn
 cp ../reservation-svc/api/v1/reservation.proto api/proto/reservation/v1/ 2>/dev/null || echo "Warning: reservation.proto not found"
 cp ../session-svc/api/v1/session.proto api/proto/session/v1/ 2>/dev/null || echo "Warning: session.proto not found"
 cp ../payment-svc/api/v1/payment.proto api/proto/payment/v1/ 2>/dev/null || echo "Warning: payment.proto not found"
+cp ../notification-svc/api/v1/notification.proto api/proto/notification/v1/ 2>/dev/null || echo "Warning: notification.proto not found"
 
 # Update go_package options
 echo "Updating go_package options..."
@@ -52,6 +53,12 @@ if [ -f "api/proto/payment/v1/payment.proto" ]; then
     echo "✓ Updated payment.proto"
 fi
 
+# Notification
+if [ -f "api/proto/notification/v1/notification.proto" ]; then
+    sed -i 's|option go_package = .*|option go_package = "github.com/diploma/api-gateway/api/proto/notification/v1;notificationv1";|' api/proto/notification/v1/notification.proto
+    echo "✓ Updated notification.proto"
+fi
+
 echo ""
 echo "Proto files setup complete!"
 echo "Run 'make proto' to generate gRPC code."
@@ -0,0 +1,241 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/diploma/api-gateway/internal/auth"
+	"github.com/diploma/api-gateway/internal/middleware"
+	"github.com/diploma/authz"
+	sharedevents "github.com/diploma/events"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const issuer = "auth-svc"
+
+// countingVerifier counts how often tokens actually get verified
+type countingVerifier struct {
+	verifier authz.Verifier
+	calls    int
+}
+
+func (v *countingVerifier) Verify(token string) (*authz.Claims, error) {
+	v.calls++
+	return v.verifier.Verify(token)
+}
+
+type tokenIssuer struct {
+	t    *testing.T
+	keys *authz.KeySet
+}
+
+func newTokenIssuer(t *testing.T) *tokenIssuer {
+	t.Helper()
+	keys, err := authz.GenerateKeySet()
+	if err != nil {
+		t.Fatalf("Failed to generate key set: %v", err)
+	}
+	return &tokenIssuer{t: t, keys: keys}
+}
+
+func (i *tokenIssuer) verifier() *countingVerifier {
+	return &countingVerifier{verifier: authz.NewJWKSVerifier(i.keys, issuer, time.Minute)}
+}
+
+func (i *tokenIssuer) token(userID string, issuedAt time.Time) string {
+	i.t.Helper()
+	return i.sign(userID, issuedAt, false)
+}
+
+func (i *tokenIssuer) verifiedToken(userID string, issuedAt time.Time) string {
+	i.t.Helper()
+	return i.sign(userID, issuedAt, true)
+}
+
+func (i *tokenIssuer) sign(userID string, issuedAt time.Time, emailVerified bool) string {
+	i.t.Helper()
+	token, err := i.keys.Sign(authz.Claims{
+		UserID:        userID,
+		Email:         userID + "@example.com",
+		EmailVerified: emailVerified,
+		Role:          authz.RoleVenueOwner,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(15 * time.Minute)),
+		},
+	})
+	if err != nil {
+		i.t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestCachingVerifierVerifiesOncePerToken(t *testing.T) {
+	issuer := newTokenIssuer(t)
+	inner := issuer.verifier()
+	verifier := auth.NewCachingVerifier(inner, auth.NewRevocationList(time.Hour), 1)
+
+	first := issuer.token("user-1", time.Now())
+	for i := 0; i < 3; i++ {
+		if _, err := verifier.Verify(first); err != nil {
+			t.Fatalf("Expected a valid token, got %v", err)
+		}
+	}
+	if inner.calls != 1 {
+		t.Errorf("Expected one verification for a repeated token, got %d", inner.calls)
+	}
+
+	// The cache is full of a live token, so a second one is verified every time rather than evicting it
+	second := issuer.token("user-2", time.Now())
+	verifier.Verify(second)
+	verifier.Verify(second)
+	if inner.calls != 3 {
+		t.Errorf("Expected uncached tokens to be verified each time, got %d calls", inner.calls)
+	}
+
+	if _, err := verifier.Verify("not.a.token"); !errors.Is(err, authz.ErrInvalidToken) {
+		t.Errorf("Expected garbage to be rejected, got %v", err)
+	}
+}
+
+func TestCachingVerifierHonoursRevocations(t *testing.T) {
+	issuer := newTokenIssuer(t)
+	revocations := auth.NewRevocationList(time.Hour)
+	verifier := auth.NewCachingVerifier(issuer.verifier(), revocations, 100)
+
+	now := time.Now()
+	before := issuer.token("user-1", now.Add(-time.Minute))
+	after := issuer.token("user-1", now)
+	otherUser := issuer.token("user-2", now.Add(-time.Minute))
+
+	if _, err := verifier.Verify(before); err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+
+	revocations.Revoke("user-1", now.Add(-30*time.Second))
+
+	if _, err := verifier.Verify(before); !errors.Is(err, auth.ErrTokenRevoked) {
+		t.Errorf("Expected a cached token issued before the revocation to be rejected, got %v", err)
+	}
+	if _, err := verifier.Verify(after); err != nil {
+		t.Errorf("Expected a token issued after the revocation to pass, got %v", err)
+	}
+	if _, err := verifier.Verify(otherUser); err != nil {
+		t.Errorf("Expected other users to be unaffected, got %v", err)
+	}
+
+	// An older revocation replayed late does not move the cut-off back
+	revocations.Revoke("user-1", now.Add(-time.Hour))
+	if _, err := verifier.Verify(before); !errors.Is(err, auth.ErrTokenRevoked) {
+		t.Errorf("Expected the latest revocation to win, got %v", err)
+	}
+}
+
+func TestRevocationListForgetsExpiredRevocations(t *testing.T) {
+	revocations := auth.NewRevocationList(time.Hour)
+	revocations.Revoke("user-1", time.Now().Add(-2*time.Hour))
+	revocations.Revoke("user-2", time.Now())
+
+	if revocations.Len() != 1 {
+		t.Errorf("Expected revocations older than any live token to be dropped, got %d", revocations.Len())
+	}
+}
+
+func TestRevocationSubscriberHandle(t *testing.T) {
+	revocations := auth.NewRevocationList(time.Hour)
+	subscriber := auth.NewRevocationSubscriber(nil, revocations)
+
+	data, err := sharedevents.NewCodec("auth-svc").Encode(context.Background(), sharedevents.SubjectUserTokensRevoked, sharedevents.UserTokensRevoked{
+		UserID:        "user-1",
+		RevokedBefore: time.Now(),
+		Reason:        "logout_all",
+	})
+	if err != nil {
+		t.Fatalf("Failed to encode event: %v", err)
+	}
+
+	if err := subscriber.Handle(data); err != nil {
+		t.Fatalf("Failed to handle event: %v", err)
+	}
+	if !revocations.IsRevoked(&authz.Claims{UserID: "user-1", RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))}}) {
+		t.Error("Expected the event to revoke the user's earlier tokens")
+	}
+
+	if err := subscriber.Handle([]byte("{}")); err == nil {
+		t.Error("Expected a malformed event to be reported")
+	}
+}
+
+func TestAuthMiddlewareExposesClaims(t *testing.T) {
+	issuer := newTokenIssuer(t)
+	revocations := auth.NewRevocationList(time.Hour)
+	m := middleware.NewAuthMiddleware(auth.NewCachingVerifier(issuer.verifier(), revocations, 100))
+
+	var userID, role, email string
+	var claims *authz.Claims
+	h := m.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID = middleware.GetUserID(r.Context())
+		role = middleware.GetRole(r.Context())
+		email = middleware.GetEmail(r.Context())
+		claims, _ = middleware.GetClaims(r.Context())
+	}))
+
+	call := func(header string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	token := issuer.token("user-1", time.Now().Add(-time.Minute))
+	if code := call("Bearer " + token); code != http.StatusOK {
+		t.Fatalf("Expected 200 for a valid token, got %d", code)
+	}
+	if userID != "user-1" || role != "venue_owner" || email != "user-1@example.com" || claims == nil || claims.UserID != "user-1" {
+		t.Errorf("Expected the token's claims in the context, got %q %q %q %+v", userID, role, email, claims)
+	}
+
+	for name, header := range map[string]string{
+		"missing":   "",
+		"malformed": token,
+		"invalid":   "Bearer not.a.token",
+	} {
+		if code := call(header); code != http.StatusUnauthorized {
+			t.Errorf("Expected 401 for a %s header, got %d", name, code)
+		}
+	}
+
+	revocations.Revoke("user-1", time.Now())
+	if code := call("Bearer " + token); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for a revoked token, got %d", code)
+	}
+}
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	issuer := newTokenIssuer(t)
+	m := middleware.NewAuthMiddleware(auth.NewCachingVerifier(issuer.verifier(), auth.NewRevocationList(time.Hour), 100))
+	h := m.Authenticate(middleware.RequireVerifiedEmail(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+
+	call := func(token string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/session-1/pay", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := call(issuer.token("user-1", time.Now())); code != http.StatusForbidden {
+		t.Errorf("Expected 403 for an unverified email, got %d", code)
+	}
+	if code := call(issuer.verifiedToken("user-1", time.Now())); code != http.StatusOK {
+		t.Errorf("Expected 200 for a verified email, got %d", code)
+	}
+}
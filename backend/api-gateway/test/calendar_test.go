@@ -0,0 +1,323 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	authv1 "github.com/diploma/api-gateway/api/proto/auth/v1"
+	reservationv1 "github.com/diploma/api-gateway/api/proto/reservation/v1"
+	sessionv1 "github.com/diploma/api-gateway/api/proto/session/v1"
+	venuev1 "github.com/diploma/api-gateway/api/proto/venue/v1"
+	"github.com/diploma/api-gateway/internal/calendar"
+	"github.com/diploma/api-gateway/internal/handler"
+	"github.com/diploma/api-gateway/internal/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var feedNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+type fakeReservations struct {
+	items []*reservationv1.GetReservationResponse
+}
+
+func (f *fakeReservations) ListReservationsByUser(_ context.Context, req *reservationv1.ListReservationsByUserRequest) (*reservationv1.ListReservationsByUserResponse, error) {
+	var items []*reservationv1.GetReservationResponse
+	for _, item := range f.items {
+		if item.UserId == req.UserId {
+			items = append(items, item)
+		}
+	}
+	return &reservationv1.ListReservationsByUserResponse{Items: items}, nil
+}
+
+func (f *fakeReservations) GetReservation(_ context.Context, req *reservationv1.GetReservationRequest) (*reservationv1.GetReservationResponse, error) {
+	for _, item := range f.items {
+		if item.Id == req.ReservationId {
+			return item, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "reservation not found")
+}
+
+type fakeSessions struct {
+	items []*sessionv1.GetSessionResponse
+	pages int
+}
+
+func (f *fakeSessions) ListUserSessions(_ context.Context, req *sessionv1.ListUserSessionsRequest) (*sessionv1.ListUserSessionsResponse, error) {
+	f.pages++
+	start := int(req.Page-1) * int(req.PageSize)
+	if start > len(f.items) {
+		start = len(f.items)
+	}
+	end := start + int(req.PageSize)
+	if end > len(f.items) {
+		end = len(f.items)
+	}
+	return &sessionv1.ListUserSessionsResponse{Items: f.items[start:end], TotalCount: int32(len(f.items))}, nil
+}
+
+type fakeVenues struct {
+	lookups int
+}
+
+func (f *fakeVenues) GetResource(_ context.Context, req *venuev1.GetResourceRequest) (*venuev1.GetResourceResponse, error) {
+	f.lookups++
+	if req.ResourceId != "court-1" {
+		return nil, status.Error(codes.NotFound, "resource not found")
+	}
+	return &venuev1.GetResourceResponse{Id: "court-1", VenueId: "venue-1", Name: "Court 1"}, nil
+}
+
+func (f *fakeVenues) GetVenue(_ context.Context, _ *venuev1.GetVenueRequest) (*venuev1.GetVenueResponse, error) {
+	return &venuev1.GetVenueResponse{
+		Id:        "venue-1",
+		Name:      "Central Sports Park",
+		Address:   "12 Abay Ave",
+		City:      "Almaty",
+		Latitude:  43.238949,
+		Longitude: 76.889709,
+	}, nil
+}
+
+func reservationAt(id, status string, start time.Time) *reservationv1.GetReservationResponse {
+	return &reservationv1.GetReservationResponse{
+		Id:         id,
+		UserId:     "user-1",
+		ResourceId: "court-1",
+		Status:     status,
+		StartTime:  start.Format(time.RFC3339),
+		EndTime:    start.Add(90 * time.Minute).Format(time.RFC3339),
+	}
+}
+
+func sessionAt(id, reservationID string, sessionStatus sessionv1.SessionStatus, start time.Time) *sessionv1.GetSessionResponse {
+	return &sessionv1.GetSessionResponse{
+		Id:            id,
+		ReservationId: reservationID,
+		SportType:     "FOOTBALL",
+		SkillLevel:    "INTERMEDIATE",
+		Status:        sessionStatus,
+		StartTime:     start.Format(time.RFC3339),
+		EndTime:       start.Add(90 * time.Minute).Format(time.RFC3339),
+	}
+}
+
+type fakeFeedVersions struct {
+	versions map[string]int32
+}
+
+func (f *fakeFeedVersions) GetCalendarFeedVersion(_ context.Context, req *authv1.GetCalendarFeedVersionRequest) (*authv1.GetCalendarFeedVersionResponse, error) {
+	return &authv1.GetCalendarFeedVersionResponse{Version: f.versions[req.UserId]}, nil
+}
+
+func (f *fakeFeedVersions) RotateCalendarFeed(_ context.Context, req *authv1.RotateCalendarFeedRequest) (*authv1.RotateCalendarFeedResponse, error) {
+	if f.versions == nil {
+		f.versions = map[string]int32{}
+	}
+	f.versions[req.UserId]++
+	return &authv1.RotateCalendarFeedResponse{Version: f.versions[req.UserId]}, nil
+}
+
+func buildFeed(t *testing.T, reservations *fakeReservations, sessions *fakeSessions, venues *fakeVenues) string {
+	t.Helper()
+	feed, err := calendar.NewFeedBuilder(reservations, sessions, venues).Build(context.Background(), "user-1", feedNow)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return strings.ReplaceAll(string(feed.Marshal()), "\r\n ", "")
+}
+
+func TestFeedTokens_RoundTrip(t *testing.T) {
+	tokens := calendar.NewFeedTokens("secret")
+
+	for _, version := range []int{0, 3} {
+		userID, got, err := tokens.Verify(tokens.Issue("user-1", version))
+		if err != nil || userID != "user-1" || got != version {
+			t.Fatalf("Expected user-1 at version %d, got %q at %d (%v)", version, userID, got, err)
+		}
+	}
+
+	for _, token := range []string{
+		"",
+		"no-dot",
+		calendar.NewFeedTokens("other").Issue("user-1", 0),
+		strings.Replace(tokens.Issue("user-1", 0), "dXNlci0x", "dXNlci0y", 1), // user-2 with user-1's signature
+		strings.Replace(tokens.Issue("user-1", 3), ".3.", ".4.", 1),           // version 4 with version 3's signature
+		strings.Replace(tokens.Issue("user-1", 3), ".3.", ".", 1),             // version 0 with version 3's signature
+		strings.Replace(tokens.Issue("user-1", 3), ".3.", ".03.", 1),          // same version, different spelling
+	} {
+		if _, _, err := tokens.Verify(token); err == nil {
+			t.Errorf("Expected %q to be rejected", token)
+		}
+	}
+}
+
+func TestFeedBuilder_ReservationsAndSessions(t *testing.T) {
+	venues := &fakeVenues{}
+	feed := buildFeed(t,
+		&fakeReservations{items: []*reservationv1.GetReservationResponse{
+			reservationAt("res-confirmed", "CONFIRMED", feedNow.Add(24*time.Hour)),
+			reservationAt("res-pending", "PENDING", feedNow.Add(48*time.Hour)),
+			reservationAt("res-cancelled", "CANCELLED", feedNow.Add(72*time.Hour)),
+			reservationAt("res-expired", "EXPIRED", feedNow.Add(96*time.Hour)),
+			reservationAt("res-old", "CONFIRMED", feedNow.Add(-60*24*time.Hour)),
+			reservationAt("res-hosted", "CONFIRMED", feedNow.Add(120*time.Hour)),
+		}},
+		&fakeSessions{items: []*sessionv1.GetSessionResponse{
+			sessionAt("ses-hosted", "res-hosted", sessionv1.SessionStatus_SESSION_STATUS_OPEN, feedNow.Add(120*time.Hour)),
+			sessionAt("ses-cancelled", "", sessionv1.SessionStatus_SESSION_STATUS_CANCELLED, feedNow.Add(144*time.Hour)),
+		}},
+		venues,
+	)
+
+	for _, want := range []string{
+		"METHOD:PUBLISH\r\n",
+		"UID:reservation-res-confirmed@sportsapp\r\nDTSTAMP:20260310T120000Z\r\n",
+		"SUMMARY:Court 1\\, Central Sports Park\r\n",
+		"LOCATION:Central Sports Park\\, 12 Abay Ave\\, Almaty\r\n",
+		"GEO:43.238949;76.889709\r\n",
+		"UID:session-ses-hosted@sportsapp\r\n",
+		"SUMMARY:Football (Intermediate)\r\n",
+	} {
+		if !strings.Contains(feed, want) {
+			t.Errorf("Expected feed to contain %q, got:\n%s", want, feed)
+		}
+	}
+
+	statuses := map[string]string{
+		"reservation-res-pending@sportsapp":   "STATUS:TENTATIVE",
+		"reservation-res-cancelled@sportsapp": "STATUS:CANCELLED",
+		"session-ses-cancelled@sportsapp":     "STATUS:CANCELLED",
+	}
+	for uid, want := range statuses {
+		block := eventBlock(feed, uid)
+		if !strings.Contains(block, want) {
+			t.Errorf("Expected %s to have %s, got:\n%s", uid, want, block)
+		}
+	}
+
+	for _, missing := range []string{"res-expired", "res-old", "reservation-res-hosted"} {
+		if strings.Contains(feed, missing) {
+			t.Errorf("Expected %s to be left out of the feed", missing)
+		}
+	}
+	if venues.lookups != 1 {
+		t.Errorf("Expected the venue to be looked up once per feed, got %d", venues.lookups)
+	}
+}
+
+func TestFeedBuilder_PagesThroughSessions(t *testing.T) {
+	sessions := &fakeSessions{}
+	for i := 0; i < 150; i++ {
+		sessions.items = append(sessions.items, sessionAt("ses-"+strconv.Itoa(i), "", sessionv1.SessionStatus_SESSION_STATUS_OPEN, feedNow.Add(time.Duration(i)*time.Hour)))
+	}
+
+	feed := buildFeed(t, &fakeReservations{}, sessions, &fakeVenues{})
+	if got := strings.Count(feed, "BEGIN:VEVENT"); got != 150 {
+		t.Errorf("Expected 150 events, got %d", got)
+	}
+	if sessions.pages != 2 {
+		t.Errorf("Expected 2 pages to be fetched, got %d", sessions.pages)
+	}
+}
+
+func TestCalendarHandler_GetFeed(t *testing.T) {
+	tokens := calendar.NewFeedTokens("secret")
+	h := handler.NewCalendarHandler(
+		tokens,
+		&fakeFeedVersions{},
+		calendar.NewFeedBuilder(&fakeReservations{items: []*reservationv1.GetReservationResponse{
+			reservationAt("res-1", "CONFIRMED", time.Now().Add(24*time.Hour)),
+		}}, &fakeSessions{}, &fakeVenues{}),
+		"https://api.sportsapp.test/",
+		"service-key",
+	)
+
+	rec := httptest.NewRecorder()
+	h.GetFeed(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users/me/calendar.ics?token=bogus", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for a bad token, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	h.GetFeed(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users/me/calendar.ics?token="+tokens.Issue("user-1", 0), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Type"); got != "text/calendar; charset=UTF-8; method=PUBLISH" {
+		t.Errorf("Unexpected content type %q", got)
+	}
+	if !strings.Contains(rec.Body.String(), "UID:reservation-res-1@sportsapp") {
+		t.Errorf("Expected the user's reservation in the feed, got:\n%s", rec.Body.String())
+	}
+}
+
+func TestCalendarHandler_RegeneratedLinkRevokesOldOnes(t *testing.T) {
+	versions := &fakeFeedVersions{}
+	h := handler.NewCalendarHandler(
+		calendar.NewFeedTokens("secret"),
+		versions,
+		calendar.NewFeedBuilder(&fakeReservations{}, &fakeSessions{}, &fakeVenues{}),
+		"https://api.sportsapp.test",
+		"service-key",
+	)
+	ctx := context.WithValue(context.Background(), middleware.UserIDKey, "user-1")
+
+	feedLink := func(method, path string, serve http.HandlerFunc) string {
+		rec := httptest.NewRecorder()
+		serve(rec, httptest.NewRequest(method, path, nil).WithContext(ctx))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected 200 from %s, got %d: %s", path, rec.Code, rec.Body.String())
+		}
+		var resp handler.CalendarFeedResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("Failed to decode feed link: %v", err)
+		}
+		return resp.URL
+	}
+	poll := func(feedURL string) int {
+		rec := httptest.NewRecorder()
+		h.GetFeed(rec, httptest.NewRequest(http.MethodGet, feedURL, nil))
+		return rec.Code
+	}
+
+	oldLink := feedLink(http.MethodGet, "/api/v1/users/me/calendar", h.GetFeedLink)
+	if code := poll(oldLink); code != http.StatusOK {
+		t.Fatalf("Expected the issued link to work, got %d", code)
+	}
+
+	newLink := feedLink(http.MethodPost, "/api/v1/users/me/calendar/regenerate", h.RegenerateFeedLink)
+	if code := poll(oldLink); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for a link issued before regenerating, got %d", code)
+	}
+	if code := poll(newLink); code != http.StatusOK {
+		t.Errorf("Expected the regenerated link to work, got %d", code)
+	}
+
+	// auth-svc bumps the version on password reset and logout-all the same way
+	versions.versions["user-1"]++
+	if code := poll(newLink); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 once the feed version moved on, got %d", code)
+	}
+}
+
+// eventBlock returns the VEVENT with the given UID
+func eventBlock(feed, uid string) string {
+	start := strings.Index(feed, "UID:"+uid)
+	if start < 0 {
+		return ""
+	}
+	end := strings.Index(feed[start:], "END:VEVENT")
+	if end < 0 {
+		return feed[start:]
+	}
+	return feed[start : start+end]
+}
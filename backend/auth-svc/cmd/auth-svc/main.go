@@ -1,22 +1,29 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/diploma/auth-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/auth-svc/internal/adapters/outbound/cache"
 	"github.com/diploma/auth-svc/internal/adapters/outbound/database/repository"
-	"github.com/diploma/auth-svc/internal/adapters/outbound/external/email"
+	"github.com/diploma/auth-svc/internal/adapters/outbound/external/events"
 	"github.com/diploma/auth-svc/internal/application/user/usecase"
 	"github.com/diploma/auth-svc/internal/config"
+	authport "github.com/diploma/auth-svc/internal/domain/auth/port"
 	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
 	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
-	"github.com/diploma/auth-svc/pkg/middleware"
+	"github.com/diploma/authz"
+	sharedevents "github.com/diploma/events"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/jaeger"
@@ -63,26 +70,54 @@ func main() {
 		log.Printf("Warning: Failed to connect to NATS: %v", err)
 	} else {
 		defer natsConn.Close()
+		ensureUserStream(natsConn, cfg.NATS.StreamMaxAge)
 	}
 
+	loginAttempts := newLoginAttemptStore(cfg.Redis)
+
 	userRepo := repository.NewUserRepository(db)
 	authRepo := repository.NewAuthRepository(db)
 
 	userService := userservice.NewUserService(userRepo)
-	authService := authservice.NewAuthService(authRepo, cfg)
+	signingKeys, err := loadSigningKeys(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
+	log.Printf("Signing access tokens with key %s", signingKeys.ActiveKeyID())
 
-	emailService := email.NewEmailService()
-	_ = emailService
+	tokenSealer, err := sharedevents.NewSealer(cfg.Account.TokenSealKey)
+	if err != nil {
+		log.Fatalf("Failed to create token sealer: %v", err)
+	}
+	eventPublisher := events.NewNATSEventPublisher(natsConn, sharedevents.NewCodec("auth-svc"), tokenSealer)
+	authService := authservice.NewAuthService(authRepo, eventPublisher, cfg, signingKeys)
+	loginGuard := authservice.NewLoginGuard(loginAttempts, eventPublisher, cfg.Login)
 
-	registerUserUseCase := usecase.NewRegisterUserUseCase(userService)
-	loginUserUseCase := usecase.NewLoginUserUseCase(userService, authService)
+	requestEmailVerificationUseCase := usecase.NewRequestEmailVerificationUseCase(userService, authService, eventPublisher)
+	registerUserUseCase := usecase.NewRegisterUserUseCase(userService, requestEmailVerificationUseCase)
+	loginUserUseCase := usecase.NewLoginUserUseCase(userService, authService, loginGuard)
 	getUserProfileUseCase := usecase.NewGetUserProfileUseCase(userService)
 	refreshTokenUseCase := usecase.NewRefreshTokenUseCase(authService, userService)
+	logoutUseCase := usecase.NewLogoutUseCase(authService)
+	logoutAllDevicesUseCase := usecase.NewLogoutAllDevicesUseCase(authService, userService)
+	setUserRoleUseCase := usecase.NewSetUserRoleUseCase(userService, authService)
+	verifyEmailUseCase := usecase.NewVerifyEmailUseCase(userService, authService, eventPublisher)
+	requestPasswordResetUseCase := usecase.NewRequestPasswordResetUseCase(userService, authService, eventPublisher)
+	resetPasswordUseCase := usecase.NewResetPasswordUseCase(userService, authService, eventPublisher)
+	getCalendarFeedVersionUseCase := usecase.NewGetCalendarFeedVersionUseCase(userService)
+	rotateCalendarFeedUseCase := usecase.NewRotateCalendarFeedUseCase(userService)
+
+	trustedProxies, err := handler.NewTrustedProxies(cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
 
 	userHandler := handler.NewUserGRPCHandler(registerUserUseCase, getUserProfileUseCase)
-	authHandler := handler.NewAuthGRPCHandler(loginUserUseCase, refreshTokenUseCase, authService)
+	authHandler := handler.NewAuthGRPCHandler(loginUserUseCase, refreshTokenUseCase, logoutUseCase, logoutAllDevicesUseCase, setUserRoleUseCase, authService, authService, trustedProxies)
+	accountHandler := handler.NewAccountGRPCHandler(requestEmailVerificationUseCase, verifyEmailUseCase, requestPasswordResetUseCase, resetPasswordUseCase)
+	calendarFeedHandler := handler.NewCalendarFeedGRPCHandler(getCalendarFeedVersionUseCase, rotateCalendarFeedUseCase)
 
-	authInterceptor := middleware.NewAuthInterceptor(authService)
+	authInterceptor := authz.UnaryServerInterceptor(authService, handler.AccessPolicy)
 
 	var serverOpts []grpc.ServerOption
 
@@ -90,19 +125,19 @@ func main() {
 		serverOpts = []grpc.ServerOption{
 			grpc.ChainUnaryInterceptor(
 				otelgrpc.UnaryServerInterceptor(),
-				authInterceptor.UnaryInterceptor(),
+				authInterceptor,
 			),
 			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
 		}
 	} else {
 		serverOpts = []grpc.ServerOption{
-			grpc.UnaryInterceptor(authInterceptor.UnaryInterceptor()),
+			grpc.UnaryInterceptor(authInterceptor),
 		}
 	}
 
 	grpcServer := grpc.NewServer(serverOpts...)
 
-	handler.RegisterAuthService(grpcServer, userHandler, authHandler)
+	handler.RegisterAuthService(grpcServer, userHandler, authHandler, accountHandler, calendarFeedHandler)
 
 	reflection.Register(grpcServer)
 
@@ -129,6 +164,47 @@ func main() {
 	log.Println("Server stopped")
 }
 
+// newLoginAttemptStore falls back to counting in memory when Redis is down at startup; sign-ins stay
+// throttled, but per replica and only until a restart
+func newLoginAttemptStore(cfg config.RedisConfig) authport.LoginAttemptStore {
+	client := redis.NewClient(&redis.Options{
+		Addr:         cfg.Addr,
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		DialTimeout:  cfg.DialTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		log.Printf("Warning: Failed to connect to Redis, throttling sign-ins in memory: %v", err)
+		client.Close()
+		return cache.NewMemoryLoginAttemptStore()
+	}
+	return cache.NewRedisLoginAttemptStore(client)
+}
+
+// loadSigningKeys falls back to an ephemeral key outside production, which only means access tokens stop verifying on restart
+// ensureUserStream creates the USERS stream auth-svc publishes into; until it exists NATS drops the events
+func ensureUserStream(conn *nats.Conn, maxAge time.Duration) {
+	js, err := jetstream.New(conn)
+	if err == nil {
+		err = sharedevents.EnsureStream(context.Background(), js, sharedevents.StreamUsers, maxAge)
+	}
+	if err != nil {
+		log.Printf("Warning: Failed to ensure the %s stream: %v", sharedevents.StreamUsers, err)
+	}
+}
+
+func loadSigningKeys(cfg *config.Config) (*authz.KeySet, error) {
+	if cfg.JWT.KeysDir == "" {
+		return authz.GenerateKeySet()
+	}
+	return authz.LoadKeySet(cfg.JWT.KeysDir, cfg.JWT.ActiveKeyID)
+}
+
 func initTracing(jaegerURL string) error {
 	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(jaegerURL)))
 	if err != nil {
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	authv1 "github.com/diploma/auth-svc/api/v1"
+	"github.com/diploma/authz"
+)
+
+var AccessPolicy = authz.Policy{
+	authv1.AuthService_Register_FullMethodName:      authz.Public(),
+	authv1.AuthService_Login_FullMethodName:         authz.Public(),
+	authv1.AuthService_ValidateToken_FullMethodName: authz.Public(),
+	authv1.AuthService_RefreshToken_FullMethodName:  authz.Public(),
+	authv1.AuthService_Logout_FullMethodName:        authz.Public(),
+	authv1.AuthService_GetJWKS_FullMethodName:       authz.Public(),
+	// Reached from links in emails, by users who may not be signed in
+	authv1.AuthService_VerifyEmail_FullMethodName:          authz.Public(),
+	authv1.AuthService_RequestPasswordReset_FullMethodName: authz.Public(),
+	authv1.AuthService_ResetPassword_FullMethodName:        authz.Public(),
+	// Looked up by notification-svc when addressing messages
+	authv1.AuthService_GetUserProfile_FullMethodName:           authz.Public(),
+	authv1.AuthService_LogoutAllDevices_FullMethodName:         authz.Authenticated(),
+	authv1.AuthService_RequestEmailVerification_FullMethodName: authz.Authenticated(),
+	authv1.AuthService_SetUserRole_FullMethodName:              authz.Require(authz.PermManageRoles),
+	authv1.AuthService_RotateCalendarFeed_FullMethodName:       authz.Authenticated(),
+	// Checked by api-gateway on feed polls, which carry the signed link instead of a bearer token
+	authv1.AuthService_GetCalendarFeedVersion_FullMethodName: authz.Public(),
+}
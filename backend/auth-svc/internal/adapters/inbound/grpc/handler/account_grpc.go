@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+
+	authv1 "github.com/diploma/auth-svc/api/v1"
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	"github.com/diploma/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccountGRPCHandler serves the flows that prove control of the account's email address
+type AccountGRPCHandler struct {
+	authv1.UnimplementedAuthServiceServer
+	requestEmailVerificationUseCase *usecase.RequestEmailVerificationUseCase
+	verifyEmailUseCase              *usecase.VerifyEmailUseCase
+	requestPasswordResetUseCase     *usecase.RequestPasswordResetUseCase
+	resetPasswordUseCase            *usecase.ResetPasswordUseCase
+}
+
+func NewAccountGRPCHandler(
+	requestEmailVerificationUseCase *usecase.RequestEmailVerificationUseCase,
+	verifyEmailUseCase *usecase.VerifyEmailUseCase,
+	requestPasswordResetUseCase *usecase.RequestPasswordResetUseCase,
+	resetPasswordUseCase *usecase.ResetPasswordUseCase,
+) *AccountGRPCHandler {
+	return &AccountGRPCHandler{
+		requestEmailVerificationUseCase: requestEmailVerificationUseCase,
+		verifyEmailUseCase:              verifyEmailUseCase,
+		requestPasswordResetUseCase:     requestPasswordResetUseCase,
+		resetPasswordUseCase:            resetPasswordUseCase,
+	}
+}
+
+func (h *AccountGRPCHandler) RequestEmailVerification(ctx context.Context, req *authv1.RequestEmailVerificationRequest) (*authv1.RequestEmailVerificationResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.requestEmailVerificationUseCase.Execute(ctx, dto.RequestEmailVerificationInput{UserID: req.UserId})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.RequestEmailVerificationResponse{AlreadyVerified: output.AlreadyVerified}, nil
+}
+
+func (h *AccountGRPCHandler) VerifyEmail(ctx context.Context, req *authv1.VerifyEmailRequest) (*authv1.VerifyEmailResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.verifyEmailUseCase.Execute(ctx, dto.VerifyEmailInput{Token: req.Token}); err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.VerifyEmailResponse{Success: true}, nil
+}
+
+func (h *AccountGRPCHandler) RequestPasswordReset(ctx context.Context, req *authv1.RequestPasswordResetRequest) (*authv1.RequestPasswordResetResponse, error) {
+	if req.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	if err := h.requestPasswordResetUseCase.Execute(ctx, dto.RequestPasswordResetInput{Email: req.Email}); err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.RequestPasswordResetResponse{Success: true}, nil
+}
+
+func (h *AccountGRPCHandler) ResetPassword(ctx context.Context, req *authv1.ResetPasswordRequest) (*authv1.ResetPasswordResponse, error) {
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	if req.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new_password is required")
+	}
+
+	input := dto.ResetPasswordInput{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}
+
+	if err := h.resetPasswordUseCase.Execute(ctx, input); err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.ResetPasswordResponse{Success: true}, nil
+}
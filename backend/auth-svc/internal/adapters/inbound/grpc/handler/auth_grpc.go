@@ -2,34 +2,51 @@ package handler
 
 import (
 	"context"
+	"math"
+	"strconv"
 
 	authv1 "github.com/diploma/auth-svc/api/v1"
 	"github.com/diploma/auth-svc/internal/application/user/dto"
 	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/diploma/authz"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 type AuthGRPCHandler struct {
 	authv1.UnimplementedAuthServiceServer
-	loginUserUseCase    *usecase.LoginUserUseCase
-	refreshTokenUseCase *usecase.RefreshTokenUseCase
-	authService         TokenValidator
-}
-
-type TokenValidator interface {
-	ValidateToken(tokenString string) (userID string, isValid bool, err error)
+	loginUserUseCase        *usecase.LoginUserUseCase
+	refreshTokenUseCase     *usecase.RefreshTokenUseCase
+	logoutUseCase           *usecase.LogoutUseCase
+	logoutAllDevicesUseCase *usecase.LogoutAllDevicesUseCase
+	setUserRoleUseCase      *usecase.SetUserRoleUseCase
+	authService             authz.Verifier
+	keys                    authz.KeySource
+	proxies                 *TrustedProxies
 }
 
 func NewAuthGRPCHandler(
 	loginUserUseCase *usecase.LoginUserUseCase,
 	refreshTokenUseCase *usecase.RefreshTokenUseCase,
-	authService TokenValidator,
+	logoutUseCase *usecase.LogoutUseCase,
+	logoutAllDevicesUseCase *usecase.LogoutAllDevicesUseCase,
+	setUserRoleUseCase *usecase.SetUserRoleUseCase,
+	authService authz.Verifier,
+	keys authz.KeySource,
+	proxies *TrustedProxies,
 ) *AuthGRPCHandler {
 	return &AuthGRPCHandler{
-		loginUserUseCase:    loginUserUseCase,
-		refreshTokenUseCase: refreshTokenUseCase,
-		authService:         authService,
+		loginUserUseCase:        loginUserUseCase,
+		refreshTokenUseCase:     refreshTokenUseCase,
+		logoutUseCase:           logoutUseCase,
+		logoutAllDevicesUseCase: logoutAllDevicesUseCase,
+		setUserRoleUseCase:      setUserRoleUseCase,
+		authService:             authService,
+		keys:                    keys,
+		proxies:                 proxies,
 	}
 }
 
@@ -44,10 +61,15 @@ func (h *AuthGRPCHandler) Login(ctx context.Context, req *authv1.LoginRequest) (
 	input := dto.LoginUserInput{
 		Email:    req.Email,
 		Password: req.Password,
+		ClientIP: h.proxies.ClientIP(ctx),
 	}
 
 	output, err := h.loginUserUseCase.Execute(ctx, input)
 	if err != nil {
+		if retryAfter := pkgerrors.GetRetryAfter(err); retryAfter > 0 {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			grpc.SetTrailer(ctx, metadata.Pairs("retry-after", strconv.Itoa(seconds)))
+		}
 		return nil, mapErrorToGRPCStatus(err)
 	}
 
@@ -63,7 +85,7 @@ func (h *AuthGRPCHandler) ValidateToken(ctx context.Context, req *authv1.Validat
 		return nil, status.Errorf(codes.InvalidArgument, "token is required")
 	}
 
-	userID, isValid, err := h.authService.ValidateToken(req.Token)
+	claims, err := h.authService.Verify(req.Token)
 	if err != nil {
 		return &authv1.ValidateTokenResponse{
 			UserId:  "",
@@ -72,8 +94,9 @@ func (h *AuthGRPCHandler) ValidateToken(ctx context.Context, req *authv1.Validat
 	}
 
 	return &authv1.ValidateTokenResponse{
-		UserId:  userID,
-		IsValid: isValid,
+		UserId:  claims.UserID,
+		IsValid: true,
+		Role:    string(claims.Role),
 	}, nil
 }
 
@@ -96,3 +119,69 @@ func (h *AuthGRPCHandler) RefreshToken(ctx context.Context, req *authv1.RefreshT
 		RefreshToken: output.RefreshToken,
 	}, nil
 }
+
+func (h *AuthGRPCHandler) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	if err := h.logoutUseCase.Execute(ctx, dto.LogoutInput{RefreshToken: req.RefreshToken}); err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.LogoutResponse{Success: true}, nil
+}
+
+func (h *AuthGRPCHandler) LogoutAllDevices(ctx context.Context, req *authv1.LogoutAllDevicesRequest) (*authv1.LogoutAllDevicesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.logoutAllDevicesUseCase.Execute(ctx, dto.LogoutAllDevicesInput{UserID: req.UserId})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.LogoutAllDevicesResponse{SessionsRevoked: int32(output.SessionsRevoked)}, nil
+}
+
+func (h *AuthGRPCHandler) SetUserRole(ctx context.Context, req *authv1.SetUserRoleRequest) (*authv1.SetUserRoleResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+
+	if err := h.setUserRoleUseCase.Execute(ctx, dto.SetUserRoleInput{UserID: req.UserId, Role: req.Role}); err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.SetUserRoleResponse{Success: true}, nil
+}
+
+func (h *AuthGRPCHandler) GetJWKS(ctx context.Context, req *authv1.GetJWKSRequest) (*authv1.GetJWKSResponse, error) {
+	jwks, err := h.keys.FetchJWKS(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load signing keys")
+	}
+
+	keys := make([]*authv1.JsonWebKey, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		keys = append(keys, &authv1.JsonWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+
+	return &authv1.GetJWKSResponse{Keys: keys}, nil
+}
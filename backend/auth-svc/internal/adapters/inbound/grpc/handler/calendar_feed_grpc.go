@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+
+	authv1 "github.com/diploma/auth-svc/api/v1"
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	"github.com/diploma/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CalendarFeedGRPCHandler keeps the per-user version api-gateway signs into calendar feed links
+type CalendarFeedGRPCHandler struct {
+	authv1.UnimplementedAuthServiceServer
+	getCalendarFeedVersionUseCase *usecase.GetCalendarFeedVersionUseCase
+	rotateCalendarFeedUseCase     *usecase.RotateCalendarFeedUseCase
+}
+
+func NewCalendarFeedGRPCHandler(
+	getCalendarFeedVersionUseCase *usecase.GetCalendarFeedVersionUseCase,
+	rotateCalendarFeedUseCase *usecase.RotateCalendarFeedUseCase,
+) *CalendarFeedGRPCHandler {
+	return &CalendarFeedGRPCHandler{
+		getCalendarFeedVersionUseCase: getCalendarFeedVersionUseCase,
+		rotateCalendarFeedUseCase:     rotateCalendarFeedUseCase,
+	}
+}
+
+func (h *CalendarFeedGRPCHandler) GetCalendarFeedVersion(ctx context.Context, req *authv1.GetCalendarFeedVersionRequest) (*authv1.GetCalendarFeedVersionResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	output, err := h.getCalendarFeedVersionUseCase.Execute(ctx, dto.CalendarFeedInput{UserID: req.UserId})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.GetCalendarFeedVersionResponse{Version: int32(output.Version)}, nil
+}
+
+func (h *CalendarFeedGRPCHandler) RotateCalendarFeed(ctx context.Context, req *authv1.RotateCalendarFeedRequest) (*authv1.RotateCalendarFeedResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.rotateCalendarFeedUseCase.Execute(ctx, dto.CalendarFeedInput{UserID: req.UserId})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &authv1.RotateCalendarFeedResponse{Version: int32(output.Version)}, nil
+}
@@ -9,14 +9,18 @@ import (
 
 type CombinedAuthService struct {
 	authv1.UnimplementedAuthServiceServer
-	userHandler *UserGRPCHandler
-	authHandler *AuthGRPCHandler
+	userHandler    *UserGRPCHandler
+	authHandler    *AuthGRPCHandler
+	accountHandler *AccountGRPCHandler
+	calendarFeeds  *CalendarFeedGRPCHandler
 }
 
-func NewCombinedAuthService(userHandler *UserGRPCHandler, authHandler *AuthGRPCHandler) *CombinedAuthService {
+func NewCombinedAuthService(userHandler *UserGRPCHandler, authHandler *AuthGRPCHandler, accountHandler *AccountGRPCHandler, calendarFeeds *CalendarFeedGRPCHandler) *CombinedAuthService {
 	return &CombinedAuthService{
-		userHandler: userHandler,
-		authHandler: authHandler,
+		userHandler:    userHandler,
+		authHandler:    authHandler,
+		accountHandler: accountHandler,
+		calendarFeeds:  calendarFeeds,
 	}
 }
 
@@ -40,7 +44,47 @@ func (s *CombinedAuthService) RefreshToken(ctx context.Context, req *authv1.Refr
 	return s.authHandler.RefreshToken(ctx, req)
 }
 
-func RegisterAuthService(server *grpc.Server, userHandler *UserGRPCHandler, authHandler *AuthGRPCHandler) {
-	combinedService := NewCombinedAuthService(userHandler, authHandler)
+func (s *CombinedAuthService) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	return s.authHandler.Logout(ctx, req)
+}
+
+func (s *CombinedAuthService) LogoutAllDevices(ctx context.Context, req *authv1.LogoutAllDevicesRequest) (*authv1.LogoutAllDevicesResponse, error) {
+	return s.authHandler.LogoutAllDevices(ctx, req)
+}
+
+func (s *CombinedAuthService) SetUserRole(ctx context.Context, req *authv1.SetUserRoleRequest) (*authv1.SetUserRoleResponse, error) {
+	return s.authHandler.SetUserRole(ctx, req)
+}
+
+func (s *CombinedAuthService) GetJWKS(ctx context.Context, req *authv1.GetJWKSRequest) (*authv1.GetJWKSResponse, error) {
+	return s.authHandler.GetJWKS(ctx, req)
+}
+
+func (s *CombinedAuthService) RequestEmailVerification(ctx context.Context, req *authv1.RequestEmailVerificationRequest) (*authv1.RequestEmailVerificationResponse, error) {
+	return s.accountHandler.RequestEmailVerification(ctx, req)
+}
+
+func (s *CombinedAuthService) VerifyEmail(ctx context.Context, req *authv1.VerifyEmailRequest) (*authv1.VerifyEmailResponse, error) {
+	return s.accountHandler.VerifyEmail(ctx, req)
+}
+
+func (s *CombinedAuthService) RequestPasswordReset(ctx context.Context, req *authv1.RequestPasswordResetRequest) (*authv1.RequestPasswordResetResponse, error) {
+	return s.accountHandler.RequestPasswordReset(ctx, req)
+}
+
+func (s *CombinedAuthService) ResetPassword(ctx context.Context, req *authv1.ResetPasswordRequest) (*authv1.ResetPasswordResponse, error) {
+	return s.accountHandler.ResetPassword(ctx, req)
+}
+
+func (s *CombinedAuthService) GetCalendarFeedVersion(ctx context.Context, req *authv1.GetCalendarFeedVersionRequest) (*authv1.GetCalendarFeedVersionResponse, error) {
+	return s.calendarFeeds.GetCalendarFeedVersion(ctx, req)
+}
+
+func (s *CombinedAuthService) RotateCalendarFeed(ctx context.Context, req *authv1.RotateCalendarFeedRequest) (*authv1.RotateCalendarFeedResponse, error) {
+	return s.calendarFeeds.RotateCalendarFeed(ctx, req)
+}
+
+func RegisterAuthService(server *grpc.Server, userHandler *UserGRPCHandler, authHandler *AuthGRPCHandler, accountHandler *AccountGRPCHandler, calendarFeeds *CalendarFeedGRPCHandler) {
+	combinedService := NewCombinedAuthService(userHandler, authHandler, accountHandler, calendarFeeds)
 	authv1.RegisterAuthServiceServer(server, combinedService)
 }
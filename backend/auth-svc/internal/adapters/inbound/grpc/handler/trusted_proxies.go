@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// TrustedProxies lists the callers whose x-forwarded-for header is believed. Entries are IPs, CIDRs
+// or host names; host names are looked up on every check since container addresses change.
+type TrustedProxies struct {
+	networks []*net.IPNet
+	hosts    []string
+	resolver *net.Resolver
+}
+
+func NewTrustedProxies(entries []string) (*TrustedProxies, error) {
+	proxies := &TrustedProxies{resolver: net.DefaultResolver}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+			continue
+		case strings.Contains(entry, "/"):
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+			proxies.networks = append(proxies.networks, network)
+		case net.ParseIP(entry) != nil:
+			ip := net.ParseIP(entry)
+			bits := 8 * len(ip.To4())
+			if bits == 0 {
+				bits = 8 * net.IPv6len
+			}
+			proxies.networks = append(proxies.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+		default:
+			proxies.hosts = append(proxies.hosts, entry)
+		}
+	}
+	return proxies, nil
+}
+
+func (p *TrustedProxies) trusts(ctx context.Context, ip net.IP) bool {
+	if p == nil || ip == nil {
+		return false
+	}
+	for _, network := range p.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	for _, host := range p.hosts {
+		addrs, err := p.resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if addr.IP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ClientIP is the peer's address, or the address a trusted proxy forwards for it; anyone else could
+// otherwise pick the address their failed logins count against
+func (p *TrustedProxies) ClientIP(ctx context.Context) string {
+	var peerIP string
+	if pr, ok := peer.FromContext(ctx); ok && pr.Addr != nil {
+		peerIP = pr.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+
+	if !p.trusts(ctx, net.ParseIP(peerIP)) {
+		return peerIP
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			first, _, _ := strings.Cut(forwarded[0], ",")
+			if ip := strings.TrimSpace(first); ip != "" {
+				return ip
+			}
+		}
+	}
+	return peerIP
+}
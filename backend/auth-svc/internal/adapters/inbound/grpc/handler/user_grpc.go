@@ -67,11 +67,13 @@ func (h *UserGRPCHandler) GetUserProfile(ctx context.Context, req *authv1.GetUse
 	}
 
 	return &authv1.GetUserProfileResponse{
-		UserId:    output.User.ID,
-		FullName:  output.User.FullName,
-		Email:     output.User.Email,
-		Phone:     output.User.Phone,
-		CreatedAt: output.User.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UserId:        output.User.ID,
+		FullName:      output.User.FullName,
+		Email:         output.User.Email,
+		Phone:         output.User.Phone,
+		Role:          output.User.Role,
+		EmailVerified: output.User.EmailVerified,
+		CreatedAt:     output.User.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
 }
 
@@ -95,6 +97,8 @@ func mapErrorToGRPCStatus(err error) error {
 			return status.Errorf(codes.Unauthenticated, msg)
 		case pkgerrors.CodePermissionDenied:
 			return status.Errorf(codes.PermissionDenied, msg)
+		case pkgerrors.CodeResourceExhausted:
+			return status.Errorf(codes.ResourceExhausted, msg)
 		default:
 			return status.Errorf(codes.Internal, "internal server error")
 		}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryLoginAttemptStore throttles within a single process. It stands in for Redis in tests and when
+// Redis is unreachable at startup, at the cost of every replica counting on its own.
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	failures map[string][]time.Time
+	lockouts map[string]memoryLockouts
+	locks    map[string]time.Time
+}
+
+type memoryLockouts struct {
+	count     int
+	expiresAt time.Time
+}
+
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		failures: make(map[string][]time.Time),
+		lockouts: make(map[string]memoryLockouts),
+		locks:    make(map[string]time.Time),
+	}
+}
+
+func (s *MemoryLoginAttemptStore) AddFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cutoff := at.Add(-window)
+	recent := s.failures[key][:0]
+	for _, failure := range s.failures[key] {
+		if !failure.Before(cutoff) {
+			recent = append(recent, failure)
+		}
+	}
+	s.failures[key] = append(recent, at)
+	return len(s.failures[key]), nil
+}
+
+func (s *MemoryLoginAttemptStore) ClearFailures(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.failures, key)
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) AddLockout(ctx context.Context, key string, memory time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	lockouts := s.lockouts[key]
+	if now.After(lockouts.expiresAt) {
+		lockouts.count = 0
+	}
+	lockouts.count++
+	lockouts.expiresAt = now.Add(memory)
+	s.lockouts[key] = lockouts
+	return lockouts.count, nil
+}
+
+func (s *MemoryLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks[key] = until
+	return nil
+}
+
+func (s *MemoryLoginAttemptStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	until, ok := s.locks[key]
+	if !ok {
+		return time.Time{}, nil
+	}
+	if !time.Now().Before(until) {
+		delete(s.locks, key)
+		return time.Time{}, nil
+	}
+	return until, nil
+}
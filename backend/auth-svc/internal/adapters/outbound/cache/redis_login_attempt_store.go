@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const loginKeyPrefix = "auth:login:"
+
+// RedisLoginAttemptStore shares sign-in throttling between auth-svc replicas. Failures are a sorted
+// set scored by time, so the window slides instead of resetting; every key expires on its own.
+type RedisLoginAttemptStore struct {
+	client *redis.Client
+}
+
+func NewRedisLoginAttemptStore(client *redis.Client) *RedisLoginAttemptStore {
+	return &RedisLoginAttemptStore{client: client}
+}
+
+func (s *RedisLoginAttemptStore) AddFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	failuresKey := loginKeyPrefix + key + ":failures"
+	cutoff := at.Add(-window).UnixMilli()
+
+	var count *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, failuresKey, "-inf", "("+strconv.FormatInt(cutoff, 10))
+		pipe.ZAdd(ctx, failuresKey, redis.Z{Score: float64(at.UnixMilli()), Member: uuid.NewString()})
+		count = pipe.ZCard(ctx, failuresKey)
+		pipe.PExpire(ctx, failuresKey, window)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to record failure: %w", err)
+	}
+	return int(count.Val()), nil
+}
+
+func (s *RedisLoginAttemptStore) ClearFailures(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, loginKeyPrefix+key+":failures").Err(); err != nil {
+		return fmt.Errorf("failed to clear failures: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisLoginAttemptStore) AddLockout(ctx context.Context, key string, memory time.Duration) (int, error) {
+	lockoutsKey := loginKeyPrefix + key + ":lockouts"
+
+	var count *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Incr(ctx, lockoutsKey)
+		pipe.PExpire(ctx, lockoutsKey, memory)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count lockout: %w", err)
+	}
+	return int(count.Val()), nil
+}
+
+func (s *RedisLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	err := s.client.Set(ctx, loginKeyPrefix+key+":lock", until.UnixMilli(), time.Until(until)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to lock: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisLoginAttemptStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	value, err := s.client.Get(ctx, loginKeyPrefix+key+":lock").Int64()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read lock: %w", err)
+	}
+	return time.UnixMilli(value), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/diploma/auth-svc/internal/domain/auth/entity"
 	"github.com/diploma/auth-svc/internal/domain/auth/port"
@@ -11,6 +12,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	userTokensTable       = "user_tokens"
+	userActionTokensTable = "user_action_tokens"
+)
+
 type AuthRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -22,11 +28,15 @@ func NewAuthRepository(db *gorm.DB) port.AuthRepository {
 }
 
 func (r *AuthRepositoryImpl) SaveRefreshToken(ctx context.Context, token *entity.Token) error {
+	return saveToken(r.db.WithContext(ctx), token)
+}
+
+func saveToken(db *gorm.DB, token *entity.Token) error {
 	if token.ID == uuid.Nil {
 		token.ID = uuid.New()
 	}
 
-	result := r.db.WithContext(ctx).Create(token)
+	result := db.Table(userTokensTable).Create(token)
 	if result.Error != nil {
 		return fmt.Errorf("failed to save refresh token: %w", result.Error)
 	}
@@ -34,13 +44,13 @@ func (r *AuthRepositoryImpl) SaveRefreshToken(ctx context.Context, token *entity
 	return nil
 }
 
-func (r *AuthRepositoryImpl) GetRefreshToken(ctx context.Context, refreshToken string) (*entity.Token, error) {
+func (r *AuthRepositoryImpl) GetRefreshToken(ctx context.Context, tokenHash string) (*entity.Token, error) {
 	var token entity.Token
-	result := r.db.WithContext(ctx).Where("refresh_token = ?", refreshToken).First(&token)
+	result := r.db.WithContext(ctx).Table(userTokensTable).Where("token_hash = ?", tokenHash).First(&token)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("refresh token not found: %w", result.Error)
+			return nil, port.ErrRefreshTokenNotFound
 		}
 		return nil, fmt.Errorf("failed to get refresh token: %w", result.Error)
 	}
@@ -48,16 +58,117 @@ func (r *AuthRepositoryImpl) GetRefreshToken(ctx context.Context, refreshToken s
 	return &token, nil
 }
 
-func (r *AuthRepositoryImpl) DeleteRefreshToken(ctx context.Context, refreshToken string) error {
-	result := r.db.WithContext(ctx).Where("refresh_token = ?", refreshToken).Delete(&entity.Token{})
+func (r *AuthRepositoryImpl) RotateRefreshToken(ctx context.Context, current *entity.Token, next *entity.Token) (bool, error) {
+	rotated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Table(userTokensTable).
+			Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", current.ID).
+			Update("rotated_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("failed to rotate refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := saveToken(tx, next); err != nil {
+			return err
+		}
+		current.RotatedAt = &now
+		rotated = true
+		return nil
+	})
+
+	return rotated, err
+}
+
+func (r *AuthRepositoryImpl) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Table(userTokensTable).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now())
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to delete refresh token: %w", result.Error)
+		return fmt.Errorf("failed to revoke refresh token family: %w", result.Error)
 	}
 
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("refresh token not found")
+	return nil
+}
+
+func (r *AuthRepositoryImpl) RevokeUserTokens(ctx context.Context, userID uuid.UUID) (int, error) {
+	now := time.Now()
+	var active int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Each family has at most one unrotated token, so active tokens are active sessions
+		count := tx.Table(userTokensTable).
+			Where("user_id = ? AND revoked_at IS NULL AND rotated_at IS NULL AND expires_at > ?", userID, now).
+			Count(&active)
+		if count.Error != nil {
+			return fmt.Errorf("failed to count refresh tokens: %w", count.Error)
+		}
+
+		result := tx.Table(userTokensTable).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", result.Error)
+		}
+		return nil
+	})
+
+	return int(active), err
+}
+
+func (r *AuthRepositoryImpl) SaveActionToken(ctx context.Context, token *entity.ActionToken) error {
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
 	}
 
-	return nil
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only the most recently mailed link works
+		result := tx.Table(userActionTokensTable).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", token.UserID, token.Purpose).
+			Update("used_at", token.CreatedAt)
+		if result.Error != nil {
+			return fmt.Errorf("failed to invalidate earlier %s tokens: %w", token.Purpose, result.Error)
+		}
+
+		if err := tx.Table(userActionTokensTable).Create(token).Error; err != nil {
+			return fmt.Errorf("failed to save %s token: %w", token.Purpose, err)
+		}
+		return nil
+	})
+}
+
+func (r *AuthRepositoryImpl) ConsumeActionToken(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, now time.Time) (*entity.ActionToken, error) {
+	var token entity.ActionToken
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(userActionTokensTable).
+			Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, purpose, now).
+			First(&token)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return port.ErrActionTokenNotFound
+			}
+			return fmt.Errorf("failed to get %s token: %w", purpose, result.Error)
+		}
+
+		// Conditional on used_at so two concurrent requests cannot both spend the token
+		update := tx.Table(userActionTokensTable).
+			Where("id = ? AND used_at IS NULL", token.ID).
+			Update("used_at", now)
+		if update.Error != nil {
+			return fmt.Errorf("failed to consume %s token: %w", purpose, update.Error)
+		}
+		if update.RowsAffected == 0 {
+			return port.ErrActionTokenNotFound
+		}
+		token.UsedAt = &now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
 }
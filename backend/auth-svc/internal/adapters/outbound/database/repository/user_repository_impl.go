@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/diploma/auth-svc/internal/domain/user/entity"
 	"github.com/diploma/auth-svc/internal/domain/user/port"
+	"github.com/diploma/authz"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -69,3 +71,53 @@ func (r *UserRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Us
 
 	return &user, nil
 }
+
+func (r *UserRepositoryImpl) UpdateRole(ctx context.Context, id uuid.UUID, role authz.Role) error {
+	result := r.db.WithContext(ctx).Model(&entity.User{}).Where("id = ?", id).Update("role", role)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update user role: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryImpl) MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&entity.User{}).
+		Where("id = ? AND email_verified_at IS NULL", id).
+		Update("email_verified_at", verifiedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark email verified: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryImpl) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	result := r.db.WithContext(ctx).Model(&entity.User{}).Where("id = ?", id).Update("password_hash", passwordHash)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update password: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryImpl) BumpCalendarFeedVersion(ctx context.Context, id uuid.UUID) (int, error) {
+	var version int
+	result := r.db.WithContext(ctx).
+		Raw("UPDATE users SET calendar_feed_version = calendar_feed_version + 1 WHERE id = ? RETURNING calendar_feed_version", id).
+		Scan(&version)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to bump calendar feed version: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return 0, fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)
+	}
+
+	return version, nil
+}
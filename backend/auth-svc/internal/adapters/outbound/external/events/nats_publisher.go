@@ -0,0 +1,116 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	sharedevents "github.com/diploma/events"
+	"github.com/nats-io/nats.go"
+)
+
+// NATSEventPublisher publishes straight to NATS rather than through an outbox: a lost revocation
+// only lets an access token live out its short TTL, and a lost email can be requested again.
+// Account tokens are sealed first, so the stream never holds one a reader could redeem.
+type NATSEventPublisher struct {
+	conn   *nats.Conn
+	codec  *sharedevents.Codec
+	sealer *sharedevents.Sealer
+}
+
+func NewNATSEventPublisher(conn *nats.Conn, codec *sharedevents.Codec, sealer *sharedevents.Sealer) *NATSEventPublisher {
+	return &NATSEventPublisher{
+		conn:   conn,
+		codec:  codec,
+		sealer: sealer,
+	}
+}
+
+func (p *NATSEventPublisher) PublishTokensRevoked(ctx context.Context, userID string, revokedBefore time.Time, reason string) error {
+	return p.publish(ctx, sharedevents.SubjectUserTokensRevoked, sharedevents.UserTokensRevoked{
+		UserID:        userID,
+		RevokedBefore: revokedBefore.UTC(),
+		Reason:        reason,
+	})
+}
+
+func (p *NATSEventPublisher) PublishEmailVerificationRequested(ctx context.Context, userID, email, token string, expiresAt time.Time) error {
+	sealed, err := p.sealer.Seal(sharedevents.SubjectUserEmailVerificationRequested, token)
+	if err != nil {
+		return fmt.Errorf("failed to seal token: %w", err)
+	}
+
+	return p.publish(ctx, sharedevents.SubjectUserEmailVerificationRequested, sharedevents.UserEmailVerificationRequested{
+		UserID:      userID,
+		Email:       email,
+		SealedToken: sealed,
+		ExpiresAt:   expiresAt.UTC(),
+	})
+}
+
+func (p *NATSEventPublisher) PublishEmailVerified(ctx context.Context, userID, email string, verifiedAt time.Time) error {
+	return p.publish(ctx, sharedevents.SubjectUserEmailVerified, sharedevents.UserEmailVerified{
+		UserID:     userID,
+		Email:      email,
+		VerifiedAt: verifiedAt.UTC(),
+	})
+}
+
+func (p *NATSEventPublisher) PublishPasswordResetRequested(ctx context.Context, userID, email, token string, expiresAt time.Time) error {
+	sealed, err := p.sealer.Seal(sharedevents.SubjectUserPasswordResetRequested, token)
+	if err != nil {
+		return fmt.Errorf("failed to seal token: %w", err)
+	}
+
+	return p.publish(ctx, sharedevents.SubjectUserPasswordResetRequested, sharedevents.UserPasswordResetRequested{
+		UserID:      userID,
+		Email:       email,
+		SealedToken: sealed,
+		ExpiresAt:   expiresAt.UTC(),
+	})
+}
+
+func (p *NATSEventPublisher) PublishPasswordChanged(ctx context.Context, userID, email string, changedAt time.Time) error {
+	return p.publish(ctx, sharedevents.SubjectUserPasswordChanged, sharedevents.UserPasswordChanged{
+		UserID:    userID,
+		Email:     email,
+		ChangedAt: changedAt.UTC(),
+	})
+}
+
+func (p *NATSEventPublisher) PublishLoginLocked(ctx context.Context, lockout *entity.LoginLockout) error {
+	return p.publish(ctx, sharedevents.SubjectUserLoginLocked, sharedevents.UserLoginLocked{
+		Scope:       string(lockout.Scope),
+		UserID:      lockout.UserID,
+		Email:       lockout.Email,
+		ClientIP:    lockout.ClientIP,
+		Failures:    lockout.Failures,
+		Lockouts:    lockout.Lockouts,
+		LockedUntil: lockout.LockedUntil.UTC(),
+	})
+}
+
+func (p *NATSEventPublisher) publish(ctx context.Context, subject string, payload interface{}) error {
+	if p.conn == nil {
+		return errors.New("not connected to NATS")
+	}
+
+	envelope, err := p.codec.NewEnvelope(ctx, subject, payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Header.Set(nats.MsgIdHdr, envelope.ID)
+	msg.Data = data
+
+	return p.conn.PublishMsg(msg)
+}
@@ -3,11 +3,13 @@ package dto
 import "time"
 
 type UserDTO struct {
-	ID        string
-	FullName  string
-	Email     string
-	Phone     string
-	CreatedAt time.Time
+	ID            string
+	FullName      string
+	Email         string
+	Phone         string
+	Role          string
+	EmailVerified bool
+	CreatedAt     time.Time
 }
 
 type RegisterUserInput struct {
@@ -24,6 +26,7 @@ type RegisterUserOutput struct {
 type LoginUserInput struct {
 	Email    string
 	Password string
+	ClientIP string // Empty when unknown; only the account is throttled then
 }
 
 type LoginUserOutput struct {
@@ -41,6 +44,44 @@ type RefreshTokenOutput struct {
 	RefreshToken string
 }
 
+type LogoutInput struct {
+	RefreshToken string
+}
+
+type LogoutAllDevicesInput struct {
+	UserID string
+}
+
+type LogoutAllDevicesOutput struct {
+	SessionsRevoked int
+}
+
+type SetUserRoleInput struct {
+	UserID string
+	Role   string
+}
+
+type RequestEmailVerificationInput struct {
+	UserID string
+}
+
+type RequestEmailVerificationOutput struct {
+	AlreadyVerified bool
+}
+
+type VerifyEmailInput struct {
+	Token string
+}
+
+type RequestPasswordResetInput struct {
+	Email string
+}
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
 type GetUserProfileInput struct {
 	UserID string
 }
@@ -48,3 +89,11 @@ type GetUserProfileInput struct {
 type GetUserProfileOutput struct {
 	User UserDTO
 }
+
+type CalendarFeedInput struct {
+	UserID string
+}
+
+type CalendarFeedOutput struct {
+	Version int
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type GetCalendarFeedVersionUseCase struct {
+	userService *service.UserService
+}
+
+func NewGetCalendarFeedVersionUseCase(userService *service.UserService) *GetCalendarFeedVersionUseCase {
+	return &GetCalendarFeedVersionUseCase{
+		userService: userService,
+	}
+}
+
+func (uc *GetCalendarFeedVersionUseCase) Execute(ctx context.Context, input dto.CalendarFeedInput) (*dto.CalendarFeedOutput, error) {
+	if _, err := uuid.Parse(input.UserID); err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	user, err := uc.userService.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewNotFoundError("user not found")
+	}
+
+	return &dto.CalendarFeedOutput{Version: user.CalendarFeedVersion}, nil
+}
+
+type RotateCalendarFeedUseCase struct {
+	userService *service.UserService
+}
+
+func NewRotateCalendarFeedUseCase(userService *service.UserService) *RotateCalendarFeedUseCase {
+	return &RotateCalendarFeedUseCase{
+		userService: userService,
+	}
+}
+
+// Execute revokes the user's calendar feed links, for when one was shared or leaked
+func (uc *RotateCalendarFeedUseCase) Execute(ctx context.Context, input dto.CalendarFeedInput) (*dto.CalendarFeedOutput, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	version, err := uc.userService.RotateCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.NewInternalError("failed to rotate calendar feed", err)
+	}
+
+	return &dto.CalendarFeedOutput{Version: version}, nil
+}
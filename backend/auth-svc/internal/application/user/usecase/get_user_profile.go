@@ -26,11 +26,13 @@ func (uc *GetUserProfileUseCase) Execute(ctx context.Context, input dto.GetUserP
 
 	return &dto.GetUserProfileOutput{
 		User: dto.UserDTO{
-			ID:        user.ID.String(),
-			FullName:  user.FullName,
-			Email:     user.Email,
-			Phone:     user.Phone,
-			CreatedAt: user.CreatedAt,
+			ID:            user.ID.String(),
+			FullName:      user.FullName,
+			Email:         user.Email,
+			Phone:         user.Phone,
+			Role:          string(user.Role),
+			EmailVerified: user.IsEmailVerified(),
+			CreatedAt:     user.CreatedAt,
 		},
 	}, nil
 }
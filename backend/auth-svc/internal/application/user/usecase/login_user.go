@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/diploma/auth-svc/internal/application/user/dto"
@@ -13,26 +14,35 @@ import (
 type LoginUserUseCase struct {
 	userService *userservice.UserService
 	authService *authservice.AuthService
+	loginGuard  *authservice.LoginGuard
 }
 
-func NewLoginUserUseCase(userService *userservice.UserService, authService *authservice.AuthService) *LoginUserUseCase {
+func NewLoginUserUseCase(userService *userservice.UserService, authService *authservice.AuthService, loginGuard *authservice.LoginGuard) *LoginUserUseCase {
 	return &LoginUserUseCase{
 		userService: userService,
 		authService: authService,
+		loginGuard:  loginGuard,
 	}
 }
 
 func (uc *LoginUserUseCase) Execute(ctx context.Context, input dto.LoginUserInput) (*dto.LoginUserOutput, error) {
+	// A locked key is refused before the password is checked, so guesses made during a lockout reveal nothing
+	if err := uc.loginGuard.Check(ctx, input.Email, input.ClientIP); err != nil {
+		return nil, lockedOut(err)
+	}
+
 	user, err := uc.userService.GetByEmail(ctx, input.Email)
 	if err != nil {
-		return nil, pkgerrors.NewUnauthenticatedError("invalid email or password")
+		return nil, uc.rejectCredentials(ctx, "", input)
 	}
 
 	if err := uc.userService.ValidatePassword(ctx, user, input.Password); err != nil {
-		return nil, pkgerrors.NewUnauthenticatedError("invalid email or password")
+		return nil, uc.rejectCredentials(ctx, user.ID.String(), input)
 	}
 
-	accessToken, err := uc.authService.GenerateToken(user.ID.String(), user.Email)
+	uc.loginGuard.RecordSuccess(ctx, input.Email)
+
+	accessToken, err := uc.authService.GenerateToken(user.ID.String(), user.Email, user.Role, user.IsEmailVerified())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -52,3 +62,18 @@ func (uc *LoginUserUseCase) Execute(ctx context.Context, input dto.LoginUserInpu
 		UserID:       user.ID.String(),
 	}, nil
 }
+
+func (uc *LoginUserUseCase) rejectCredentials(ctx context.Context, userID string, input dto.LoginUserInput) error {
+	if err := uc.loginGuard.RecordFailure(ctx, userID, input.Email, input.ClientIP); err != nil {
+		return lockedOut(err)
+	}
+	return pkgerrors.NewUnauthenticatedError("invalid email or password")
+}
+
+func lockedOut(err error) error {
+	var lockout *authservice.LockoutError
+	if errors.As(err, &lockout) {
+		return pkgerrors.NewResourceExhaustedError("too many failed sign-in attempts, try again later", lockout.RetryAfter)
+	}
+	return pkgerrors.NewInternalError("failed to check sign-in attempts", err)
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+)
+
+type LogoutUseCase struct {
+	authService *authservice.AuthService
+}
+
+func NewLogoutUseCase(authService *authservice.AuthService) *LogoutUseCase {
+	return &LogoutUseCase{
+		authService: authService,
+	}
+}
+
+// Execute signs out the device holding the refresh token; access tokens already issued stay valid until they expire
+func (uc *LogoutUseCase) Execute(ctx context.Context, input dto.LogoutInput) error {
+	if err := uc.authService.RevokeRefreshToken(ctx, input.RefreshToken); err != nil {
+		return pkgerrors.NewInternalError("failed to log out", err)
+	}
+	return nil
+}
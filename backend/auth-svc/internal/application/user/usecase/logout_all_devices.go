@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type LogoutAllDevicesUseCase struct {
+	authService *authservice.AuthService
+	userService *userservice.UserService
+}
+
+func NewLogoutAllDevicesUseCase(authService *authservice.AuthService, userService *userservice.UserService) *LogoutAllDevicesUseCase {
+	return &LogoutAllDevicesUseCase{
+		authService: authService,
+		userService: userService,
+	}
+}
+
+// Execute revokes every session of the user along with their calendar feed links
+
+func (uc *LogoutAllDevicesUseCase) Execute(ctx context.Context, input dto.LogoutAllDevicesInput) (*dto.LogoutAllDevicesOutput, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	revoked, err := uc.authService.RevokeUserRefreshTokens(ctx, input.UserID, authservice.RevocationLogoutAll)
+	if err != nil {
+		return nil, pkgerrors.NewInternalError("failed to log out all devices", err)
+	}
+
+	if _, err := uc.userService.RotateCalendarFeed(ctx, userID); err != nil {
+		return nil, pkgerrors.NewInternalError("sessions were revoked but revoking calendar feed links failed", err)
+	}
+
+	return &dto.LogoutAllDevicesOutput{SessionsRevoked: revoked}, nil
+}
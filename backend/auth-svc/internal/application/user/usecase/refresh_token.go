@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/diploma/auth-svc/internal/application/user/dto"
@@ -23,9 +24,16 @@ func NewRefreshTokenUseCase(authService *authservice.AuthService, userService *u
 }
 
 func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input dto.RefreshTokenInput) (*dto.RefreshTokenOutput, error) {
-	userID, err := uc.authService.ValidateRefreshToken(ctx, input.RefreshToken)
+	userID, newRefreshToken, err := uc.authService.RotateRefreshToken(ctx, input.RefreshToken)
 	if err != nil {
-		return nil, pkgerrors.NewUnauthenticatedError("invalid or expired refresh token")
+		switch {
+		case errors.Is(err, authservice.ErrRefreshTokenReused):
+			return nil, pkgerrors.NewUnauthenticatedError("refresh token was already used; all sessions from that login have been signed out")
+		case errors.Is(err, authservice.ErrRefreshTokenInvalid), errors.Is(err, authservice.ErrRefreshTokenExpired):
+			return nil, pkgerrors.NewUnauthenticatedError("invalid or expired refresh token")
+		default:
+			return nil, pkgerrors.NewInternalError("failed to refresh token", err)
+		}
 	}
 
 	user, err := uc.userService.GetByID(ctx, userID)
@@ -33,27 +41,13 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input dto.RefreshTok
 		return nil, pkgerrors.NewUnauthenticatedError("user not found")
 	}
 
-	accessToken, err := uc.authService.GenerateToken(user.ID.String(), user.Email)
+	accessToken, err := uc.authService.GenerateToken(user.ID.String(), user.Email, user.Role, user.IsEmailVerified())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	newRefreshToken, err := uc.authService.GenerateRefreshToken()
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
-	}
-
-	if err := uc.authService.DeleteRefreshToken(ctx, input.RefreshToken); err != nil {
-		fmt.Printf("Warning: Failed to delete old refresh token: %v\n", err)
-	}
-
-	if err := uc.authService.SaveRefreshToken(ctx, user.ID.String(), newRefreshToken); err != nil {
-		return nil, fmt.Errorf("failed to save refresh token: %w", err)
-	}
-
 	return &dto.RefreshTokenOutput{
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
 	}, nil
 }
-
@@ -3,18 +3,21 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/diploma/auth-svc/internal/application/user/dto"
 	"github.com/diploma/auth-svc/internal/domain/user/service"
 )
 
 type RegisterUserUseCase struct {
-	userService *service.UserService
+	userService       *service.UserService
+	emailVerification *RequestEmailVerificationUseCase
 }
 
-func NewRegisterUserUseCase(userService *service.UserService) *RegisterUserUseCase {
+func NewRegisterUserUseCase(userService *service.UserService, emailVerification *RequestEmailVerificationUseCase) *RegisterUserUseCase {
 	return &RegisterUserUseCase{
-		userService: userService,
+		userService:       userService,
+		emailVerification: emailVerification,
 	}
 }
 
@@ -24,6 +27,11 @@ func (uc *RegisterUserUseCase) Execute(ctx context.Context, input dto.RegisterUs
 		return nil, fmt.Errorf("failed to register user: %w", err)
 	}
 
+	// The account is usable without it; the user can ask for another link
+	if err := uc.emailVerification.send(ctx, user); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", user.ID, err)
+	}
+
 	return &dto.RegisterUserOutput{
 		UserID: user.ID.String(),
 	}, nil
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authentity "github.com/diploma/auth-svc/internal/domain/auth/entity"
+	authport "github.com/diploma/auth-svc/internal/domain/auth/port"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userentity "github.com/diploma/auth-svc/internal/domain/user/entity"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type RequestEmailVerificationUseCase struct {
+	userService *userservice.UserService
+	authService *authservice.AuthService
+	events      authport.EventPublisher
+}
+
+func NewRequestEmailVerificationUseCase(userService *userservice.UserService, authService *authservice.AuthService, events authport.EventPublisher) *RequestEmailVerificationUseCase {
+	return &RequestEmailVerificationUseCase{
+		userService: userService,
+		authService: authService,
+		events:      events,
+	}
+}
+
+// Execute mails the user a fresh verification link, invalidating any earlier one
+func (uc *RequestEmailVerificationUseCase) Execute(ctx context.Context, input dto.RequestEmailVerificationInput) (*dto.RequestEmailVerificationOutput, error) {
+	if _, err := uuid.Parse(input.UserID); err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	user, err := uc.userService.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewNotFoundError("user not found")
+	}
+	if user.IsEmailVerified() {
+		return &dto.RequestEmailVerificationOutput{AlreadyVerified: true}, nil
+	}
+
+	if err := uc.send(ctx, user); err != nil {
+		return nil, pkgerrors.NewInternalError("failed to send verification email", err)
+	}
+
+	return &dto.RequestEmailVerificationOutput{}, nil
+}
+
+func (uc *RequestEmailVerificationUseCase) send(ctx context.Context, user *userentity.User) error {
+	token, expiresAt, err := uc.authService.IssueActionToken(ctx, user.ID, authentity.PurposeEmailVerification)
+	if err != nil {
+		return err
+	}
+	return uc.events.PublishEmailVerificationRequested(ctx, user.ID.String(), user.Email, token, expiresAt)
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authentity "github.com/diploma/auth-svc/internal/domain/auth/entity"
+	authport "github.com/diploma/auth-svc/internal/domain/auth/port"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+)
+
+type RequestPasswordResetUseCase struct {
+	userService *userservice.UserService
+	authService *authservice.AuthService
+	events      authport.EventPublisher
+}
+
+func NewRequestPasswordResetUseCase(userService *userservice.UserService, authService *authservice.AuthService, events authport.EventPublisher) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userService: userService,
+		authService: authService,
+		events:      events,
+	}
+}
+
+// Execute mails a reset link if the address belongs to an account. It succeeds either way so
+// callers cannot use it to find out which addresses are registered.
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, input dto.RequestPasswordResetInput) error {
+	if input.Email == "" {
+		return pkgerrors.NewInvalidArgumentError("email is required")
+	}
+
+	user, err := uc.userService.GetByEmail(ctx, input.Email)
+	if err != nil {
+		return nil
+	}
+
+	token, expiresAt, err := uc.authService.IssueActionToken(ctx, user.ID, authentity.PurposePasswordReset)
+	if err != nil {
+		return pkgerrors.NewInternalError("failed to request password reset", err)
+	}
+
+	if err := uc.events.PublishPasswordResetRequested(ctx, user.ID.String(), user.Email, token, expiresAt); err != nil {
+		return pkgerrors.NewInternalError("failed to send password reset email", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authentity "github.com/diploma/auth-svc/internal/domain/auth/entity"
+	authport "github.com/diploma/auth-svc/internal/domain/auth/port"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+)
+
+type ResetPasswordUseCase struct {
+	userService *userservice.UserService
+	authService *authservice.AuthService
+	events      authport.EventPublisher
+}
+
+func NewResetPasswordUseCase(userService *userservice.UserService, authService *authservice.AuthService, events authport.EventPublisher) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userService: userService,
+		authService: authService,
+		events:      events,
+	}
+}
+
+// Execute sets a new password with a reset token and signs the user out everywhere, calendar feed
+// links included, since whoever knew the old password may still hold a session
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input dto.ResetPasswordInput) error {
+	if input.Token == "" {
+		return pkgerrors.NewInvalidArgumentError("token is required")
+	}
+	if input.NewPassword == "" {
+		return pkgerrors.NewInvalidArgumentError("new_password is required")
+	}
+
+	userID, err := uc.authService.ConsumeActionToken(ctx, authentity.PurposePasswordReset, input.Token)
+	if err != nil {
+		if errors.Is(err, authservice.ErrActionTokenInvalid) {
+			return pkgerrors.NewInvalidArgumentError("invalid or expired reset token")
+		}
+		return pkgerrors.NewInternalError("failed to reset password", err)
+	}
+
+	user, err := uc.userService.GetByID(ctx, userID.String())
+	if err != nil {
+		return pkgerrors.NewNotFoundError("user not found")
+	}
+
+	if err := uc.userService.SetPassword(ctx, user, input.NewPassword); err != nil {
+		if pkgerrors.IsDomainError(err) {
+			return err
+		}
+		return pkgerrors.NewInternalError("failed to reset password", err)
+	}
+
+	if _, err := uc.authService.RevokeUserRefreshTokens(ctx, user.ID.String(), authservice.RevocationPasswordReset); err != nil {
+		return pkgerrors.NewInternalError("password was changed but signing out other sessions failed", err)
+	}
+	if _, err := uc.userService.RotateCalendarFeed(ctx, user.ID); err != nil {
+		return pkgerrors.NewInternalError("password was changed but revoking calendar feed links failed", err)
+	}
+
+	if err := uc.events.PublishPasswordChanged(ctx, user.ID.String(), user.Email, time.Now()); err != nil {
+		log.Printf("Failed to publish password change of user %s: %v", user.ID, err)
+	}
+
+	return nil
+}
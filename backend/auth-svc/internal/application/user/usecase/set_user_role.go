@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	"github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type SetUserRoleUseCase struct {
+	userService *service.UserService
+	authService *authservice.AuthService
+}
+
+func NewSetUserRoleUseCase(userService *service.UserService, authService *authservice.AuthService) *SetUserRoleUseCase {
+	return &SetUserRoleUseCase{
+		userService: userService,
+		authService: authService,
+	}
+}
+
+// Execute changes the role stored for the user and revokes the access tokens carrying the old one,
+// so the next request refreshes into the new role
+func (uc *SetUserRoleUseCase) Execute(ctx context.Context, input dto.SetUserRoleInput) error {
+	if _, err := uuid.Parse(input.UserID); err != nil {
+		return pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	if _, err := uc.userService.SetRole(ctx, input.UserID, input.Role); err != nil {
+		if pkgerrors.IsDomainError(err) {
+			return err
+		}
+		return pkgerrors.NewInternalError("failed to set user role", err)
+	}
+
+	uc.authService.RevokeAccessTokens(ctx, input.UserID, authservice.RevocationRoleChanged)
+	return nil
+}
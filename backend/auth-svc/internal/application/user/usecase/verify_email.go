@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	authentity "github.com/diploma/auth-svc/internal/domain/auth/entity"
+	authport "github.com/diploma/auth-svc/internal/domain/auth/port"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+)
+
+type VerifyEmailUseCase struct {
+	userService *userservice.UserService
+	authService *authservice.AuthService
+	events      authport.EventPublisher
+}
+
+func NewVerifyEmailUseCase(userService *userservice.UserService, authService *authservice.AuthService, events authport.EventPublisher) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userService: userService,
+		authService: authService,
+		events:      events,
+	}
+}
+
+// Execute spends a verification token. The user's access tokens are revoked so the next refresh
+// issues one carrying the verified claim.
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, input dto.VerifyEmailInput) error {
+	if input.Token == "" {
+		return pkgerrors.NewInvalidArgumentError("token is required")
+	}
+
+	userID, err := uc.authService.ConsumeActionToken(ctx, authentity.PurposeEmailVerification, input.Token)
+	if err != nil {
+		if errors.Is(err, authservice.ErrActionTokenInvalid) {
+			return pkgerrors.NewInvalidArgumentError("invalid or expired verification token")
+		}
+		return pkgerrors.NewInternalError("failed to verify email", err)
+	}
+
+	user, err := uc.userService.GetByID(ctx, userID.String())
+	if err != nil {
+		return pkgerrors.NewNotFoundError("user not found")
+	}
+
+	if err := uc.userService.MarkEmailVerified(ctx, user); err != nil {
+		return pkgerrors.NewInternalError("failed to verify email", err)
+	}
+
+	if err := uc.events.PublishEmailVerified(ctx, user.ID.String(), user.Email, time.Now()); err != nil {
+		log.Printf("Failed to publish email verification of user %s: %v", user.ID, err)
+	}
+	uc.authService.RevokeAccessTokens(ctx, user.ID.String(), authservice.RevocationEmailVerified)
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -15,6 +16,8 @@ type Config struct {
 	NATS     NATSConfig
 	Jaeger   JaegerConfig
 	JWT      JWTConfig
+	Account  AccountConfig
+	Login    LoginConfig
 	Server   ServerConfig
 }
 
@@ -35,8 +38,9 @@ type RedisConfig struct {
 }
 
 type NATSConfig struct {
-	URL     string
-	Timeout time.Duration
+	URL          string
+	Timeout      time.Duration
+	StreamMaxAge time.Duration
 }
 
 type JaegerConfig struct {
@@ -45,14 +49,38 @@ type JaegerConfig struct {
 }
 
 type JWTConfig struct {
-	Secret          string
+	KeysDir         string
+	ActiveKeyID     string
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
 	Issuer          string
 }
 
+// AccountConfig sets how long mailed verification and reset links stay valid. Their tokens travel to
+// notification-svc sealed with TokenSealKey, which both services must share.
+type AccountConfig struct {
+	EmailVerificationTTL time.Duration
+	PasswordResetTTL     time.Duration
+	TokenSealKey         string
+}
+
+// LoginConfig bounds failed sign-ins. Each failure counts against the account and the client address
+// over a sliding Window; reaching a limit locks that key for LockoutBase, doubling with every further
+// lockout within LockoutMemory up to LockoutMax.
+type LoginConfig struct {
+	AccountMaxFailures int
+	IPMaxFailures      int
+	Window             time.Duration
+	LockoutBase        time.Duration
+	LockoutMax         time.Duration
+	LockoutMemory      time.Duration
+}
+
+// TrustedProxies are the IPs, CIDRs or host names allowed to pass a client address on in
+// x-forwarded-for; other callers are identified by their own address
 type ServerConfig struct {
-	GRPCPort string
+	GRPCPort       string
+	TrustedProxies []string
 }
 
 func Load() (*Config, error) {
@@ -75,30 +103,50 @@ func Load() (*Config, error) {
 			WriteTimeout: getEnvAsDuration("REDIS_WRITE_TIMEOUT", 3*time.Second),
 		},
 		NATS: NATSConfig{
-			URL:     getEnv("NATS_URL", "nats://localhost:4222"),
-			Timeout: getEnvAsDuration("NATS_TIMEOUT", 5*time.Second),
+			URL:          getEnv("NATS_URL", "nats://localhost:4222"),
+			Timeout:      getEnvAsDuration("NATS_TIMEOUT", 5*time.Second),
+			StreamMaxAge: getEnvAsDuration("EVENT_STREAM_MAX_AGE", 7*24*time.Hour),
 		},
 		Jaeger: JaegerConfig{
 			URL:     getEnv("JAEGER_URL", "http://localhost:14268/api/traces"),
 			Enabled: getEnvAsBool("JAEGER_ENABLED", true),
 		},
 		JWT: JWTConfig{
-			Secret:          getEnv("JWT_SECRET", "supersecret_placeholder"),
+			KeysDir:         getEnv("JWT_KEYS_DIR", ""),
+			ActiveKeyID:     getEnv("JWT_ACTIVE_KEY_ID", ""),
 			AccessTokenTTL:  getEnvAsDuration("JWT_ACCESS_TTL", 15*time.Minute),
 			RefreshTokenTTL: getEnvAsDuration("JWT_REFRESH_TTL", 7*24*time.Hour),
 			Issuer:          getEnv("JWT_ISSUER", "auth-svc"),
 		},
+		Account: AccountConfig{
+			EmailVerificationTTL: getEnvAsDuration("EMAIL_VERIFICATION_TTL", 24*time.Hour),
+			PasswordResetTTL:     getEnvAsDuration("PASSWORD_RESET_TTL", 1*time.Hour),
+			TokenSealKey:         getEnv("EVENT_SEAL_KEY", ""),
+		},
+		Login: LoginConfig{
+			AccountMaxFailures: getEnvAsInt("LOGIN_ACCOUNT_MAX_FAILURES", 5),
+			IPMaxFailures:      getEnvAsInt("LOGIN_IP_MAX_FAILURES", 20),
+			Window:             getEnvAsDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			LockoutBase:        getEnvAsDuration("LOGIN_LOCKOUT_BASE", 1*time.Minute),
+			LockoutMax:         getEnvAsDuration("LOGIN_LOCKOUT_MAX", 1*time.Hour),
+			LockoutMemory:      getEnvAsDuration("LOGIN_LOCKOUT_MEMORY", 24*time.Hour),
+		},
 		Server: ServerConfig{
-			GRPCPort: getEnv("GRPC_PORT", "9091"),
+			GRPCPort:       getEnv("GRPC_PORT", "9091"),
+			TrustedProxies: getEnvAsList("TRUSTED_PROXIES"),
 		},
 	}
 
-	if cfg.JWT.Secret == "" {
-		return nil, fmt.Errorf("JWT_SECRET must be set")
+	if cfg.JWT.KeysDir != "" && cfg.JWT.ActiveKeyID == "" {
+		return nil, fmt.Errorf("JWT_ACTIVE_KEY_ID must be set when JWT_KEYS_DIR is")
+	}
+
+	if cfg.Account.TokenSealKey == "" {
+		return nil, fmt.Errorf("EVENT_SEAL_KEY must be set")
 	}
 
-	if cfg.JWT.Secret == "supersecret_placeholder" {
-		fmt.Fprintf(os.Stderr, "WARNING: Using placeholder JWT_SECRET. This should NEVER be used in production!\n")
+	if cfg.JWT.KeysDir == "" {
+		fmt.Fprintf(os.Stderr, "WARNING: JWT_KEYS_DIR not set, signing with an ephemeral key. This should NEVER be used in production!\n")
 	}
 
 	return cfg, nil
@@ -134,3 +182,13 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
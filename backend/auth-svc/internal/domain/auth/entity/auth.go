@@ -6,9 +6,24 @@ import (
 	"github.com/google/uuid"
 )
 
+// Token is a stored refresh token. Only its hash is kept; every token rotated out of the same
+// login shares a FamilyID, so a leaked token can be traced to and revoked with its whole chain.
 type Token struct {
-	ID           uuid.UUID
-	UserID       uuid.UUID
-	RefreshToken string
-	CreatedAt    time.Time
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	FamilyID  uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (t *Token) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsSpent reports whether the token was already exchanged or revoked; presenting it again signals theft
+func (t *Token) IsSpent() bool {
+	return t.RotatedAt != nil || t.RevokedAt != nil
 }
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// LockoutScope says what a sign-in lockout blocks
+type LockoutScope string
+
+const (
+	LockoutScopeAccount LockoutScope = "account"
+	LockoutScopeIP      LockoutScope = "ip"
+)
+
+// LoginLockout records that repeated failed sign-ins locked an account or a client address
+type LoginLockout struct {
+	Scope       LockoutScope
+	UserID      string // Empty when the email belongs to no account
+	Email       string
+	ClientIP    string
+	Failures    int
+	Lockouts    int // Lockouts of the same key still remembered, this one included
+	LockedUntil time.Time
+}
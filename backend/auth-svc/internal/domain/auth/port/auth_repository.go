@@ -2,14 +2,36 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrActionTokenNotFound  = errors.New("action token not found")
 )
 
 type AuthRepository interface {
 	SaveRefreshToken(ctx context.Context, token *entity.Token) error
 
-	GetRefreshToken(ctx context.Context, refreshToken string) (*entity.Token, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*entity.Token, error)
+
+	// RotateRefreshToken marks current as rotated and saves next in one step; false means current
+	// was no longer active, i.e. a concurrent request spent it first
+	RotateRefreshToken(ctx context.Context, current *entity.Token, next *entity.Token) (bool, error)
+
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+
+	// RevokeUserTokens revokes every active token of the user and returns how many there were
+	RevokeUserTokens(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// SaveActionToken stores token and invalidates the user's earlier unused tokens of the same purpose
+	SaveActionToken(ctx context.Context, token *entity.ActionToken) error
 
-	DeleteRefreshToken(ctx context.Context, refreshToken string) error
+	// ConsumeActionToken marks the usable token with this hash as used and returns it; a token that is
+	// unknown, already used or expired is ErrActionTokenNotFound
+	ConsumeActionToken(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, now time.Time) (*entity.ActionToken, error)
 }
@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+)
+
+type EventPublisher interface {
+	// PublishTokensRevoked tells the gateway and other verifiers to reject the user's access tokens issued before revokedBefore
+	PublishTokensRevoked(ctx context.Context, userID string, revokedBefore time.Time, reason string) error
+
+	// PublishEmailVerificationRequested and PublishPasswordResetRequested hand the plaintext token to notification-svc to mail
+	PublishEmailVerificationRequested(ctx context.Context, userID, email, token string, expiresAt time.Time) error
+
+	PublishEmailVerified(ctx context.Context, userID, email string, verifiedAt time.Time) error
+
+	PublishPasswordResetRequested(ctx context.Context, userID, email, token string, expiresAt time.Time) error
+
+	PublishPasswordChanged(ctx context.Context, userID, email string, changedAt time.Time) error
+
+	// PublishLoginLocked audits a sign-in lockout
+	PublishLoginLocked(ctx context.Context, lockout *entity.LoginLockout) error
+}
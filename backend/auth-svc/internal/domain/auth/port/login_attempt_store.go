@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttemptStore keeps failed sign-ins and lockouts per key, such as "account:<email>" or "ip:<address>"
+type LoginAttemptStore interface {
+	// AddFailure records a failed attempt and returns how many fall within the trailing window
+	AddFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error)
+
+	// ClearFailures forgets the key's failed attempts; its lockout history is kept
+	ClearFailures(ctx context.Context, key string) error
+
+	// AddLockout counts a new lockout of the key and returns how many happened within memory, this one included
+	AddLockout(ctx context.Context, key string, memory time.Duration) (int, error)
+
+	Lock(ctx context.Context, key string, until time.Time) error
+
+	// LockedUntil returns the end of the key's lock, or the zero time when it is not locked
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+}
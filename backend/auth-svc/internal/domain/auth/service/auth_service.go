@@ -3,46 +3,70 @@ package service
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/diploma/auth-svc/internal/config"
 	"github.com/diploma/auth-svc/internal/domain/auth/entity"
 	"github.com/diploma/auth-svc/internal/domain/auth/port"
+	"github.com/diploma/authz"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+// Reasons attached to access token revocations
+const (
+	RevocationLogoutAll   = "logout_all"
+	RevocationTokenReuse  = "refresh_token_reuse"
+	RevocationRoleChanged = "role_changed"
+	// RevocationEmailVerified makes clients refresh into a token carrying the verified claim
+	RevocationEmailVerified = "email_verified"
+	RevocationPasswordReset = "password_reset"
+)
+
+var (
+	ErrRefreshTokenInvalid = errors.New("refresh token not found")
+	ErrRefreshTokenExpired = errors.New("refresh token expired")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+	ErrActionTokenInvalid  = errors.New("token is invalid, used or expired")
+)
+
 type AuthService struct {
 	authRepo port.AuthRepository
+	events   port.EventPublisher
 	cfg      *config.Config
+	keys     *authz.KeySet
+	verifier *authz.JWKSVerifier
 }
 
-func NewAuthService(authRepo port.AuthRepository, cfg *config.Config) *AuthService {
+func NewAuthService(authRepo port.AuthRepository, events port.EventPublisher, cfg *config.Config, keys *authz.KeySet) *AuthService {
 	return &AuthService{
 		authRepo: authRepo,
+		events:   events,
 		cfg:      cfg,
+		keys:     keys,
+		// The key set is in memory and fixed for the life of the process, so the cache never needs to expire
+		verifier: authz.NewJWKSVerifier(keys, cfg.JWT.Issuer, 24*time.Hour),
 	}
 }
 
-type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	jwt.RegisteredClaims
-}
-
 func (s *AuthService) HashPassword(password string) (string, error) {
 
 	return "", errors.New("use UserService.CreateUser for password hashing")
 }
 
-func (s *AuthService) GenerateToken(userID, email string) (string, error) {
+func (s *AuthService) GenerateToken(userID, email string, role authz.Role, emailVerified bool) (string, error) {
 	now := time.Now()
-	claims := &Claims{
-		UserID: userID,
-		Email:  email,
+	claims := &authz.Claims{
+		UserID:        userID,
+		Email:         email,
+		Role:          role,
+		EmailVerified: emailVerified,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.JWT.AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -51,8 +75,7 @@ func (s *AuthService) GenerateToken(userID, email string) (string, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.cfg.JWT.Secret))
+	tokenString, err := s.keys.Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -69,52 +92,190 @@ func (s *AuthService) GenerateRefreshToken() (string, error) {
 }
 
 func (s *AuthService) ValidateToken(tokenString string) (string, bool, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.cfg.JWT.Secret), nil
-	})
+	claims, err := s.Verify(tokenString)
+	if err != nil {
+		return "", false, err
+	}
+	return claims.UserID, true, nil
+}
+
+// Verify makes AuthService the authz.Verifier of its own gRPC server
+func (s *AuthService) Verify(tokenString string) (*authz.Claims, error) {
+	return s.verifier.Verify(tokenString)
+}
+
+// FetchJWKS publishes the public keys access tokens are signed with
+func (s *AuthService) FetchJWKS(ctx context.Context) (*authz.JWKS, error) {
+	return s.keys.FetchJWKS(ctx)
+}
+
+// SaveRefreshToken stores a freshly generated token as the start of a new family, i.e. a new login
+func (s *AuthService) SaveRefreshToken(ctx context.Context, userID string, refreshToken string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	return s.authRepo.SaveRefreshToken(ctx, s.newToken(userUUID, uuid.New(), refreshToken, time.Now()))
+}
+
+// ValidateRefreshToken returns the owner of an active token. Presenting a token that was already
+// rotated or revoked revokes its whole family, since either the client or an attacker holds a stolen copy.
+func (s *AuthService) ValidateRefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	token, err := s.activeRefreshToken(ctx, refreshToken, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return token.UserID.String(), nil
+}
 
+// RotateRefreshToken exchanges a token for a new one in the same family and returns the user it belongs to
+func (s *AuthService) RotateRefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	now := time.Now()
+	current, err := s.activeRefreshToken(ctx, refreshToken, now)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to parse token: %w", err)
+		return "", "", err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims.UserID, true, nil
+	newRefreshToken, err := s.GenerateRefreshToken()
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", false, errors.New("invalid token")
+	rotated, err := s.authRepo.RotateRefreshToken(ctx, current, s.newToken(current.UserID, current.FamilyID, newRefreshToken, now))
+	if err != nil {
+		return "", "", err
+	}
+	if !rotated {
+		// Lost the race against another request presenting the same token, which is reuse all the same
+		s.revokeFamily(ctx, current)
+		return "", "", ErrRefreshTokenReused
+	}
+
+	return current.UserID.String(), newRefreshToken, nil
 }
 
-func (s *AuthService) SaveRefreshToken(ctx context.Context, userID string, refreshToken string) error {
+// RevokeRefreshToken ends the session the token belongs to. Unknown tokens are ignored so logout
+// never tells a caller whether a token exists.
+func (s *AuthService) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	token, err := s.authRepo.GetRefreshToken(ctx, HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, port.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
 
+	return s.authRepo.RevokeFamily(ctx, token.FamilyID)
+}
+
+// RevokeUserRefreshTokens ends every session of the user, access tokens included, and returns how many were active
+func (s *AuthService) RevokeUserRefreshTokens(ctx context.Context, userID, reason string) (int, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return fmt.Errorf("invalid user ID: %w", err)
+		return 0, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	token := &entity.Token{
-		UserID:       userUUID,
-		RefreshToken: refreshToken,
+	revoked, err := s.authRepo.RevokeUserTokens(ctx, userUUID)
+	if err != nil {
+		return 0, err
 	}
 
-	return s.authRepo.SaveRefreshToken(ctx, token)
+	s.RevokeAccessTokens(ctx, userID, reason)
+	return revoked, nil
 }
 
-func (s *AuthService) ValidateRefreshToken(ctx context.Context, refreshToken string) (string, error) {
-	token, err := s.authRepo.GetRefreshToken(ctx, refreshToken)
+// RevokeAccessTokens asks verifiers to reject the user's access tokens issued until now. It is best
+// effort: if the event is lost the tokens simply live out their TTL.
+func (s *AuthService) RevokeAccessTokens(ctx context.Context, userID, reason string) {
+	if err := s.events.PublishTokensRevoked(ctx, userID, time.Now(), reason); err != nil {
+		log.Printf("Failed to publish access token revocation for user %s: %v", userID, err)
+	}
+}
+
+// IssueActionToken creates a single-use token for purpose, superseding the user's earlier ones,
+// and returns it with its expiry
+func (s *AuthService) IssueActionToken(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) (string, time.Time, error) {
+	token, err := s.GenerateRefreshToken()
 	if err != nil {
-		return "", fmt.Errorf("refresh token not found: %w", err)
+		return "", time.Time{}, err
 	}
 
-	if token == nil {
-		return "", errors.New("refresh token not found")
+	now := time.Now()
+	expiresAt := now.Add(s.actionTokenTTL(purpose))
+	if err := s.authRepo.SaveActionToken(ctx, &entity.ActionToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: HashRefreshToken(token),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}); err != nil {
+		return "", time.Time{}, err
 	}
 
-	return token.UserID.String(), nil
+	return token, expiresAt, nil
+}
+
+// ConsumeActionToken spends a token issued for purpose and returns the user it was issued to
+func (s *AuthService) ConsumeActionToken(ctx context.Context, purpose entity.TokenPurpose, token string) (uuid.UUID, error) {
+	consumed, err := s.authRepo.ConsumeActionToken(ctx, purpose, HashRefreshToken(token), time.Now())
+	if err != nil {
+		if errors.Is(err, port.ErrActionTokenNotFound) {
+			return uuid.Nil, ErrActionTokenInvalid
+		}
+		return uuid.Nil, err
+	}
+	return consumed.UserID, nil
+}
+
+func (s *AuthService) actionTokenTTL(purpose entity.TokenPurpose) time.Duration {
+	if purpose == entity.PurposePasswordReset {
+		return s.cfg.Account.PasswordResetTTL
+	}
+	return s.cfg.Account.EmailVerificationTTL
+}
+
+func (s *AuthService) activeRefreshToken(ctx context.Context, refreshToken string, now time.Time) (*entity.Token, error) {
+	token, err := s.authRepo.GetRefreshToken(ctx, HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, port.ErrRefreshTokenNotFound) {
+			return nil, ErrRefreshTokenInvalid
+		}
+		return nil, err
+	}
+
+	if token.IsSpent() {
+		s.revokeFamily(ctx, token)
+		return nil, ErrRefreshTokenReused
+	}
+	if token.IsExpired(now) {
+		return nil, ErrRefreshTokenExpired
+	}
+
+	return token, nil
+}
+
+func (s *AuthService) revokeFamily(ctx context.Context, token *entity.Token) {
+	log.Printf("Refresh token reuse detected for user %s, revoking token family %s", token.UserID, token.FamilyID)
+	if err := s.authRepo.RevokeFamily(ctx, token.FamilyID); err != nil {
+		log.Printf("Failed to revoke token family %s: %v", token.FamilyID, err)
+	}
+	// Whoever holds the stolen token may already have minted access tokens with it
+	s.RevokeAccessTokens(ctx, token.UserID.String(), RevocationTokenReuse)
+}
+
+func (s *AuthService) newToken(userID, familyID uuid.UUID, refreshToken string, now time.Time) *entity.Token {
+	return &entity.Token{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: HashRefreshToken(refreshToken),
+		ExpiresAt: now.Add(s.cfg.JWT.RefreshTokenTTL),
+		CreatedAt: now,
+	}
 }
 
-func (s *AuthService) DeleteRefreshToken(ctx context.Context, refreshToken string) error {
-	return s.authRepo.DeleteRefreshToken(ctx, refreshToken)
+// HashRefreshToken is what gets stored for refresh and action tokens; they carry 256 random bits, so a fast hash is enough
+func HashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/config"
+	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	"github.com/diploma/auth-svc/internal/domain/auth/port"
+)
+
+var ErrLoginLocked = errors.New("too many failed sign-in attempts")
+
+// LockoutError tells a caller locked out of signing in how long to wait
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("%v, retry in %s", ErrLoginLocked, e.RetryAfter)
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrLoginLocked
+}
+
+// LoginGuard throttles password guessing per account and per client address. The store failing
+// must not stop everyone from signing in, so its errors are logged and the attempt let through.
+type LoginGuard struct {
+	store  port.LoginAttemptStore
+	events port.EventPublisher
+	cfg    config.LoginConfig
+}
+
+func NewLoginGuard(store port.LoginAttemptStore, events port.EventPublisher, cfg config.LoginConfig) *LoginGuard {
+	return &LoginGuard{
+		store:  store,
+		events: events,
+		cfg:    cfg,
+	}
+}
+
+type guardedKey struct {
+	scope       entity.LockoutScope
+	name        string
+	maxFailures int
+}
+
+func (g *LoginGuard) keys(email, clientIP string) []guardedKey {
+	keys := []guardedKey{{
+		scope:       entity.LockoutScopeAccount,
+		name:        "account:" + strings.ToLower(strings.TrimSpace(email)),
+		maxFailures: g.cfg.AccountMaxFailures,
+	}}
+	if clientIP != "" {
+		keys = append(keys, guardedKey{
+			scope:       entity.LockoutScopeIP,
+			name:        "ip:" + clientIP,
+			maxFailures: g.cfg.IPMaxFailures,
+		})
+	}
+	return keys
+}
+
+// Check fails with a *LockoutError while the account or the client address is locked
+func (g *LoginGuard) Check(ctx context.Context, email, clientIP string) error {
+	now := time.Now()
+	var wait time.Duration
+	for _, key := range g.keys(email, clientIP) {
+		until, err := g.store.LockedUntil(ctx, key.name)
+		if err != nil {
+			log.Printf("Failed to check sign-in lock of %s: %v", key.name, err)
+			continue
+		}
+		if remaining := until.Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+
+	if wait > 0 {
+		return &LockoutError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// RecordFailure counts a failed sign-in against the account and the client address and locks each one
+// that reached its limit. The returned *LockoutError already applies to this attempt. userID is empty
+// when the email belongs to no account; such emails are throttled all the same so lockouts do not
+// reveal which addresses are registered.
+func (g *LoginGuard) RecordFailure(ctx context.Context, userID, email, clientIP string) error {
+	now := time.Now()
+	var wait time.Duration
+	for _, key := range g.keys(email, clientIP) {
+		failures, err := g.store.AddFailure(ctx, key.name, now, g.cfg.Window)
+		if err != nil {
+			log.Printf("Failed to record sign-in failure of %s: %v", key.name, err)
+			continue
+		}
+		if key.maxFailures <= 0 || failures < key.maxFailures {
+			continue
+		}
+
+		lockout, err := g.lock(ctx, key, failures, now)
+		if err != nil {
+			log.Printf("Failed to lock sign-in of %s: %v", key.name, err)
+			continue
+		}
+		lockout.ClientIP = clientIP
+		if key.scope == entity.LockoutScopeAccount {
+			lockout.UserID = userID
+			lockout.Email = email
+		}
+
+		log.Printf("Locked sign-in of %s until %s after %d failures (lockout %d)", key.name, lockout.LockedUntil.Format(time.RFC3339), failures, lockout.Lockouts)
+		if err := g.events.PublishLoginLocked(ctx, lockout); err != nil {
+			log.Printf("Failed to publish sign-in lockout of %s: %v", key.name, err)
+		}
+
+		if remaining := lockout.LockedUntil.Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+
+	if wait > 0 {
+		return &LockoutError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// RecordSuccess forgets the account's failures. The client address keeps its count, or an attacker
+// could sign in to an account of their own between guesses to reset it.
+func (g *LoginGuard) RecordSuccess(ctx context.Context, email string) {
+	key := g.keys(email, "")[0]
+	if err := g.store.ClearFailures(ctx, key.name); err != nil {
+		log.Printf("Failed to clear sign-in failures of %s: %v", key.name, err)
+	}
+}
+
+// lock blocks the key for a period that doubles with every lockout still remembered; the failures
+// that led to it are cleared, so the next lockout takes a full set of new ones
+func (g *LoginGuard) lock(ctx context.Context, key guardedKey, failures int, now time.Time) (*entity.LoginLockout, error) {
+	lockouts, err := g.store.AddLockout(ctx, key.name, g.cfg.LockoutMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	until := now.Add(g.lockoutDuration(lockouts))
+	if err := g.store.Lock(ctx, key.name, until); err != nil {
+		return nil, err
+	}
+	if err := g.store.ClearFailures(ctx, key.name); err != nil {
+		log.Printf("Failed to clear sign-in failures of %s: %v", key.name, err)
+	}
+
+	return &entity.LoginLockout{
+		Scope:       key.scope,
+		Failures:    failures,
+		Lockouts:    lockouts,
+		LockedUntil: until,
+	}, nil
+}
+
+func (g *LoginGuard) lockoutDuration(lockouts int) time.Duration {
+	duration := g.cfg.LockoutBase
+	for i := 1; i < lockouts && duration < g.cfg.LockoutMax; i++ {
+		duration *= 2
+	}
+	if g.cfg.LockoutMax > 0 && duration > g.cfg.LockoutMax {
+		duration = g.cfg.LockoutMax
+	}
+	return duration
+}
@@ -3,18 +3,27 @@ package entity
 import (
 	"time"
 
+	"github.com/diploma/authz"
 	"github.com/google/uuid"
 )
 
 type User struct {
-	ID           uuid.UUID
-	FullName     string
-	Email        string
-	Phone        string
-	PasswordHash string
-	CreatedAt    time.Time
+	ID              uuid.UUID
+	FullName        string
+	Email           string
+	Phone           string
+	PasswordHash    string
+	Role            authz.Role
+	EmailVerifiedAt *time.Time
+	// CalendarFeedVersion is signed into calendar feed links; bumping it revokes the old ones
+	CalendarFeedVersion int
+	CreatedAt           time.Time
 }
 
 func (u *User) IsValid() bool {
 	return u.Email != "" && u.PasswordHash != ""
 }
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
@@ -2,8 +2,11 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/diploma/auth-svc/internal/domain/user/entity"
+	"github.com/diploma/authz"
+	"github.com/google/uuid"
 )
 
 type UserRepository interface {
@@ -12,4 +15,14 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
 
 	GetByID(ctx context.Context, id string) (*entity.User, error)
+
+	UpdateRole(ctx context.Context, id uuid.UUID, role authz.Role) error
+
+	// MarkEmailVerified keeps the first verification time if the address was already verified
+	MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error
+
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+
+	// BumpCalendarFeedVersion increments the user's feed version and returns the new one
+	BumpCalendarFeedVersion(ctx context.Context, id uuid.UUID) (int, error)
 }
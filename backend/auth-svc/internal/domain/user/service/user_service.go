@@ -3,10 +3,13 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/diploma/auth-svc/internal/domain/user/entity"
 	"github.com/diploma/auth-svc/internal/domain/user/port"
 	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/diploma/authz"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -33,16 +36,17 @@ func (s *UserService) CreateUser(ctx context.Context, fullName, email, phone, pa
 		return nil, pkgerrors.NewAlreadyExistsError("user with this email already exists")
 	}
 
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	passwordHash, err := hashPassword(password)
 	if err != nil {
-		return nil, pkgerrors.NewInternalError("failed to hash password", err)
+		return nil, err
 	}
 
 	user := &entity.User{
 		FullName:     fullName,
 		Email:        email,
 		Phone:        phone,
-		PasswordHash: string(passwordHash),
+		PasswordHash: passwordHash,
+		Role:         authz.RolePlayer,
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
@@ -81,3 +85,77 @@ func (s *UserService) ValidatePassword(ctx context.Context, user *entity.User, p
 
 	return nil
 }
+
+func (s *UserService) SetRole(ctx context.Context, id string, role string) (*entity.User, error) {
+	parsedRole, ok := authz.ParseRole(role)
+	if !ok {
+		return nil, pkgerrors.NewInvalidArgumentError(fmt.Sprintf("unknown role %q", role))
+	}
+
+	user, err := s.GetByID(ctx, id)
+	if err != nil {
+		if pkgerrors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, pkgerrors.NewNotFoundError("user not found")
+	}
+
+	if err := s.userRepo.UpdateRole(ctx, user.ID, parsedRole); err != nil {
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+	user.Role = parsedRole
+
+	return user, nil
+}
+
+// MarkEmailVerified records that the user proved control of their address
+func (s *UserService) MarkEmailVerified(ctx context.Context, user *entity.User) error {
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	now := time.Now()
+	if err := s.userRepo.MarkEmailVerified(ctx, user.ID, now); err != nil {
+		return err
+	}
+	user.EmailVerifiedAt = &now
+
+	return nil
+}
+
+func (s *UserService) SetPassword(ctx context.Context, user *entity.User, password string) error {
+	if password == "" {
+		return pkgerrors.NewInvalidArgumentError("password is required")
+	}
+
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, passwordHash); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	user.PasswordHash = passwordHash
+
+	return nil
+}
+
+// RotateCalendarFeed revokes every calendar feed link handed out to the user so far and returns
+// the version new links are signed with
+func (s *UserService) RotateCalendarFeed(ctx context.Context, id uuid.UUID) (int, error) {
+	version, err := s.userRepo.BumpCalendarFeedVersion(ctx, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rotate calendar feed: %w", err)
+	}
+
+	return version, nil
+}
+
+func hashPassword(password string) (string, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", pkgerrors.NewInternalError("failed to hash password", err)
+	}
+	return string(passwordHash), nil
+}
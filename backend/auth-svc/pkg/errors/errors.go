@@ -3,12 +3,14 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 type DomainError struct {
-	Code    string
-	Message string
-	Err     error
+	Code       string
+	Message    string
+	Err        error
+	RetryAfter time.Duration // Set on ResourceExhausted errors the caller may retry later
 }
 
 func (e *DomainError) Error() string {
@@ -23,12 +25,13 @@ func (e *DomainError) Unwrap() error {
 }
 
 const (
-	CodeNotFound         = "NOT_FOUND"
-	CodeAlreadyExists    = "ALREADY_EXISTS"
-	CodeInvalidArgument  = "INVALID_ARGUMENT"
-	CodeUnauthenticated  = "UNAUTHENTICATED"
-	CodePermissionDenied = "PERMISSION_DENIED"
-	CodeInternal         = "INTERNAL"
+	CodeNotFound          = "NOT_FOUND"
+	CodeAlreadyExists     = "ALREADY_EXISTS"
+	CodeInvalidArgument   = "INVALID_ARGUMENT"
+	CodeUnauthenticated   = "UNAUTHENTICATED"
+	CodePermissionDenied  = "PERMISSION_DENIED"
+	CodeResourceExhausted = "RESOURCE_EXHAUSTED"
+	CodeInternal          = "INTERNAL"
 )
 
 func NewNotFoundError(message string) error {
@@ -59,6 +62,14 @@ func NewUnauthenticatedError(message string) error {
 	}
 }
 
+func NewResourceExhaustedError(message string, retryAfter time.Duration) error {
+	return &DomainError{
+		Code:       CodeResourceExhausted,
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
+
 func NewInternalError(message string, err error) error {
 	return &DomainError{
 		Code:    CodeInternal,
@@ -86,3 +97,11 @@ func GetErrorCode(err error) string {
 	return CodeInternal
 }
 
+// GetRetryAfter returns how long the caller should wait before retrying, or zero when it does not matter
+func GetRetryAfter(err error) time.Duration {
+	var de *DomainError
+	if errors.As(err, &de) {
+		return de.RetryAfter
+	}
+	return 0
+}
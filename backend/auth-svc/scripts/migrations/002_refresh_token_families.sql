@@ -0,0 +1,17 @@
+-- Refresh tokens are stored as SHA-256 hashes, expire, and are grouped into rotation families.
+-- Plaintext tokens cannot be carried over, so every device signs in again once.
+
+DELETE FROM user_tokens;
+
+DROP INDEX IF EXISTS idx_user_tokens_refresh_token;
+
+ALTER TABLE user_tokens
+    DROP COLUMN IF EXISTS refresh_token,
+    ADD COLUMN IF NOT EXISTS token_hash CHAR(64) NOT NULL,
+    ADD COLUMN IF NOT EXISTS family_id UUID NOT NULL,
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NOT NULL,
+    ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_tokens_token_hash ON user_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_user_tokens_family_id ON user_tokens(family_id);
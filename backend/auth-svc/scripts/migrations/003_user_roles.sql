@@ -0,0 +1,6 @@
+-- Users carry a role that is issued as a JWT claim and checked by the services.
+-- Every existing account becomes a player; grant the first admin by hand:
+--   UPDATE users SET role = 'admin' WHERE email = '...';
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'player'
+    CHECK (role IN ('player', 'venue_owner', 'admin'));
@@ -0,0 +1,18 @@
+-- Email verification and password reset. Both mail the user a single-use token whose SHA-256 hash
+-- is stored here; issuing a new token invalidates the earlier unused ones of the same purpose.
+-- Existing accounts start unverified and are asked to verify on their next gated action.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS user_action_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL CHECK (purpose IN ('email_verification', 'password_reset')),
+    token_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_action_tokens_token_hash ON user_action_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_user_action_tokens_user_purpose ON user_action_tokens(user_id, purpose);
@@ -0,0 +1,4 @@
+-- Calendar feed links are signed with the user's feed version; bumping it revokes every link
+-- handed out so far. Existing links were signed without a version and count as version 0.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS calendar_feed_version INT NOT NULL DEFAULT 0;
@@ -0,0 +1,311 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	"github.com/diploma/auth-svc/internal/config"
+	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	"github.com/diploma/auth-svc/internal/domain/auth/port"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	sharedevents "github.com/diploma/events"
+	"github.com/google/uuid"
+)
+
+var testAccountConfig = config.AccountConfig{
+	EmailVerificationTTL: 24 * time.Hour,
+	PasswordResetTTL:     time.Hour,
+}
+
+func (m *MockAuthRepository) SaveActionToken(ctx context.Context, token *entity.ActionToken) error {
+	for _, existing := range m.actionTokens {
+		if existing.UserID == token.UserID && existing.Purpose == token.Purpose && existing.UsedAt == nil {
+			usedAt := token.CreatedAt
+			existing.UsedAt = &usedAt
+		}
+	}
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+	m.actionTokens[token.TokenHash] = token
+	return nil
+}
+
+func (m *MockAuthRepository) ConsumeActionToken(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, now time.Time) (*entity.ActionToken, error) {
+	token, ok := m.actionTokens[tokenHash]
+	if !ok || token.Purpose != purpose || !token.IsUsable(now) {
+		return nil, port.ErrActionTokenNotFound
+	}
+	token.UsedAt = &now
+	copied := *token
+	return &copied, nil
+}
+
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	if user, ok := m.users[id]; ok && user.EmailVerifiedAt == nil {
+		user.EmailVerifiedAt = &verifiedAt
+	}
+	return nil
+}
+
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	user, ok := m.users[id]
+	if !ok {
+		return pkgerrors.NewNotFoundError("user not found")
+	}
+	user.PasswordHash = passwordHash
+	return nil
+}
+
+func (m *MockUserRepository) BumpCalendarFeedVersion(ctx context.Context, id uuid.UUID) (int, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return 0, pkgerrors.NewNotFoundError("user not found")
+	}
+	user.CalendarFeedVersion++
+	return user.CalendarFeedVersion, nil
+}
+
+type accountEvent struct {
+	Subject   string
+	UserID    string
+	Email     string
+	Token     string
+	ExpiresAt time.Time
+}
+
+func (m *MockEventPublisher) PublishEmailVerificationRequested(ctx context.Context, userID, email, token string, expiresAt time.Time) error {
+	return m.record(accountEvent{Subject: sharedevents.SubjectUserEmailVerificationRequested, UserID: userID, Email: email, Token: token, ExpiresAt: expiresAt})
+}
+
+func (m *MockEventPublisher) PublishEmailVerified(ctx context.Context, userID, email string, verifiedAt time.Time) error {
+	return m.record(accountEvent{Subject: sharedevents.SubjectUserEmailVerified, UserID: userID, Email: email})
+}
+
+func (m *MockEventPublisher) PublishPasswordResetRequested(ctx context.Context, userID, email, token string, expiresAt time.Time) error {
+	return m.record(accountEvent{Subject: sharedevents.SubjectUserPasswordResetRequested, UserID: userID, Email: email, Token: token, ExpiresAt: expiresAt})
+}
+
+func (m *MockEventPublisher) PublishPasswordChanged(ctx context.Context, userID, email string, changedAt time.Time) error {
+	return m.record(accountEvent{Subject: sharedevents.SubjectUserPasswordChanged, UserID: userID, Email: email})
+}
+
+func (m *MockEventPublisher) record(event accountEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.accountEvents = append(m.accountEvents, event)
+	return nil
+}
+
+func (m *MockEventPublisher) AccountEvents(subject string) []accountEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var events []accountEvent
+	for _, event := range m.accountEvents {
+		if event.Subject == subject {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func newRegisterUseCase(t *testing.T, userSvc *userservice.UserService) *usecase.RegisterUserUseCase {
+	t.Helper()
+	cfg := &config.Config{Account: testAccountConfig}
+	events := NewMockEventPublisher()
+	authSvc := authservice.NewAuthService(NewMockAuthRepository(), events, cfg, newTestKeySet(t))
+	return usecase.NewRegisterUserUseCase(userSvc, usecase.NewRequestEmailVerificationUseCase(userSvc, authSvc, events))
+}
+
+type accountFixture struct {
+	*refreshFixture
+	register            *usecase.RegisterUserUseCase
+	requestVerification *usecase.RequestEmailVerificationUseCase
+	verifyEmail         *usecase.VerifyEmailUseCase
+	requestReset        *usecase.RequestPasswordResetUseCase
+	resetPassword       *usecase.ResetPasswordUseCase
+}
+
+func newAccountFixture(t *testing.T) *accountFixture {
+	t.Helper()
+	f := newRefreshFixture(t, time.Hour)
+	requestVerification := usecase.NewRequestEmailVerificationUseCase(f.userSvc, f.authSvc, f.events)
+
+	return &accountFixture{
+		refreshFixture:      f,
+		register:            usecase.NewRegisterUserUseCase(f.userSvc, requestVerification),
+		requestVerification: requestVerification,
+		verifyEmail:         usecase.NewVerifyEmailUseCase(f.userSvc, f.authSvc, f.events),
+		requestReset:        usecase.NewRequestPasswordResetUseCase(f.userSvc, f.authSvc, f.events),
+		resetPassword:       usecase.NewResetPasswordUseCase(f.userSvc, f.authSvc, f.events),
+	}
+}
+
+// mailedToken returns the token of the latest mail of the given subject and checks who it went to
+func (f *accountFixture) mailedToken(t *testing.T, subject, email string) string {
+	t.Helper()
+	events := f.events.AccountEvents(subject)
+	if len(events) == 0 {
+		t.Fatalf("Expected a %s event", subject)
+	}
+	event := events[len(events)-1]
+	if event.Email != email || event.Token == "" {
+		t.Fatalf("Expected a token mailed to %s, got %+v", email, event)
+	}
+	return event.Token
+}
+
+func (f *accountFixture) requestVerificationToken(t *testing.T) string {
+	t.Helper()
+	if _, err := f.requestVerification.Execute(context.Background(), dto.RequestEmailVerificationInput{UserID: f.user.ID.String()}); err != nil {
+		t.Fatalf("Failed to request verification: %v", err)
+	}
+	return f.mailedToken(t, sharedevents.SubjectUserEmailVerificationRequested, f.user.Email)
+}
+
+func (f *accountFixture) emailVerifiedClaim(t *testing.T) bool {
+	t.Helper()
+	output, err := f.login.Execute(context.Background(), dto.LoginUserInput{Email: f.user.Email, Password: "password"})
+	if err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+	claims, err := f.authSvc.Verify(output.AccessToken)
+	if err != nil {
+		t.Fatalf("Failed to verify access token: %v", err)
+	}
+	return claims.EmailVerified
+}
+
+func expectCode(t *testing.T, err error, code string) {
+	t.Helper()
+	if pkgerrors.GetErrorCode(err) != code {
+		t.Errorf("Expected %s error, got %v", code, err)
+	}
+}
+
+func TestRegisterMailsVerificationLink(t *testing.T) {
+	f := newAccountFixture(t)
+
+	output, err := f.register.Execute(context.Background(), dto.RegisterUserInput{FullName: "Jane Doe", Email: "jane@example.com", Password: "password"})
+	if err != nil {
+		t.Fatalf("Failed to register: %v", err)
+	}
+
+	f.mailedToken(t, sharedevents.SubjectUserEmailVerificationRequested, "jane@example.com")
+	event := f.events.AccountEvents(sharedevents.SubjectUserEmailVerificationRequested)[0]
+	if event.UserID != output.UserID {
+		t.Errorf("Expected the link for user %s, got %s", output.UserID, event.UserID)
+	}
+	if until := time.Until(event.ExpiresAt); until < 23*time.Hour || until > 24*time.Hour {
+		t.Errorf("Expected the link to expire after the verification TTL, expires in %v", until)
+	}
+	if len(f.authRepo.actionTokens) != 1 || f.authRepo.actionTokens[authservice.HashRefreshToken(event.Token)] == nil {
+		t.Error("Expected only the hash of the token to be stored")
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	f := newAccountFixture(t)
+	if f.emailVerifiedClaim(t) {
+		t.Fatal("Expected a new account to be unverified")
+	}
+
+	token := f.requestVerificationToken(t)
+	if err := f.verifyEmail.Execute(context.Background(), dto.VerifyEmailInput{Token: token}); err != nil {
+		t.Fatalf("Failed to verify email: %v", err)
+	}
+
+	if !f.user.IsEmailVerified() {
+		t.Error("Expected the user to be verified")
+	}
+	if !f.emailVerifiedClaim(t) {
+		t.Error("Expected access tokens to carry the verified claim")
+	}
+	if len(f.events.AccountEvents(sharedevents.SubjectUserEmailVerified)) != 1 {
+		t.Error("Expected an email verified event")
+	}
+	f.expectRevocation(t, authservice.RevocationEmailVerified)
+
+	expectCode(t, f.verifyEmail.Execute(context.Background(), dto.VerifyEmailInput{Token: token}), pkgerrors.CodeInvalidArgument)
+
+	output, err := f.requestVerification.Execute(context.Background(), dto.RequestEmailVerificationInput{UserID: f.user.ID.String()})
+	if err != nil || !output.AlreadyVerified {
+		t.Errorf("Expected verified users not to be mailed again, got %+v, %v", output, err)
+	}
+}
+
+func TestEmailVerificationLinkSupersededAndExpiring(t *testing.T) {
+	f := newAccountFixture(t)
+
+	first := f.requestVerificationToken(t)
+	second := f.requestVerificationToken(t)
+	expectCode(t, f.verifyEmail.Execute(context.Background(), dto.VerifyEmailInput{Token: first}), pkgerrors.CodeInvalidArgument)
+
+	f.cfg.Account.EmailVerificationTTL = -time.Minute
+	expired := f.requestVerificationToken(t)
+	expectCode(t, f.verifyEmail.Execute(context.Background(), dto.VerifyEmailInput{Token: second}), pkgerrors.CodeInvalidArgument)
+	expectCode(t, f.verifyEmail.Execute(context.Background(), dto.VerifyEmailInput{Token: expired}), pkgerrors.CodeInvalidArgument)
+
+	if f.user.IsEmailVerified() {
+		t.Error("Expected the user to stay unverified")
+	}
+}
+
+func TestRequestPasswordResetDoesNotRevealAccounts(t *testing.T) {
+	f := newAccountFixture(t)
+
+	if err := f.requestReset.Execute(context.Background(), dto.RequestPasswordResetInput{Email: "nobody@example.com"}); err != nil {
+		t.Errorf("Expected unknown addresses to succeed silently, got %v", err)
+	}
+	if events := f.events.AccountEvents(sharedevents.SubjectUserPasswordResetRequested); len(events) != 0 {
+		t.Errorf("Expected no mail for an unknown address, got %+v", events)
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	f := newAccountFixture(t)
+	session := f.signIn(t)
+
+	if err := f.requestReset.Execute(context.Background(), dto.RequestPasswordResetInput{Email: f.user.Email}); err != nil {
+		t.Fatalf("Failed to request password reset: %v", err)
+	}
+	token := f.mailedToken(t, sharedevents.SubjectUserPasswordResetRequested, f.user.Email)
+
+	expectCode(t, f.resetPassword.Execute(context.Background(), dto.ResetPasswordInput{Token: token}), pkgerrors.CodeInvalidArgument)
+	if err := f.resetPassword.Execute(context.Background(), dto.ResetPasswordInput{Token: token, NewPassword: "new-password"}); err != nil {
+		t.Fatalf("Failed to reset password: %v", err)
+	}
+
+	if _, err := f.login.Execute(context.Background(), dto.LoginUserInput{Email: f.user.Email, Password: "password"}); err == nil {
+		t.Error("Expected the old password to stop working")
+	}
+	if _, err := f.login.Execute(context.Background(), dto.LoginUserInput{Email: f.user.Email, Password: "new-password"}); err != nil {
+		t.Errorf("Expected the new password to work, got %v", err)
+	}
+
+	f.expectRevocation(t, authservice.RevocationPasswordReset)
+	f.expectRejected(t, session)
+	if f.user.CalendarFeedVersion != 1 {
+		t.Errorf("Expected calendar feed links to be revoked, got feed version %d", f.user.CalendarFeedVersion)
+	}
+	if len(f.events.AccountEvents(sharedevents.SubjectUserPasswordChanged)) != 1 {
+		t.Error("Expected a password changed event")
+	}
+
+	expectCode(t, f.resetPassword.Execute(context.Background(), dto.ResetPasswordInput{Token: token, NewPassword: "another-password"}), pkgerrors.CodeInvalidArgument)
+}
+
+func TestActionTokensAreBoundToTheirPurpose(t *testing.T) {
+	f := newAccountFixture(t)
+	token := f.requestVerificationToken(t)
+
+	expectCode(t, f.resetPassword.Execute(context.Background(), dto.ResetPasswordInput{Token: token, NewPassword: "new-password"}), pkgerrors.CodeInvalidArgument)
+	if err := f.verifyEmail.Execute(context.Background(), dto.VerifyEmailInput{Token: token}); err != nil {
+		t.Errorf("Expected the verification token to survive a misuse, got %v", err)
+	}
+}
@@ -10,42 +10,80 @@ import (
 	"github.com/diploma/auth-svc/internal/application/user/usecase"
 	"github.com/diploma/auth-svc/internal/config"
 	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	"github.com/diploma/auth-svc/internal/domain/auth/port"
 	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
 	userentity "github.com/diploma/auth-svc/internal/domain/user/entity"
 	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
 	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/diploma/authz"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type MockAuthRepository struct {
-	tokens map[string]*entity.Token
+	tokens       map[string]*entity.Token
+	actionTokens map[string]*entity.ActionToken
 }
 
 func NewMockAuthRepository() *MockAuthRepository {
 	return &MockAuthRepository{
-		tokens: make(map[string]*entity.Token),
+		tokens:       make(map[string]*entity.Token),
+		actionTokens: make(map[string]*entity.ActionToken),
 	}
 }
 
 func (m *MockAuthRepository) SaveRefreshToken(ctx context.Context, token *entity.Token) error {
-	m.tokens[token.RefreshToken] = token
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+	m.tokens[token.TokenHash] = token
 	return nil
 }
 
-func (m *MockAuthRepository) GetRefreshToken(ctx context.Context, refreshToken string) (*entity.Token, error) {
-	token, ok := m.tokens[refreshToken]
+func (m *MockAuthRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*entity.Token, error) {
+	token, ok := m.tokens[tokenHash]
 	if !ok {
-		return nil, fmt.Errorf("refresh token not found")
+		return nil, port.ErrRefreshTokenNotFound
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (m *MockAuthRepository) RotateRefreshToken(ctx context.Context, current *entity.Token, next *entity.Token) (bool, error) {
+	stored, ok := m.tokens[current.TokenHash]
+	if !ok || stored.IsSpent() {
+		return false, nil
 	}
-	return token, nil
+	now := time.Now()
+	stored.RotatedAt = &now
+	return true, m.SaveRefreshToken(ctx, next)
 }
 
-func (m *MockAuthRepository) DeleteRefreshToken(ctx context.Context, refreshToken string) error {
-	delete(m.tokens, refreshToken)
+func (m *MockAuthRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	now := time.Now()
+	for _, token := range m.tokens {
+		if token.FamilyID == familyID && token.RevokedAt == nil {
+			token.RevokedAt = &now
+		}
+	}
 	return nil
 }
 
+func (m *MockAuthRepository) RevokeUserTokens(ctx context.Context, userID uuid.UUID) (int, error) {
+	now := time.Now()
+	active := 0
+	for _, token := range m.tokens {
+		if token.UserID != userID || token.RevokedAt != nil {
+			continue
+		}
+		if token.RotatedAt == nil && !token.IsExpired(now) {
+			active++
+		}
+		token.RevokedAt = &now
+	}
+	return active, nil
+}
+
 type MockUserRepository struct {
 	users       map[uuid.UUID]*userentity.User
 	emailIndex  map[string]*userentity.User
@@ -107,6 +145,15 @@ func (m *MockUserRepository) GetByID(ctx context.Context, id string) (*userentit
 	return user, nil
 }
 
+func (m *MockUserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role authz.Role) error {
+	user, ok := m.users[id]
+	if !ok {
+		return fmt.Errorf("user not found")
+	}
+	user.Role = role
+	return nil
+}
+
 func TestHashPassword(t *testing.T) {
 	password := "testPassword123!"
 
@@ -158,7 +205,6 @@ func TestValidatePassword(t *testing.T) {
 func TestGenerateToken(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -166,12 +212,12 @@ func TestGenerateToken(t *testing.T) {
 	}
 
 	mockRepo := NewMockAuthRepository()
-	authService := authservice.NewAuthService(mockRepo, cfg)
+	authService := authservice.NewAuthService(mockRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 
 	userID := "123e4567-e89b-12d3-a456-426614174000"
 	email := "test@example.com"
 
-	token, err := authService.GenerateToken(userID, email)
+	token, err := authService.GenerateToken(userID, email, authz.RolePlayer, false)
 	if err != nil {
 		t.Fatalf("Failed to generate token: %v", err)
 	}
@@ -195,7 +241,6 @@ func TestGenerateToken(t *testing.T) {
 func TestGenerateRefreshToken(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -203,7 +248,7 @@ func TestGenerateRefreshToken(t *testing.T) {
 	}
 
 	mockRepo := NewMockAuthRepository()
-	authService := authservice.NewAuthService(mockRepo, cfg)
+	authService := authservice.NewAuthService(mockRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 
 	refreshToken, err := authService.GenerateRefreshToken()
 	if err != nil {
@@ -227,7 +272,6 @@ func TestGenerateRefreshToken(t *testing.T) {
 func TestValidateTokenInvalid(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -235,7 +279,7 @@ func TestValidateTokenInvalid(t *testing.T) {
 	}
 
 	mockRepo := NewMockAuthRepository()
-	authService := authservice.NewAuthService(mockRepo, cfg)
+	authService := authservice.NewAuthService(mockRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 
 	_, isValid, _ := authService.ValidateToken("invalid.token.here")
 	if isValid {
@@ -251,7 +295,7 @@ func TestValidateTokenInvalid(t *testing.T) {
 func TestRegisterUserSuccess(t *testing.T) {
 	userRepo := NewMockUserRepository()
 	userSvc := userservice.NewUserService(userRepo)
-	registerUseCase := usecase.NewRegisterUserUseCase(userSvc)
+	registerUseCase := newRegisterUseCase(t, userSvc)
 
 	input := dto.RegisterUserInput{
 		FullName: "John Doe",
@@ -285,7 +329,7 @@ func TestRegisterUserSuccess(t *testing.T) {
 func TestRegisterUserDuplicateEmail(t *testing.T) {
 	userRepo := NewMockUserRepository()
 	userSvc := userservice.NewUserService(userRepo)
-	registerUseCase := usecase.NewRegisterUserUseCase(userSvc)
+	registerUseCase := newRegisterUseCase(t, userSvc)
 
 	input := dto.RegisterUserInput{
 		FullName: "John Doe",
@@ -316,7 +360,7 @@ func TestRegisterUserDuplicateEmail(t *testing.T) {
 func TestRegisterUserInvalidInput(t *testing.T) {
 	userRepo := NewMockUserRepository()
 	userSvc := userservice.NewUserService(userRepo)
-	registerUseCase := usecase.NewRegisterUserUseCase(userSvc)
+	registerUseCase := newRegisterUseCase(t, userSvc)
 
 	tests := []struct {
 		name  string
@@ -357,7 +401,6 @@ func TestRegisterUserInvalidInput(t *testing.T) {
 func TestLoginUserSuccess(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -368,9 +411,9 @@ func TestLoginUserSuccess(t *testing.T) {
 	authRepo := NewMockAuthRepository()
 	
 	userSvc := userservice.NewUserService(userRepo)
-	authSvc := authservice.NewAuthService(authRepo, cfg)
+	authSvc := authservice.NewAuthService(authRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 	
-	loginUseCase := usecase.NewLoginUserUseCase(userSvc, authSvc)
+	loginUseCase := usecase.NewLoginUserUseCase(userSvc, authSvc, newTestLoginGuard(NewMockEventPublisher()))
 
 	password := "secure_password"
 	user, err := userSvc.CreateUser(context.Background(), "John Doe", "john@example.com", "+1234567890", password)
@@ -410,7 +453,6 @@ func TestLoginUserSuccess(t *testing.T) {
 func TestLoginUserInvalidPassword(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -421,9 +463,9 @@ func TestLoginUserInvalidPassword(t *testing.T) {
 	authRepo := NewMockAuthRepository()
 	
 	userSvc := userservice.NewUserService(userRepo)
-	authSvc := authservice.NewAuthService(authRepo, cfg)
+	authSvc := authservice.NewAuthService(authRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 	
-	loginUseCase := usecase.NewLoginUserUseCase(userSvc, authSvc)
+	loginUseCase := usecase.NewLoginUserUseCase(userSvc, authSvc, newTestLoginGuard(NewMockEventPublisher()))
 
 	password := "secure_password"
 	user, err := userSvc.CreateUser(context.Background(), "John Doe", "john@example.com", "+1234567890", password)
@@ -449,7 +491,6 @@ func TestLoginUserInvalidPassword(t *testing.T) {
 func TestLoginUserNotFound(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -460,9 +501,9 @@ func TestLoginUserNotFound(t *testing.T) {
 	authRepo := NewMockAuthRepository()
 	
 	userSvc := userservice.NewUserService(userRepo)
-	authSvc := authservice.NewAuthService(authRepo, cfg)
+	authSvc := authservice.NewAuthService(authRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 	
-	loginUseCase := usecase.NewLoginUserUseCase(userSvc, authSvc)
+	loginUseCase := usecase.NewLoginUserUseCase(userSvc, authSvc, newTestLoginGuard(NewMockEventPublisher()))
 
 	input := dto.LoginUserInput{
 		Email:    "nonexistent@example.com",
@@ -524,7 +565,6 @@ func TestGetUserProfileNotFound(t *testing.T) {
 func TestRefreshTokenSuccess(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -535,7 +575,7 @@ func TestRefreshTokenSuccess(t *testing.T) {
 	authRepo := NewMockAuthRepository()
 	
 	userSvc := userservice.NewUserService(userRepo)
-	authSvc := authservice.NewAuthService(authRepo, cfg)
+	authSvc := authservice.NewAuthService(authRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 	
 	refreshTokenUseCase := usecase.NewRefreshTokenUseCase(authSvc, userSvc)
 
@@ -577,7 +617,6 @@ func TestRefreshTokenSuccess(t *testing.T) {
 func TestRefreshTokenInvalid(t *testing.T) {
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test_secret_key_min_32_chars_long_for_hmac",
 			AccessTokenTTL:  15 * time.Minute,
 			RefreshTokenTTL: 7 * 24 * time.Hour,
 			Issuer:          "auth-svc-test",
@@ -588,7 +627,7 @@ func TestRefreshTokenInvalid(t *testing.T) {
 	authRepo := NewMockAuthRepository()
 	
 	userSvc := userservice.NewUserService(userRepo)
-	authSvc := authservice.NewAuthService(authRepo, cfg)
+	authSvc := authservice.NewAuthService(authRepo, NewMockEventPublisher(), cfg, newTestKeySet(t))
 	
 	refreshTokenUseCase := usecase.NewRefreshTokenUseCase(authSvc, userSvc)
 
@@ -0,0 +1,53 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestRotateCalendarFeedBumpsVersion(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	get := usecase.NewGetCalendarFeedVersionUseCase(f.userSvc)
+	rotate := usecase.NewRotateCalendarFeedUseCase(f.userSvc)
+	input := dto.CalendarFeedInput{UserID: f.user.ID.String()}
+
+	current, err := get.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Failed to get calendar feed version: %v", err)
+	}
+	if current.Version != 0 {
+		t.Errorf("Expected a new user to start at version 0, got %d", current.Version)
+	}
+
+	rotated, err := rotate.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Failed to rotate calendar feed: %v", err)
+	}
+	current, err = get.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Failed to get calendar feed version: %v", err)
+	}
+	if rotated.Version != 1 || current.Version != 1 {
+		t.Errorf("Expected version 1 after rotating, got %d and %d", rotated.Version, current.Version)
+	}
+}
+
+func TestCalendarFeedVersionOfUnknownUser(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	get := usecase.NewGetCalendarFeedVersionUseCase(f.userSvc)
+
+	_, err := get.Execute(context.Background(), dto.CalendarFeedInput{UserID: uuid.NewString()})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+		t.Errorf("Expected NotFound for an unknown user, got %v", err)
+	}
+	_, err = usecase.NewRotateCalendarFeedUseCase(f.userSvc).Execute(context.Background(), dto.CalendarFeedInput{UserID: "not-a-uuid"})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected InvalidArgument for a malformed user ID, got %v", err)
+	}
+}
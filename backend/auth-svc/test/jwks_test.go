@@ -0,0 +1,127 @@
+package test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	authv1 "github.com/diploma/auth-svc/api/v1"
+	"github.com/diploma/auth-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/auth-svc/internal/config"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	"github.com/diploma/authz"
+)
+
+func newTestKeySet(t *testing.T) *authz.KeySet {
+	t.Helper()
+	keys, err := authz.GenerateKeySet()
+	if err != nil {
+		t.Fatalf("Failed to generate key set: %v", err)
+	}
+	return keys
+}
+
+// grpcKeySource turns a GetJWKS response back into a key set, like a service verifying tokens would
+type grpcKeySource struct {
+	handler *handler.AuthGRPCHandler
+}
+
+func (s grpcKeySource) FetchJWKS(ctx context.Context) (*authz.JWKS, error) {
+	resp, err := s.handler.GetJWKS(ctx, &authv1.GetJWKSRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := &authz.JWKS{}
+	for _, key := range resp.Keys {
+		jwks.Keys = append(jwks.Keys, authz.JWK{
+			Kty: key.Kty, Kid: key.Kid, Use: key.Use, Alg: key.Alg,
+			N: key.N, E: key.E, Crv: key.Crv, X: key.X,
+		})
+	}
+	return jwks, nil
+}
+
+func newKeyedAuthService(keys *authz.KeySet) *authservice.AuthService {
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+			Issuer:          "auth-svc-test",
+		},
+	}
+	return authservice.NewAuthService(NewMockAuthRepository(), NewMockEventPublisher(), cfg, keys)
+}
+
+func TestGetJWKSVerifiesIssuedTokens(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	keys, err := authz.NewKeySet(authz.SigningKey{ID: "2026-10", Key: rsaKey})
+	if err != nil {
+		t.Fatalf("Failed to build key set: %v", err)
+	}
+
+	authSvc := newKeyedAuthService(keys)
+	h := handler.NewAuthGRPCHandler(nil, nil, nil, nil, nil, authSvc, authSvc, nil)
+
+	resp, err := h.GetJWKS(context.Background(), &authv1.GetJWKSRequest{})
+	if err != nil {
+		t.Fatalf("Failed to get JWKS: %v", err)
+	}
+	if len(resp.Keys) != 1 || resp.Keys[0].Kid != "2026-10" || resp.Keys[0].Alg != "RS256" || resp.Keys[0].N == "" {
+		t.Fatalf("Unexpected JWKS %+v", resp.Keys)
+	}
+
+	token, err := authSvc.GenerateToken("user-1", "user@example.com", authz.RoleVenueOwner, false)
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	// A service holding nothing but the published keys accepts the token
+	claims, err := authz.NewJWKSVerifier(grpcKeySource{handler: h}, "auth-svc-test", time.Minute).Verify(token)
+	if err != nil {
+		t.Fatalf("Expected the token to verify against the JWKS, got %v", err)
+	}
+	if claims.UserID != "user-1" || claims.Role != authz.RoleVenueOwner {
+		t.Errorf("Unexpected claims %+v", claims)
+	}
+}
+
+func TestKeyRotationKeepsIssuedTokensValid(t *testing.T) {
+	_, oldKey, _ := ed25519.GenerateKey(rand.Reader)
+	_, newKey, _ := ed25519.GenerateKey(rand.Reader)
+	old := authz.SigningKey{ID: "2026-09", Key: oldKey}
+	current := authz.SigningKey{ID: "2026-10", Key: newKey}
+
+	before, err := authz.NewKeySet(old)
+	if err != nil {
+		t.Fatalf("Failed to build key set: %v", err)
+	}
+	token, err := newKeyedAuthService(before).GenerateToken("user-1", "user@example.com", authz.RolePlayer, false)
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	// After a restart with the new key active and the old one retiring
+	rotated, err := authz.NewKeySet(current, old)
+	if err != nil {
+		t.Fatalf("Failed to build key set: %v", err)
+	}
+	if _, valid, _ := newKeyedAuthService(rotated).ValidateToken(token); !valid {
+		t.Error("Expected a token signed with a retiring key to stay valid")
+	}
+
+	// Once the old key is dropped its tokens are rejected
+	retired, err := authz.NewKeySet(current)
+	if err != nil {
+		t.Fatalf("Failed to build key set: %v", err)
+	}
+	if _, valid, _ := newKeyedAuthService(retired).ValidateToken(token); valid {
+		t.Error("Expected a token signed with a retired key to be rejected")
+	}
+}
@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/adapters/outbound/cache"
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/config"
+	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+)
+
+var testLoginConfig = config.LoginConfig{
+	AccountMaxFailures: 3,
+	IPMaxFailures:      5,
+	Window:             15 * time.Minute,
+	LockoutBase:        time.Minute,
+	LockoutMax:         3 * time.Minute,
+	LockoutMemory:      24 * time.Hour,
+}
+
+func newTestLoginGuard(events *MockEventPublisher) *authservice.LoginGuard {
+	return authservice.NewLoginGuard(cache.NewMemoryLoginAttemptStore(), events, testLoginConfig)
+}
+
+func (m *MockEventPublisher) PublishLoginLocked(ctx context.Context, lockout *entity.LoginLockout) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lockouts = append(m.lockouts, lockout)
+	return nil
+}
+
+func (m *MockEventPublisher) Lockouts() []*entity.LoginLockout {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*entity.LoginLockout(nil), m.lockouts...)
+}
+
+func (f *refreshFixture) attemptLogin(password, clientIP string) error {
+	_, err := f.login.Execute(context.Background(), dto.LoginUserInput{Email: f.user.Email, Password: password, ClientIP: clientIP})
+	return err
+}
+
+func expectLockedOut(t *testing.T, err error, retryAfter time.Duration) {
+	t.Helper()
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeResourceExhausted {
+		t.Fatalf("Expected ResourceExhausted, got %v", err)
+	}
+	if got := pkgerrors.GetRetryAfter(err); got <= retryAfter-time.Second || got > retryAfter {
+		t.Errorf("Expected to retry after about %s, got %s", retryAfter, got)
+	}
+}
+
+func TestLoginLocksAccountAfterRepeatedFailures(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+
+	for i := 1; i < testLoginConfig.AccountMaxFailures; i++ {
+		if err := f.attemptLogin("wrong", "203.0.113.7"); pkgerrors.GetErrorCode(err) != pkgerrors.CodeUnauthenticated {
+			t.Fatalf("Expected attempt %d to be Unauthenticated, got %v", i, err)
+		}
+	}
+	expectLockedOut(t, f.attemptLogin("wrong", "203.0.113.7"), time.Minute)
+
+	// The right password does not get through a lock, from any address
+	expectLockedOut(t, f.attemptLogin("password", "198.51.100.1"), time.Minute)
+
+	lockouts := f.events.Lockouts()
+	if len(lockouts) != 1 {
+		t.Fatalf("Expected one lockout event, got %d", len(lockouts))
+	}
+	if lockout := lockouts[0]; lockout.Scope != entity.LockoutScopeAccount || lockout.UserID != f.user.ID.String() ||
+		lockout.ClientIP != "203.0.113.7" || lockout.Failures != testLoginConfig.AccountMaxFailures || lockout.Lockouts != 1 {
+		t.Errorf("Unexpected lockout event: %+v", lockout)
+	}
+}
+
+func TestLoginSuccessClearsAccountFailures(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+
+	for round := 0; round < 2; round++ {
+		for i := 1; i < testLoginConfig.AccountMaxFailures; i++ {
+			if err := f.attemptLogin("wrong", ""); pkgerrors.GetErrorCode(err) != pkgerrors.CodeUnauthenticated {
+				t.Fatalf("Expected Unauthenticated, got %v", err)
+			}
+		}
+		if err := f.attemptLogin("password", ""); err != nil {
+			t.Fatalf("Expected the right password to sign in below the limit, got %v", err)
+		}
+	}
+
+	if lockouts := f.events.Lockouts(); len(lockouts) != 0 {
+		t.Errorf("Expected no lockouts, got %+v", lockouts)
+	}
+}
+
+func TestLoginLocksClientAddressAcrossAccounts(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+
+	// Spraying unknown emails from one address trips the address limit, not any account's
+	for i := 0; i < testLoginConfig.IPMaxFailures; i++ {
+		_, err := f.login.Execute(context.Background(), dto.LoginUserInput{
+			Email:    string(rune('a'+i)) + "@example.com",
+			Password: "guess",
+			ClientIP: "203.0.113.7",
+		})
+		if i < testLoginConfig.IPMaxFailures-1 && pkgerrors.GetErrorCode(err) != pkgerrors.CodeUnauthenticated {
+			t.Fatalf("Expected attempt %d to be Unauthenticated, got %v", i+1, err)
+		}
+		if i == testLoginConfig.IPMaxFailures-1 {
+			expectLockedOut(t, err, time.Minute)
+		}
+	}
+
+	expectLockedOut(t, f.attemptLogin("password", "203.0.113.7"), time.Minute)
+	if err := f.attemptLogin("password", "198.51.100.1"); err != nil {
+		t.Errorf("Expected other addresses to sign in, got %v", err)
+	}
+
+	lockouts := f.events.Lockouts()
+	if len(lockouts) != 1 || lockouts[0].Scope != entity.LockoutScopeIP || lockouts[0].UserID != "" || lockouts[0].Email != "" {
+		t.Errorf("Expected one anonymous address lockout, got %+v", lockouts)
+	}
+}
+
+func TestLoginGuardEscalatesLockouts(t *testing.T) {
+	events := NewMockEventPublisher()
+	guard := newTestLoginGuard(events)
+	ctx := context.Background()
+
+	for _, want := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 3 * time.Minute} {
+		var err error
+		for i := 0; i < testLoginConfig.AccountMaxFailures; i++ {
+			err = guard.RecordFailure(ctx, "", "Someone@Example.com ", "")
+		}
+
+		var lockout *authservice.LockoutError
+		if !errors.As(err, &lockout) || !errors.Is(err, authservice.ErrLoginLocked) {
+			t.Fatalf("Expected a lockout, got %v", err)
+		}
+		if lockout.RetryAfter != want {
+			t.Errorf("Expected a %s lockout, got %s", want, lockout.RetryAfter)
+		}
+	}
+
+	// Keys ignore case and surrounding spaces
+	if err := guard.Check(ctx, "someone@example.com", ""); !errors.Is(err, authservice.ErrLoginLocked) {
+		t.Errorf("Expected the account to be locked, got %v", err)
+	}
+	if got := len(events.Lockouts()); got != 4 {
+		t.Errorf("Expected 4 lockout events, got %d", got)
+	}
+}
+
+func TestMemoryLoginAttemptStoreSlidesWindow(t *testing.T) {
+	store := cache.NewMemoryLoginAttemptStore()
+	ctx := context.Background()
+	start := time.Now()
+
+	for _, attempt := range []struct {
+		at   time.Duration
+		want int
+	}{{0, 1}, {10 * time.Minute, 2}, {20 * time.Minute, 2}, {40 * time.Minute, 1}} {
+		count, err := store.AddFailure(ctx, "ip:203.0.113.7", start.Add(attempt.at), 15*time.Minute)
+		if err != nil {
+			t.Fatalf("Failed to add failure: %v", err)
+		}
+		if count != attempt.want {
+			t.Errorf("Expected %d failures in the window at +%s, got %d", attempt.want, attempt.at, count)
+		}
+	}
+
+	if err := store.Lock(ctx, "ip:203.0.113.7", time.Now().Add(-time.Second)); err != nil {
+		t.Fatalf("Failed to lock: %v", err)
+	}
+	if until, _ := store.LockedUntil(ctx, "ip:203.0.113.7"); !until.IsZero() {
+		t.Errorf("Expected an elapsed lock to be gone, got %s", until)
+	}
+}
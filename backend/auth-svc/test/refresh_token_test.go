@@ -0,0 +1,186 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	"github.com/diploma/auth-svc/internal/config"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+	userentity "github.com/diploma/auth-svc/internal/domain/user/entity"
+	userservice "github.com/diploma/auth-svc/internal/domain/user/service"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+)
+
+type refreshFixture struct {
+	cfg       *config.Config
+	authRepo  *MockAuthRepository
+	events    *MockEventPublisher
+	authSvc   *authservice.AuthService
+	userSvc   *userservice.UserService
+	login     *usecase.LoginUserUseCase
+	refresh   *usecase.RefreshTokenUseCase
+	logout    *usecase.LogoutUseCase
+	logoutAll *usecase.LogoutAllDevicesUseCase
+	user      *userentity.User
+}
+
+func newRefreshFixture(t *testing.T, refreshTTL time.Duration) *refreshFixture {
+	t.Helper()
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: refreshTTL,
+			Issuer:          "auth-svc-test",
+		},
+		Account: testAccountConfig,
+	}
+
+	authRepo := NewMockAuthRepository()
+	events := NewMockEventPublisher()
+	userSvc := userservice.NewUserService(NewMockUserRepository())
+	authSvc := authservice.NewAuthService(authRepo, events, cfg, newTestKeySet(t))
+
+	user, err := userSvc.CreateUser(context.Background(), "John Doe", "john@example.com", "+1234567890", "password")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	return &refreshFixture{
+		cfg:       cfg,
+		authRepo:  authRepo,
+		events:    events,
+		authSvc:   authSvc,
+		userSvc:   userSvc,
+		login:     usecase.NewLoginUserUseCase(userSvc, authSvc, newTestLoginGuard(events)),
+		refresh:   usecase.NewRefreshTokenUseCase(authSvc, userSvc),
+		logout:    usecase.NewLogoutUseCase(authSvc),
+		logoutAll: usecase.NewLogoutAllDevicesUseCase(authSvc, userSvc),
+		user:      user,
+	}
+}
+
+func (f *refreshFixture) signIn(t *testing.T) string {
+	t.Helper()
+	output, err := f.login.Execute(context.Background(), dto.LoginUserInput{Email: f.user.Email, Password: "password"})
+	if err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+	return output.RefreshToken
+}
+
+func (f *refreshFixture) rotate(t *testing.T, refreshToken string) string {
+	t.Helper()
+	output, err := f.refresh.Execute(context.Background(), dto.RefreshTokenInput{RefreshToken: refreshToken})
+	if err != nil {
+		t.Fatalf("Failed to refresh token: %v", err)
+	}
+	return output.RefreshToken
+}
+
+func (f *refreshFixture) expectRejected(t *testing.T, refreshToken string) {
+	t.Helper()
+	_, err := f.refresh.Execute(context.Background(), dto.RefreshTokenInput{RefreshToken: refreshToken})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeUnauthenticated {
+		t.Errorf("Expected Unauthenticated error, got %v", err)
+	}
+}
+
+func TestRefreshTokenStoredAsHashWithExpiry(t *testing.T) {
+	f := newRefreshFixture(t, 7*24*time.Hour)
+	refreshToken := f.signIn(t)
+
+	if len(f.authRepo.tokens) != 1 {
+		t.Fatalf("Expected one stored token, got %d", len(f.authRepo.tokens))
+	}
+	for hash, token := range f.authRepo.tokens {
+		if strings.Contains(hash, refreshToken) || hash != authservice.HashRefreshToken(refreshToken) {
+			t.Errorf("Expected only the hash of the token to be stored, got %q", hash)
+		}
+		if until := time.Until(token.ExpiresAt); until < 6*24*time.Hour || until > 7*24*time.Hour {
+			t.Errorf("Expected the token to expire after the refresh TTL, expires in %v", until)
+		}
+	}
+}
+
+func TestRefreshTokenExpired(t *testing.T) {
+	f := newRefreshFixture(t, -time.Minute)
+	f.expectRejected(t, f.signIn(t))
+}
+
+func TestRefreshTokenRotationKeepsFamily(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	first := f.signIn(t)
+	second := f.rotate(t, first)
+	third := f.rotate(t, second)
+
+	firstToken := f.authRepo.tokens[authservice.HashRefreshToken(first)]
+	thirdToken := f.authRepo.tokens[authservice.HashRefreshToken(third)]
+	if firstToken.FamilyID != thirdToken.FamilyID {
+		t.Error("Expected rotated tokens to stay in the family of the login")
+	}
+	if firstToken.RotatedAt == nil {
+		t.Error("Expected the first token to be marked rotated")
+	}
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	stolen := f.signIn(t)
+	current := f.rotate(t, stolen)
+	otherDevice := f.signIn(t)
+
+	// The attacker replays the rotated token: it is refused and the legitimate successor dies with it
+	f.expectRejected(t, stolen)
+	f.expectRejected(t, current)
+
+	if _, err := f.authSvc.ValidateRefreshToken(context.Background(), otherDevice); err != nil {
+		t.Errorf("Expected another login to be unaffected, got %v", err)
+	}
+}
+
+func TestLogoutRevokesSession(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	refreshToken := f.rotate(t, f.signIn(t))
+	otherDevice := f.signIn(t)
+
+	if err := f.logout.Execute(context.Background(), dto.LogoutInput{RefreshToken: refreshToken}); err != nil {
+		t.Fatalf("Failed to logout: %v", err)
+	}
+	f.expectRejected(t, refreshToken)
+
+	if _, err := f.authSvc.ValidateRefreshToken(context.Background(), otherDevice); err != nil {
+		t.Errorf("Expected another device to stay signed in, got %v", err)
+	}
+
+	if err := f.logout.Execute(context.Background(), dto.LogoutInput{RefreshToken: "unknown"}); err != nil {
+		t.Errorf("Expected logout with an unknown token to succeed, got %v", err)
+	}
+}
+
+func TestLogoutAllDevices(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	phone := f.rotate(t, f.signIn(t))
+	laptop := f.signIn(t)
+
+	output, err := f.logoutAll.Execute(context.Background(), dto.LogoutAllDevicesInput{UserID: f.user.ID.String()})
+	if err != nil {
+		t.Fatalf("Failed to logout all devices: %v", err)
+	}
+	if output.SessionsRevoked != 2 {
+		t.Errorf("Expected 2 sessions revoked, got %d", output.SessionsRevoked)
+	}
+
+	f.expectRejected(t, phone)
+	f.expectRejected(t, laptop)
+	if f.user.CalendarFeedVersion != 1 {
+		t.Errorf("Expected calendar feed links to be revoked, got feed version %d", f.user.CalendarFeedVersion)
+	}
+
+	if _, err := f.logoutAll.Execute(context.Background(), dto.LogoutAllDevicesInput{UserID: "not-a-uuid"}); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected InvalidArgument for a malformed user ID, got %v", err)
+	}
+}
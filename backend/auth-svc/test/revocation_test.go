@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	"github.com/diploma/auth-svc/internal/domain/auth/entity"
+	authservice "github.com/diploma/auth-svc/internal/domain/auth/service"
+)
+
+type revocation struct {
+	UserID        string
+	RevokedBefore time.Time
+	Reason        string
+}
+
+type MockEventPublisher struct {
+	mu            sync.Mutex
+	revocations   []revocation
+	accountEvents []accountEvent
+	lockouts      []*entity.LoginLockout
+}
+
+func NewMockEventPublisher() *MockEventPublisher {
+	return &MockEventPublisher{}
+}
+
+func (m *MockEventPublisher) PublishTokensRevoked(ctx context.Context, userID string, revokedBefore time.Time, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.revocations = append(m.revocations, revocation{UserID: userID, RevokedBefore: revokedBefore, Reason: reason})
+	return nil
+}
+
+func (m *MockEventPublisher) Revocations() []revocation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]revocation(nil), m.revocations...)
+}
+
+func (f *refreshFixture) expectRevocation(t *testing.T, reason string) {
+	t.Helper()
+	revocations := f.events.Revocations()
+	if len(revocations) != 1 {
+		t.Fatalf("Expected one access token revocation, got %+v", revocations)
+	}
+	if revocations[0].UserID != f.user.ID.String() || revocations[0].Reason != reason {
+		t.Errorf("Expected %s revocation for %s, got %+v", reason, f.user.ID, revocations[0])
+	}
+	if time.Since(revocations[0].RevokedBefore) > time.Minute {
+		t.Errorf("Expected the revocation to cover tokens issued until now, got %v", revocations[0].RevokedBefore)
+	}
+}
+
+func TestLogoutAllDevicesRevokesAccessTokens(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	f.signIn(t)
+
+	if _, err := f.logoutAll.Execute(context.Background(), dto.LogoutAllDevicesInput{UserID: f.user.ID.String()}); err != nil {
+		t.Fatalf("Failed to logout all devices: %v", err)
+	}
+	f.expectRevocation(t, authservice.RevocationLogoutAll)
+}
+
+func TestRefreshTokenReuseRevokesAccessTokens(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	stolen := f.signIn(t)
+	f.rotate(t, stolen)
+
+	f.expectRejected(t, stolen)
+	f.expectRevocation(t, authservice.RevocationTokenReuse)
+}
+
+func TestSetUserRoleRevokesAccessTokens(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+
+	setRole := usecase.NewSetUserRoleUseCase(f.userSvc, f.authSvc)
+	if err := setRole.Execute(context.Background(), dto.SetUserRoleInput{UserID: f.user.ID.String(), Role: "player"}); err != nil {
+		t.Fatalf("Failed to set role: %v", err)
+	}
+	f.expectRevocation(t, authservice.RevocationRoleChanged)
+}
+
+func TestLogoutKeepsOtherAccessTokens(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+
+	if err := f.logout.Execute(context.Background(), dto.LogoutInput{RefreshToken: f.signIn(t)}); err != nil {
+		t.Fatalf("Failed to logout: %v", err)
+	}
+	if revocations := f.events.Revocations(); len(revocations) != 0 {
+		t.Errorf("Expected signing out one device to leave access tokens alone, got %+v", revocations)
+	}
+}
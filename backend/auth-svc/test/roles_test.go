@@ -0,0 +1,109 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authv1 "github.com/diploma/auth-svc/api/v1"
+	"github.com/diploma/auth-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/auth-svc/internal/application/user/dto"
+	"github.com/diploma/auth-svc/internal/application/user/usecase"
+	pkgerrors "github.com/diploma/auth-svc/pkg/errors"
+	"github.com/diploma/authz"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewUsersArePlayers(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	if f.user.Role != authz.RolePlayer {
+		t.Errorf("Expected a new user to be a player, got %q", f.user.Role)
+	}
+
+	output, err := f.login.Execute(context.Background(), dto.LoginUserInput{Email: f.user.Email, Password: "password"})
+	if err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	claims, err := f.authSvc.Verify(output.AccessToken)
+	if err != nil {
+		t.Fatalf("Failed to verify access token: %v", err)
+	}
+	if claims.Role != authz.RolePlayer {
+		t.Errorf("Expected the player role in the token, got %q", claims.Role)
+	}
+}
+
+func TestSetUserRoleAppliesOnRefresh(t *testing.T) {
+	f := newRefreshFixture(t, time.Hour)
+	user := f.user
+	refreshToken := f.signIn(t)
+
+	setRole := usecase.NewSetUserRoleUseCase(f.userSvc, f.authSvc)
+	if err := setRole.Execute(context.Background(), dto.SetUserRoleInput{UserID: user.ID.String(), Role: "venue_owner"}); err != nil {
+		t.Fatalf("Failed to set role: %v", err)
+	}
+
+	output, err := f.refresh.Execute(context.Background(), dto.RefreshTokenInput{RefreshToken: refreshToken})
+	if err != nil {
+		t.Fatalf("Failed to refresh: %v", err)
+	}
+	claims, err := f.authSvc.Verify(output.AccessToken)
+	if err != nil || claims.Role != authz.RoleVenueOwner {
+		t.Errorf("Expected the refreshed token to carry venue_owner, got %v (%v)", claims, err)
+	}
+
+	cases := []struct {
+		input dto.SetUserRoleInput
+		code  string
+	}{
+		{dto.SetUserRoleInput{UserID: user.ID.String(), Role: "superuser"}, pkgerrors.CodeInvalidArgument},
+		{dto.SetUserRoleInput{UserID: "not-a-uuid", Role: "admin"}, pkgerrors.CodeInvalidArgument},
+		{dto.SetUserRoleInput{UserID: uuid.NewString(), Role: "admin"}, pkgerrors.CodeNotFound},
+	}
+	for _, c := range cases {
+		if err := setRole.Execute(context.Background(), c.input); pkgerrors.GetErrorCode(err) != c.code {
+			t.Errorf("Expected %s for %+v, got %v", c.code, c.input, err)
+		}
+	}
+}
+
+func TestAccessPolicy(t *testing.T) {
+	for _, method := range authv1.AuthService_ServiceDesc.Methods {
+		fullMethod := "/" + authv1.AuthService_ServiceDesc.ServiceName + "/" + method.MethodName
+		if _, ok := handler.AccessPolicy[fullMethod]; !ok {
+			t.Errorf("Expected an access rule for %s", fullMethod)
+		}
+	}
+
+	f := newRefreshFixture(t, time.Hour)
+	interceptor := authz.UnaryServerInterceptor(f.authSvc, handler.AccessPolicy)
+	call := func(method string, role authz.Role) error {
+		ctx := context.Background()
+		if role != "" {
+			token, err := f.authSvc.GenerateToken(uuid.NewString(), "someone@example.com", role, false)
+			if err != nil {
+				t.Fatalf("Failed to generate token: %v", err)
+			}
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	if err := call(authv1.AuthService_ValidateToken_FullMethodName, ""); err != nil {
+		t.Errorf("Expected ValidateToken to be callable without a token, got %v", err)
+	}
+	if err := call(authv1.AuthService_SetUserRole_FullMethodName, authz.RoleVenueOwner); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected SetUserRole to be denied to venue owners, got %v", err)
+	}
+	if err := call(authv1.AuthService_SetUserRole_FullMethodName, authz.RoleAdmin); err != nil {
+		t.Errorf("Expected SetUserRole to be allowed to admins, got %v", err)
+	}
+}
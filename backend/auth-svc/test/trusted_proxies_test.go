@@ -0,0 +1,58 @@
+package test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/diploma/auth-svc/internal/adapters/inbound/grpc/handler"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func callFrom(peerAddr, forwarded string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerAddr), Port: 40000}})
+	if forwarded != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwarded))
+	}
+	return ctx
+}
+
+func TestClientIP_HonoursForwardedAddressFromTrustedProxy(t *testing.T) {
+	proxies, err := handler.NewTrustedProxies([]string{"10.0.0.0/24", "192.168.1.7"})
+	if err != nil {
+		t.Fatalf("Failed to parse trusted proxies: %v", err)
+	}
+
+	if ip := proxies.ClientIP(callFrom("10.0.0.12", "203.0.113.9, 10.0.0.12")); ip != "203.0.113.9" {
+		t.Errorf("Expected the forwarded address from a trusted subnet, got %q", ip)
+	}
+	if ip := proxies.ClientIP(callFrom("192.168.1.7", "203.0.113.10")); ip != "203.0.113.10" {
+		t.Errorf("Expected the forwarded address from a trusted host, got %q", ip)
+	}
+	if ip := proxies.ClientIP(callFrom("10.0.0.12", "")); ip != "10.0.0.12" {
+		t.Errorf("Expected the peer address when nothing is forwarded, got %q", ip)
+	}
+}
+
+func TestClientIP_IgnoresForwardedAddressFromUntrustedCaller(t *testing.T) {
+	proxies, err := handler.NewTrustedProxies([]string{"10.0.0.0/24"})
+	if err != nil {
+		t.Fatalf("Failed to parse trusted proxies: %v", err)
+	}
+
+	if ip := proxies.ClientIP(callFrom("172.16.0.5", "203.0.113.9")); ip != "172.16.0.5" {
+		t.Errorf("Expected the peer address for an untrusted caller, got %q", ip)
+	}
+
+	var none *handler.TrustedProxies
+	if ip := none.ClientIP(callFrom("10.0.0.12", "203.0.113.9")); ip != "10.0.0.12" {
+		t.Errorf("Expected no proxy to be trusted by default, got %q", ip)
+	}
+}
+
+func TestNewTrustedProxies_RejectsBadCIDR(t *testing.T) {
+	if _, err := handler.NewTrustedProxies([]string{"10.0.0.0/99"}); err == nil {
+		t.Error("Expected an invalid CIDR to be rejected")
+	}
+}
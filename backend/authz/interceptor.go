@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Rule is the access requirement of one RPC
+type Rule struct {
+	public        bool
+	permission    Permission
+	verifiedEmail bool
+}
+
+// Public methods need no token; they are reads shared with other services
+func Public() Rule {
+	return Rule{public: true}
+}
+
+// Authenticated methods need a valid token but no particular permission
+func Authenticated() Rule {
+	return Rule{}
+}
+
+func Require(permission Permission) Rule {
+	return Rule{permission: permission}
+}
+
+// WithVerifiedEmail additionally requires the caller to have verified their email address,
+// for actions that involve money or other players
+func (r Rule) WithVerifiedEmail() Rule {
+	r.verifiedEmail = true
+	return r
+}
+
+// Policy maps full gRPC method names to their rule. Methods missing from it are denied.
+type Policy map[string]Rule
+
+func UnaryServerInterceptor(verifier Verifier, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rule, ok := policy[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "no access rule for %s", info.FullMethod)
+		}
+		if rule.public {
+			return handler(ctx, req)
+		}
+
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+		}
+
+		principal := Principal{UserID: claims.UserID, Role: claims.Role, EmailVerified: claims.EmailVerified}
+		if rule.permission != "" && !principal.Can(rule.permission) {
+			return nil, status.Errorf(codes.PermissionDenied, "role %s lacks permission %s", principal.Role, rule.permission)
+		}
+		if rule.verifiedEmail && !principal.EmailVerified {
+			return nil, status.Error(codes.PermissionDenied, ErrEmailNotVerified.Error())
+		}
+
+		return handler(NewContext(ctx, principal), req)
+	}
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "metadata not found")
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return "", status.Error(codes.Unauthenticated, "authorization token not provided")
+	}
+
+	const bearerPrefix = "Bearer "
+	if !strings.HasPrefix(authHeaders[0], bearerPrefix) {
+		return "", status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeaders[0], bearerPrefix), nil
+}
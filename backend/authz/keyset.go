@@ -0,0 +1,157 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSAKeyBits = 2048
+
+// SigningKey is a private key access tokens are signed with; ID goes into the kid header
+type SigningKey struct {
+	ID  string
+	Key crypto.Signer
+}
+
+// KeySet is the signing side of token issuance. The active key signs new tokens; retiring keys
+// are only published so tokens signed before the last rotation keep verifying until they expire.
+type KeySet struct {
+	active SigningKey
+	method jwt.SigningMethod
+	jwks   JWKS
+}
+
+func NewKeySet(active SigningKey, retiring ...SigningKey) (*KeySet, error) {
+	set := &KeySet{active: active}
+	seen := map[string]bool{}
+
+	for _, key := range append([]SigningKey{active}, retiring...) {
+		if key.ID == "" {
+			return nil, errors.New("signing key has no ID")
+		}
+		if seen[key.ID] {
+			return nil, fmt.Errorf("duplicate signing key ID %q", key.ID)
+		}
+		seen[key.ID] = true
+
+		if k, ok := key.Key.Public().(*rsa.PublicKey); ok && k.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key %q is shorter than %d bits", key.ID, minRSAKeyBits)
+		}
+		jwk, err := NewJWK(key.ID, key.Key.Public())
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", key.ID, err)
+		}
+		set.jwks.Keys = append(set.jwks.Keys, jwk)
+	}
+
+	method, err := signingMethodFor(active.Key.Public())
+	if err != nil {
+		return nil, err
+	}
+	set.method = method
+
+	return set, nil
+}
+
+// LoadKeySet reads every <kid>.pem private key (PKCS#8, or PKCS#1 for RSA) in dir. The key named
+// activeID signs; the rest are retiring.
+func LoadKeySet(dir, activeID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var active *SigningKey
+	var retiring []SigningKey
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := ParsePrivateKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		key := SigningKey{ID: strings.TrimSuffix(filepath.Base(path), ".pem"), Key: signer}
+		if key.ID == activeID {
+			active = &key
+		} else {
+			retiring = append(retiring, key)
+		}
+	}
+
+	if active == nil {
+		return nil, fmt.Errorf("active signing key %q not found in %s", activeID, dir)
+	}
+	return NewKeySet(*active, retiring...)
+}
+
+// GenerateKeySet makes a throwaway Ed25519 key for local development. Its tokens stop verifying on restart.
+func GenerateKeySet() (*KeySet, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+
+	return NewKeySet(SigningKey{ID: "ephemeral-" + hex.EncodeToString(suffix), Key: private})
+}
+
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		switch k := key.(type) {
+		case *rsa.PrivateKey:
+			return k, nil
+		case ed25519.PrivateKey:
+			return k, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.New("expected an RSA or Ed25519 private key")
+	}
+	return key, nil
+}
+
+func (s *KeySet) ActiveKeyID() string {
+	return s.active.ID
+}
+
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+	token.Header["kid"] = s.active.ID
+	return token.SignedString(s.active.Key)
+}
+
+// FetchJWKS lets a KeySet act as the KeySource of its own verifier
+func (s *KeySet) FetchJWKS(ctx context.Context) (*JWKS, error) {
+	return &JWKS{Keys: append([]JWK(nil), s.jwks.Keys...)}, nil
+}
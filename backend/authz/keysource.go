@@ -0,0 +1,44 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// HTTPKeySource reads a JWKS document, normally the gateway's /.well-known/jwks.json
+type HTTPKeySource struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPKeySource(url string) *HTTPKeySource {
+	return &HTTPKeySource{
+		url:    url,
+		client: &http.Client{Timeout: keyFetchTimeout},
+	}
+}
+
+func (s *HTTPKeySource) FetchJWKS(ctx context.Context) (*JWKS, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: %s returned %d", s.url, resp.StatusCode)
+	}
+
+	var jwks JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+	return &jwks, nil
+}
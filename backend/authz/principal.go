@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID        string
+	Role          Role
+	EmailVerified bool
+}
+
+var ErrEmailNotVerified = errors.New("email address must be verified first")
+
+func (p Principal) Can(permission Permission) bool {
+	return p.Role.Can(permission)
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// AuthorizeUser checks that the caller may act as userID, i.e. is that user or may act for others
+func AuthorizeUser(ctx context.Context, userID string) error {
+	principal, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if principal.UserID != userID && !principal.Can(PermActForOthers) {
+		return status.Error(codes.PermissionDenied, "cannot act on behalf of another user")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package authz
+
+type Role string
+
+const (
+	RolePlayer     Role = "player"
+	RoleVenueOwner Role = "venue_owner"
+	RoleAdmin      Role = "admin"
+)
+
+type Permission string
+
+const (
+	PermVenueCreate Permission = "venue:create"
+	// PermVenueManage lets a caller modify venues they own, along with their resources and schedules
+	PermVenueManage Permission = "venue:manage"
+	// PermVenueManageAny lifts the ownership check of PermVenueManage
+	PermVenueManageAny Permission = "venue:manage_any"
+
+	PermReservationCreate  Permission = "reservation:create"
+	PermReservationCancel  Permission = "reservation:cancel"
+	PermReservationConfirm Permission = "reservation:confirm"
+
+	PermSessionHost Permission = "session:host"
+	PermSessionJoin Permission = "session:join"
+
+	// PermPaymentRefund returns a player's money; refunds are issued by staff, not by the payer
+	PermPaymentRefund Permission = "payment:refund"
+
+	// PermActForOthers lets a caller pass a user_id other than their own
+	PermActForOthers Permission = "user:act_for_others"
+	PermManageRoles  Permission = "user:manage_roles"
+
+	// PermManageNotifications covers the notification dead-letter queue and delivery log, which hold
+	// other users' messages
+	PermManageNotifications Permission = "notification:manage"
+)
+
+var playerPermissions = []Permission{
+	PermReservationCreate,
+	PermReservationCancel,
+	PermSessionHost,
+	PermSessionJoin,
+}
+
+var rolePermissions = map[Role]map[Permission]bool{
+	RolePlayer:     grant(playerPermissions),
+	RoleVenueOwner: grant(playerPermissions, PermVenueCreate, PermVenueManage),
+	RoleAdmin: grant(playerPermissions,
+		PermVenueCreate,
+		PermVenueManage,
+		PermVenueManageAny,
+		PermReservationConfirm,
+		PermPaymentRefund,
+		PermActForOthers,
+		PermManageRoles,
+		PermManageNotifications,
+	),
+}
+
+func grant(base []Permission, extra ...Permission) map[Permission]bool {
+	permissions := make(map[Permission]bool, len(base)+len(extra))
+	for _, permission := range append(append([]Permission{}, base...), extra...) {
+		permissions[permission] = true
+	}
+	return permissions
+}
+
+func ParseRole(value string) (Role, bool) {
+	role := Role(value)
+	_, ok := rolePermissions[role]
+	return role, ok
+}
+
+func (r Role) Can(permission Permission) bool {
+	if r == RoleService {
+		return servicePermissions[permission]
+	}
+	return rolePermissions[r][permission]
+}
@@ -0,0 +1,188 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diploma/authz"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const issuer = "auth-svc"
+
+func newKeySet(t *testing.T) *authz.KeySet {
+	t.Helper()
+	keys, err := authz.GenerateKeySet()
+	if err != nil {
+		t.Fatalf("Failed to generate key set: %v", err)
+	}
+	return keys
+}
+
+func signToken(t *testing.T, keys *authz.KeySet, claims authz.Claims) string {
+	t.Helper()
+	token, err := keys.Sign(claims)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+func claimsFor(userID string, role authz.Role, expiresIn time.Duration) authz.Claims {
+	return authz.Claims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+		},
+	}
+}
+
+func TestRolePermissions(t *testing.T) {
+	cases := []struct {
+		role       authz.Role
+		permission authz.Permission
+		want       bool
+	}{
+		{authz.RolePlayer, authz.PermReservationCreate, true},
+		{authz.RolePlayer, authz.PermVenueCreate, false},
+		{authz.RolePlayer, authz.PermVenueManage, false},
+		{authz.RoleVenueOwner, authz.PermSessionJoin, true},
+		{authz.RoleVenueOwner, authz.PermVenueManage, true},
+		{authz.RoleVenueOwner, authz.PermVenueManageAny, false},
+		{authz.RoleAdmin, authz.PermVenueManageAny, true},
+		{authz.RoleAdmin, authz.PermManageRoles, true},
+		{authz.RolePlayer, authz.PermPaymentRefund, false},
+		{authz.RoleAdmin, authz.PermPaymentRefund, true},
+		{authz.Role("guest"), authz.PermSessionJoin, false},
+	}
+
+	for _, c := range cases {
+		if got := c.role.Can(c.permission); got != c.want {
+			t.Errorf("Expected %s.Can(%s) to be %v", c.role, c.permission, c.want)
+		}
+	}
+
+	if _, ok := authz.ParseRole("venue_owner"); !ok {
+		t.Error("Expected venue_owner to parse")
+	}
+	if _, ok := authz.ParseRole("superuser"); ok {
+		t.Error("Expected an unknown role to be rejected")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	policy := authz.Policy{
+		"/venue.v1.VenueService/GetVenue":    authz.Public(),
+		"/venue.v1.VenueService/CreateVenue": authz.Require(authz.PermVenueCreate),
+		"/auth.v1.AuthService/Logout":        authz.Authenticated(),
+		"/session.v1.SessionService/Create":  authz.Require(authz.PermSessionHost).WithVerifiedEmail(),
+	}
+	keys := newKeySet(t)
+	interceptor := authz.UnaryServerInterceptor(authz.NewJWKSVerifier(keys, issuer, time.Minute), policy)
+
+	call := func(method, token string) (authz.Principal, error) {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		var principal authz.Principal
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			principal, _ = authz.FromContext(ctx)
+			return nil, nil
+		})
+		return principal, err
+	}
+
+	player := signToken(t, keys, claimsFor("player-1", authz.RolePlayer, time.Minute))
+	owner := signToken(t, keys, claimsFor("owner-1", authz.RoleVenueOwner, time.Minute))
+	verifiedClaims := claimsFor("player-2", authz.RolePlayer, time.Minute)
+	verifiedClaims.EmailVerified = true
+	verified := signToken(t, keys, verifiedClaims)
+
+	cases := []struct {
+		name   string
+		method string
+		token  string
+		want   codes.Code
+	}{
+		{"public without token", "/venue.v1.VenueService/GetVenue", "", codes.OK},
+		{"missing token", "/venue.v1.VenueService/CreateVenue", "", codes.Unauthenticated},
+		{"bad token", "/venue.v1.VenueService/CreateVenue", "bogus", codes.Unauthenticated},
+		{"missing permission", "/venue.v1.VenueService/CreateVenue", player, codes.PermissionDenied},
+		{"granted permission", "/venue.v1.VenueService/CreateVenue", owner, codes.OK},
+		{"authenticated only", "/auth.v1.AuthService/Logout", player, codes.OK},
+		{"method without rule", "/venue.v1.VenueService/DeleteVenue", owner, codes.PermissionDenied},
+		{"unverified email", "/session.v1.SessionService/Create", player, codes.PermissionDenied},
+		{"verified email", "/session.v1.SessionService/Create", verified, codes.OK},
+	}
+	for _, c := range cases {
+		if _, err := call(c.method, c.token); status.Code(err) != c.want {
+			t.Errorf("%s: expected %s, got %v", c.name, c.want, err)
+		}
+	}
+
+	principal, _ := call("/venue.v1.VenueService/CreateVenue", owner)
+	if principal.UserID != "owner-1" || principal.Role != authz.RoleVenueOwner {
+		t.Errorf("Expected the caller in the handler context, got %+v", principal)
+	}
+	if principal, _ := call("/session.v1.SessionService/Create", verified); !principal.EmailVerified {
+		t.Errorf("Expected the verified flag in the handler context, got %+v", principal)
+	}
+}
+
+func TestAuthorizeUser(t *testing.T) {
+	if err := authz.AuthorizeUser(context.Background(), "user-1"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected Unauthenticated without a principal, got %v", err)
+	}
+
+	player := authz.NewContext(context.Background(), authz.Principal{UserID: "user-1", Role: authz.RolePlayer})
+	if err := authz.AuthorizeUser(player, "user-1"); err != nil {
+		t.Errorf("Expected a user to act as themselves, got %v", err)
+	}
+	if err := authz.AuthorizeUser(player, "user-2"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for another user, got %v", err)
+	}
+
+	admin := authz.NewContext(context.Background(), authz.Principal{UserID: "admin-1", Role: authz.RoleAdmin})
+	if err := authz.AuthorizeUser(admin, "user-2"); err != nil {
+		t.Errorf("Expected an admin to act for others, got %v", err)
+	}
+}
+
+func TestWithServiceKey(t *testing.T) {
+	keys := newKeySet(t)
+	verifier := authz.WithServiceKey(authz.NewJWKSVerifier(keys, issuer, time.Minute), "internal-key")
+
+	claims, err := verifier.Verify("internal-key")
+	if err != nil {
+		t.Fatalf("Expected the service key to verify, got %v", err)
+	}
+	if claims.Role != authz.RoleService || claims.UserID != authz.ServiceUserID {
+		t.Errorf("Expected service claims, got %+v", claims)
+	}
+	if _, err := verifier.Verify("other-key"); err == nil {
+		t.Error("Expected a wrong key to be rejected")
+	}
+	if claims, err := verifier.Verify(signToken(t, keys, claimsFor("user-1", authz.RolePlayer, time.Minute))); err != nil || claims.UserID != "user-1" {
+		t.Errorf("Expected user tokens to still verify, got %+v, %v", claims, err)
+	}
+
+	// Services may read for users but not act with any user permission
+	if !authz.RoleService.Can(authz.PermActForOthers) || authz.RoleService.Can(authz.PermReservationCancel) || authz.RoleService.Can(authz.PermPaymentRefund) {
+		t.Error("Expected the service role to only act for others")
+	}
+	if _, ok := authz.ParseRole(string(authz.RoleService)); ok {
+		t.Error("Expected the service role not to be assignable")
+	}
+
+	if _, err := authz.WithServiceKey(authz.NewJWKSVerifier(keys, issuer, time.Minute), "").Verify(""); err == nil {
+		t.Error("Expected an empty key to be disabled")
+	}
+}
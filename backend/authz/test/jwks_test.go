@@ -0,0 +1,241 @@
+package test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diploma/authz"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// swappableSource serves whatever key set is current and counts fetches
+type swappableSource struct {
+	mu      sync.Mutex
+	keys    *authz.KeySet
+	fetches int
+}
+
+func (s *swappableSource) FetchJWKS(ctx context.Context) (*authz.JWKS, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetches++
+	return s.keys.FetchJWKS(ctx)
+}
+
+func (s *swappableSource) swap(keys *authz.KeySet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func rsaKey(t *testing.T, id string) authz.SigningKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	return authz.SigningKey{ID: id, Key: key}
+}
+
+func ed25519Key(t *testing.T, id string) authz.SigningKey {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed25519 key: %v", err)
+	}
+	return authz.SigningKey{ID: id, Key: key}
+}
+
+func mustKeySet(t *testing.T, active authz.SigningKey, retiring ...authz.SigningKey) *authz.KeySet {
+	t.Helper()
+	keys, err := authz.NewKeySet(active, retiring...)
+	if err != nil {
+		t.Fatalf("Failed to build key set: %v", err)
+	}
+	return keys
+}
+
+func TestKeySetSignsWithKid(t *testing.T) {
+	for name, key := range map[string]authz.SigningKey{
+		"RS256": rsaKey(t, "rsa-1"),
+		"EdDSA": ed25519Key(t, "ed-1"),
+	} {
+		keys := mustKeySet(t, key)
+		token := signToken(t, keys, claimsFor("user-1", authz.RoleVenueOwner, time.Minute))
+
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, &authz.Claims{})
+		if err != nil {
+			t.Fatalf("%s: failed to parse token: %v", name, err)
+		}
+		if parsed.Header["kid"] != key.ID || parsed.Method.Alg() != name {
+			t.Errorf("%s: unexpected header %v", name, parsed.Header)
+		}
+
+		claims, err := authz.NewJWKSVerifier(keys, issuer, time.Minute).Verify(token)
+		if err != nil {
+			t.Fatalf("%s: expected a valid token, got %v", name, err)
+		}
+		if claims.UserID != "user-1" || claims.Role != authz.RoleVenueOwner {
+			t.Errorf("%s: unexpected claims %+v", name, claims)
+		}
+	}
+}
+
+func TestJWKSVerifierRejects(t *testing.T) {
+	keys := mustKeySet(t, ed25519Key(t, "ed-1"))
+	verifier := authz.NewJWKSVerifier(keys, issuer, time.Minute)
+
+	claims, err := verifier.Verify(signToken(t, keys, claimsFor("user-1", "", time.Minute)))
+	if err != nil || claims.Role != authz.RolePlayer {
+		t.Errorf("Expected a token without a role to belong to a player, got %v (%v)", claims, err)
+	}
+
+	wrongIssuer := claimsFor("user-1", authz.RolePlayer, time.Minute)
+	wrongIssuer.Issuer = "someone-else"
+
+	// An HS256 token naming a published kid must not be checked against anything
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, claimsFor("user-1", authz.RoleAdmin, time.Minute))
+	hmac.Header["kid"] = "ed-1"
+	hmacToken, _ := hmac.SignedString([]byte("guessable"))
+
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodNone, claimsFor("user-1", authz.RoleAdmin, time.Minute))
+	unsigned.Header["kid"] = "ed-1"
+	unsignedToken, _ := unsigned.SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	for name, token := range map[string]string{
+		"garbage":      "not.a.token",
+		"foreign key":  signToken(t, mustKeySet(t, ed25519Key(t, "ed-1")), claimsFor("user-1", authz.RolePlayer, time.Minute)),
+		"unknown kid":  signToken(t, mustKeySet(t, ed25519Key(t, "ed-2")), claimsFor("user-1", authz.RolePlayer, time.Minute)),
+		"expired":      signToken(t, keys, claimsFor("user-1", authz.RolePlayer, -time.Minute)),
+		"wrong issuer": signToken(t, keys, wrongIssuer),
+		"unknown role": signToken(t, keys, claimsFor("user-1", "superuser", time.Minute)),
+		"HS256":        hmacToken,
+		"alg none":     unsignedToken,
+	} {
+		if _, err := verifier.Verify(token); !errors.Is(err, authz.ErrInvalidToken) {
+			t.Errorf("Expected %s token to be rejected, got %v", name, err)
+		}
+	}
+}
+
+func TestJWKSVerifierKeyRotation(t *testing.T) {
+	oldKey, newKey := ed25519Key(t, "2026-09"), rsaKey(t, "2026-10")
+	source := &swappableSource{keys: mustKeySet(t, oldKey)}
+	verifier := authz.NewJWKSVerifier(source, issuer, time.Hour)
+
+	oldToken := signToken(t, source.keys, claimsFor("user-1", authz.RolePlayer, time.Minute))
+	if _, err := verifier.Verify(oldToken); err != nil {
+		t.Fatalf("Expected the old key to verify, got %v", err)
+	}
+	if _, err := verifier.Verify(oldToken); err != nil || source.fetches != 1 {
+		t.Fatalf("Expected cached keys to be reused, got %d fetches (%v)", source.fetches, err)
+	}
+
+	// Rotate: the new key signs, the old one is retiring but still published
+	rotated := mustKeySet(t, newKey, oldKey)
+	source.swap(rotated)
+	newToken := signToken(t, rotated, claimsFor("user-1", authz.RolePlayer, time.Minute))
+
+	if err := verifier.Refresh(context.Background()); err != nil {
+		t.Fatalf("Failed to refresh keys: %v", err)
+	}
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		if _, err := verifier.Verify(token); err != nil {
+			t.Errorf("Expected the %s token to verify after rotation, got %v", name, err)
+		}
+	}
+
+	// Retire the old key for good
+	source.swap(mustKeySet(t, newKey))
+	if err := verifier.Refresh(context.Background()); err != nil {
+		t.Fatalf("Failed to refresh keys: %v", err)
+	}
+	if _, err := verifier.Verify(oldToken); !errors.Is(err, authz.ErrInvalidToken) {
+		t.Errorf("Expected the retired key to stop verifying, got %v", err)
+	}
+}
+
+func TestJWKSVerifierFetchesUnknownKid(t *testing.T) {
+	first := mustKeySet(t, ed25519Key(t, "ed-1"))
+	source := &swappableSource{keys: first}
+	verifier := authz.NewJWKSVerifier(source, issuer, 0)
+
+	if _, err := verifier.Verify(signToken(t, first, claimsFor("user-1", authz.RolePlayer, time.Minute))); err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+
+	second := mustKeySet(t, ed25519Key(t, "ed-2"))
+	source.swap(second)
+	if _, err := verifier.Verify(signToken(t, second, claimsFor("user-1", authz.RolePlayer, time.Minute))); err != nil {
+		t.Errorf("Expected a new kid to trigger a refetch, got %v", err)
+	}
+}
+
+func TestHTTPKeySource(t *testing.T) {
+	keys := mustKeySet(t, rsaKey(t, "rsa-1"), ed25519Key(t, "ed-1"))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/jwks.json" {
+			http.NotFound(w, r)
+			return
+		}
+		jwks, _ := keys.FetchJWKS(r.Context())
+		json.NewEncoder(w).Encode(jwks)
+	}))
+	defer server.Close()
+
+	verifier := authz.NewJWKSVerifier(authz.NewHTTPKeySource(server.URL+"/.well-known/jwks.json"), issuer, time.Minute)
+	if _, err := verifier.Verify(signToken(t, keys, claimsFor("user-1", authz.RolePlayer, time.Minute))); err != nil {
+		t.Errorf("Expected a token verified against fetched keys, got %v", err)
+	}
+
+	down := authz.NewJWKSVerifier(authz.NewHTTPKeySource(server.URL+"/missing"), issuer, time.Minute)
+	if _, err := down.Verify(signToken(t, keys, claimsFor("user-1", authz.RolePlayer, time.Minute))); !errors.Is(err, authz.ErrInvalidToken) {
+		t.Errorf("Expected tokens to be rejected without keys, got %v", err)
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+	writeKey := func(key authz.SigningKey) {
+		der, err := x509.MarshalPKCS8PrivateKey(key.Key)
+		if err != nil {
+			t.Fatalf("Failed to marshal key: %v", err)
+		}
+		data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		if err := os.WriteFile(filepath.Join(dir, key.ID+".pem"), data, 0o600); err != nil {
+			t.Fatalf("Failed to write key: %v", err)
+		}
+	}
+	writeKey(ed25519Key(t, "2026-09"))
+	writeKey(rsaKey(t, "2026-10"))
+
+	keys, err := authz.LoadKeySet(dir, "2026-10")
+	if err != nil {
+		t.Fatalf("Failed to load key set: %v", err)
+	}
+	if keys.ActiveKeyID() != "2026-10" {
+		t.Errorf("Expected 2026-10 to be active, got %s", keys.ActiveKeyID())
+	}
+
+	jwks, _ := keys.FetchJWKS(context.Background())
+	if len(jwks.Keys) != 2 || jwks.Keys[0].Kid != "2026-10" || jwks.Keys[0].Alg != "RS256" || jwks.Keys[1].Alg != "EdDSA" {
+		t.Errorf("Expected the active key first and the retiring key after it, got %+v", jwks.Keys)
+	}
+
+	if _, err := authz.LoadKeySet(dir, "2026-11"); err == nil {
+		t.Error("Expected a missing active key to be an error")
+	}
+}
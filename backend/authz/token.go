@@ -0,0 +1,166 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+const (
+	maxKeyRefreshInterval = 10 * time.Second
+	keyFetchTimeout       = 5 * time.Second
+)
+
+// Claims is the payload of the access tokens issued by auth-svc
+type Claims struct {
+	UserID        string `json:"user_id"`
+	Email         string `json:"email"`
+	Role          Role   `json:"role"`
+	EmailVerified bool   `json:"email_verified"`
+	jwt.RegisteredClaims
+}
+
+type Verifier interface {
+	Verify(token string) (*Claims, error)
+}
+
+// KeySource publishes the public keys tokens may be signed with
+type KeySource interface {
+	FetchJWKS(ctx context.Context) (*JWKS, error)
+}
+
+// JWKSVerifier checks tokens against keys from a KeySource without holding any signing secret.
+// Keys are cached for cacheTTL and refetched early when a token names an unknown kid, so a
+// rotated key is picked up without a restart.
+type JWKSVerifier struct {
+	source          KeySource
+	issuer          string
+	cacheTTL        time.Duration
+	refreshInterval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func NewJWKSVerifier(source KeySource, issuer string, cacheTTL time.Duration) *JWKSVerifier {
+	return &JWKSVerifier{
+		source:          source,
+		issuer:          issuer,
+		cacheTTL:        cacheTTL,
+		refreshInterval: min(cacheTTL, maxKeyRefreshInterval),
+		keys:            map[string]crypto.PublicKey{},
+	}
+}
+
+func (v *JWKSVerifier) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, v.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	// Tokens issued before roles existed carry none; they belong to players
+	if claims.Role == "" {
+		claims.Role = RolePlayer
+	}
+	if _, ok := ParseRole(string(claims.Role)); !ok {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidToken, claims.Role)
+	}
+
+	return claims, nil
+}
+
+// Refresh fetches the key set now, e.g. to warm the cache at startup
+func (v *JWKSVerifier) Refresh(ctx context.Context) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.fetchLocked(ctx)
+}
+
+func (v *JWKSVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("token has no kid")
+	}
+
+	key, err := v.key(kid)
+	if err != nil {
+		return nil, err
+	}
+
+	method, err := signingMethodFor(key)
+	if err != nil {
+		return nil, err
+	}
+	if method.Alg() != token.Method.Alg() {
+		return nil, fmt.Errorf("key %s does not sign %s", kid, token.Method.Alg())
+	}
+
+	return key, nil
+}
+
+func (v *JWKSVerifier) key(kid string) (crypto.PublicKey, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fresh := time.Since(v.fetchedAt) < v.cacheTTL
+	v.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// Throttled so tokens with made-up kids cannot hammer the key source
+	if time.Since(v.lastAttempt) >= v.refreshInterval {
+		ctx, cancel := context.WithTimeout(context.Background(), keyFetchTimeout)
+		defer cancel()
+		if err := v.fetchLocked(ctx); err != nil {
+			// A stale key beats rejecting every token while the source is unreachable
+			log.Printf("Failed to refresh signing keys: %v", err)
+		}
+	}
+
+	key, ok = v.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	return key, nil
+}
+
+func (v *JWKSVerifier) fetchLocked(ctx context.Context) error {
+	v.lastAttempt = time.Now()
+
+	jwks, err := v.source.FetchJWKS(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			log.Printf("Skipping signing key %s: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	v.keys = keys
+	v.fetchedAt = v.lastAttempt
+	return nil
+}
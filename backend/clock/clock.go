@@ -0,0 +1,28 @@
+package clock
+
+import "fmt"
+
+const MinutesPerDay = 24 * 60
+
+// Parse turns "HH:MM" into minutes after midnight. "24:00" is accepted so a schedule slot or quiet
+// hours window can run to the end of the day.
+func Parse(value string) (int, error) {
+	if len(value) != 5 || value[2] != ':' || !digits(value[:2]) || !digits(value[3:]) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	hours := int(value[0]-'0')*10 + int(value[1]-'0')
+	minutes := int(value[3]-'0')*10 + int(value[4]-'0')
+	if minutes > 59 || hours*60+minutes > MinutesPerDay {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return hours*60 + minutes, nil
+}
+
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,3 @@
+module github.com/diploma/clock
+
+go 1.22
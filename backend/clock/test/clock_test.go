@@ -0,0 +1,28 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/diploma/clock"
+)
+
+func TestParse(t *testing.T) {
+	valid := map[string]int{
+		"00:00": 0,
+		"07:30": 450,
+		"23:59": 1439,
+		"24:00": clock.MinutesPerDay,
+	}
+	for value, want := range valid {
+		got, err := clock.Parse(value)
+		if err != nil || got != want {
+			t.Errorf("Expected %q to be %d minutes, got %d (%v)", value, want, got, err)
+		}
+	}
+
+	for _, value := range []string{"", "7:30", "07:3", "07-30", "24:01", "25:00", "12:60", "-1:00", "+1:00", "ab:cd"} {
+		if _, err := clock.Parse(value); err == nil {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
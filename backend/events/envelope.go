@@ -0,0 +1,125 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownEventType   = errors.New("unknown event type")
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+)
+
+type Envelope struct {
+	ID           string          `json:"id"`
+	Type         string          `json:"type"`
+	Version      int             `json:"version"`
+	OccurredAt   time.Time       `json:"occurred_at"`
+	Producer     string          `json:"producer"`
+	TraceContext TraceCarrier    `json:"trace_context,omitempty"`
+	Payload      json.RawMessage `json:"payload"`
+}
+
+// TraceCarrier holds W3C trace context headers and satisfies otel's propagation.TextMapCarrier
+type TraceCarrier map[string]string
+
+func (c TraceCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c TraceCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c TraceCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type TraceInjector func(ctx context.Context, carrier TraceCarrier)
+
+type Codec struct {
+	producer string
+	inject   TraceInjector
+}
+
+func NewCodec(producer string) *Codec {
+	return &Codec{producer: producer}
+}
+
+func (c *Codec) WithTraceInjector(inject TraceInjector) *Codec {
+	return &Codec{producer: c.producer, inject: inject}
+}
+
+func (c *Codec) Encode(ctx context.Context, eventType string, payload interface{}) ([]byte, error) {
+	envelope, err := c.NewEnvelope(ctx, eventType, payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envelope)
+}
+
+func (c *Codec) NewEnvelope(ctx context.Context, eventType string, payload interface{}) (*Envelope, error) {
+	version, ok := SchemaVersion(eventType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s payload: %w", eventType, err)
+	}
+
+	envelope := &Envelope{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		Version:    version,
+		OccurredAt: time.Now().UTC(),
+		Producer:   c.producer,
+		Payload:    raw,
+	}
+
+	if c.inject != nil {
+		carrier := TraceCarrier{}
+		c.inject(ctx, carrier)
+		if len(carrier) > 0 {
+			envelope.TraceContext = carrier
+		}
+	}
+
+	return envelope, nil
+}
+
+func Decode(data []byte) (*Envelope, error) {
+	var envelope Envelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event envelope: %w", err)
+	}
+
+	version, ok := SchemaVersion(envelope.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, envelope.Type)
+	}
+	if envelope.Version < 1 || envelope.Version > version {
+		return nil, fmt.Errorf("%w: %s v%d", ErrUnsupportedVersion, envelope.Type, envelope.Version)
+	}
+
+	return &envelope, nil
+}
+
+func (e *Envelope) UnmarshalPayload(v interface{}) error {
+	if err := json.Unmarshal(e.Payload, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %s payload: %w", e.Type, err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message is an event recorded in a service's outbox table, waiting for the relay to deliver it
+type Message struct {
+	ID            uuid.UUID
+	EventID       string
+	Subject       string
+	Payload       []byte
+	Attempts      int
+	LastError     *string
+	CreatedAt     time.Time
+	NextAttemptAt time.Time
+	SentAt        *time.Time
+}
+
+func NewMessage(eventID, subject string, payload []byte) *Message {
+	now := time.Now()
+	return &Message{
+		ID:            uuid.New(),
+		EventID:       eventID,
+		Subject:       subject,
+		Payload:       payload,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+}
+
+func (m *Message) IsSent() bool {
+	return m.SentAt != nil
+}
@@ -0,0 +1,149 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// MessagePublisher is the part of jetstream.JetStream the relay relies on
+type MessagePublisher interface {
+	PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+type RelayOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxBackoff   time.Duration
+	Retention    time.Duration
+	AckTimeout   time.Duration
+	// ClaimTimeout is how long a claimed batch is left to one relay; past it another replica may take it over
+	ClaimTimeout time.Duration
+}
+
+type Relay struct {
+	outbox    Repository
+	publisher MessagePublisher
+	opts      RelayOptions
+}
+
+func NewRelay(
+	outbox Repository,
+	publisher MessagePublisher,
+	opts RelayOptions,
+) *Relay {
+	return &Relay{
+		outbox:    outbox,
+		publisher: publisher,
+		opts:      opts,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+			r.purge(ctx)
+		}
+	}
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	for {
+		fetched, err := r.RelayBatch(ctx)
+		if err != nil {
+			log.Printf("Failed to relay outbox messages: %v", err)
+			return
+		}
+		if fetched < r.opts.BatchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// RelayBatch claims one batch of due messages, publishes them with no transaction open and returns how
+// many were claimed. A claim left behind by a crashed relay lapses after ClaimTimeout, and the stream's
+// duplicate window drops whatever the next relay publishes again.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	now := time.Now()
+	claimedUntil := now.Add(r.opts.ClaimTimeout)
+	messages, err := r.outbox.ClaimPending(ctx, now, claimedUntil, r.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := make([]uuid.UUID, 0, len(messages))
+	for _, message := range messages {
+		// Past the claim another relay may already be publishing the rest; they are due again as they are
+		if time.Now().After(claimedUntil) {
+			break
+		}
+
+		if err := r.publish(ctx, message); err != nil {
+			if err := r.outbox.MarkFailed(ctx, message.ID, err.Error(), time.Now().Add(r.backoff(message.Attempts+1))); err != nil {
+				log.Printf("Failed to reschedule outbox message %s: %v", message.EventID, err)
+			}
+			continue
+		}
+		published = append(published, message.ID)
+	}
+
+	if len(published) == 0 {
+		return len(messages), nil
+	}
+
+	return len(messages), r.outbox.MarkSent(ctx, published, time.Now())
+}
+
+// publish waits for the stream to acknowledge the message; without an ack it may never have been stored.
+// The stream drops a retry whose ID it saw within its duplicate window, so a lost ack does not duplicate the event.
+func (r *Relay) publish(ctx context.Context, message *Message) error {
+	if r.opts.AckTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.opts.AckTimeout)
+		defer cancel()
+	}
+
+	msg := nats.NewMsg(message.Subject)
+	msg.Header.Set(jetstream.MsgIDHeader, message.EventID)
+	msg.Data = message.Payload
+
+	_, err := r.publisher.PublishMsg(ctx, msg)
+	return err
+}
+
+func (r *Relay) purge(ctx context.Context) {
+	if r.opts.Retention <= 0 {
+		return
+	}
+
+	deleted, err := r.outbox.DeleteSentBefore(ctx, time.Now().Add(-r.opts.Retention))
+	if err != nil {
+		log.Printf("Failed to purge sent outbox messages: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Purged %d sent outbox messages", deleted)
+	}
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.opts.PollInterval
+	for i := 1; i < attempts && delay < r.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.opts.MaxBackoff {
+		delay = r.opts.MaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	Add(ctx context.Context, message *Message) error
+	// ClaimPending takes up to limit due messages, oldest first, and holds them off other relays until
+	// claimedUntil by moving their next attempt there
+	ClaimPending(ctx context.Context, now, claimedUntil time.Time, limit int) ([]*Message, error)
+	MarkSent(ctx context.Context, ids []uuid.UUID, sentAt time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error
+	DeleteSentBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// GormRepository keeps the outbox in table, joining the transaction TransactionManager put in ctx
+type GormRepository struct {
+	db    *gorm.DB
+	table string
+}
+
+func NewRepository(db *gorm.DB, table string) *GormRepository {
+	return &GormRepository{
+		db:    db,
+		table: table,
+	}
+}
+
+func (r *GormRepository) Add(ctx context.Context, message *Message) error {
+	result := DB(ctx, r.db).Table(r.table).Create(message)
+	if result.Error != nil {
+		return fmt.Errorf("failed to add outbox message: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *GormRepository) ClaimPending(ctx context.Context, now, claimedUntil time.Time, limit int) ([]*Message, error) {
+	var messages []*Message
+	// SKIP LOCKED only guards this statement; the moved next_attempt_at keeps the batch claimed while it is published
+	result := DB(ctx, r.db).Raw(`
+		UPDATE `+r.table+`
+		SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM `+r.table+`
+			WHERE sent_at IS NULL AND next_attempt_at <= ?
+			ORDER BY created_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		claimedUntil, now, limit).
+		Scan(&messages)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim pending outbox messages: %w", result.Error)
+	}
+
+	// RETURNING does not keep the subquery's order
+	sort.Slice(messages, func(i, j int) bool { return messages[i].CreatedAt.Before(messages[j].CreatedAt) })
+
+	return messages, nil
+}
+
+func (r *GormRepository) MarkSent(ctx context.Context, ids []uuid.UUID, sentAt time.Time) error {
+	result := DB(ctx, r.db).Table(r.table).Where("id IN ?", ids).Update("sent_at", sentAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark outbox messages as sent: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *GormRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error {
+	result := DB(ctx, r.db).Table(r.table).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":        gorm.Expr("attempts + 1"),
+		"last_error":      lastError,
+		"next_attempt_at": nextAttemptAt,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark outbox message as failed: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *GormRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := DB(ctx, r.db).Table(r.table).Where("sent_at IS NOT NULL AND sent_at < ?", before).Delete(&Message{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete sent outbox messages: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,135 @@
+package events
+
+import "time"
+
+type ReservationCreated struct {
+	ReservationID string    `json:"reservation_id"`
+	UserID        string    `json:"user_id"`
+	ResourceID    string    `json:"resource_id"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+	Status        string    `json:"status"`
+}
+
+type ReservationConfirmed struct {
+	ReservationID string `json:"reservation_id"`
+	UserID        string `json:"user_id"`
+	ResourceID    string `json:"resource_id"`
+}
+
+type ReservationCancelled struct {
+	ReservationID string `json:"reservation_id"`
+	UserID        string `json:"user_id"`
+	ResourceID    string `json:"resource_id"`
+}
+
+type ReservationExpired struct {
+	ReservationID string `json:"reservation_id"`
+	UserID        string `json:"user_id"`
+	ResourceID    string `json:"resource_id"`
+}
+
+type SessionCreated struct {
+	SessionID     string    `json:"session_id"`
+	ReservationID string    `json:"reservation_id"`
+	HostID        string    `json:"host_id"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+}
+
+type SessionJoined struct {
+	SessionID           string `json:"session_id"`
+	UserID              string `json:"user_id"`
+	CurrentParticipants int    `json:"current_participants"`
+}
+
+type SessionFull struct {
+	SessionID string `json:"session_id"`
+}
+
+type SessionCancelled struct {
+	SessionID string `json:"session_id"`
+}
+
+type SessionLeft struct {
+	SessionID string `json:"session_id"`
+	UserID    string `json:"user_id"`
+}
+
+// PaymentCreated v2 added Currency; v1 payments were all in USD
+type PaymentCreated struct {
+	PaymentID string  `json:"payment_id"`
+	SessionID string  `json:"session_id"`
+	UserID    string  `json:"user_id"`
+	Amount    float64 `json:"amount"`
+	Currency  string  `json:"currency"`
+}
+
+// PaymentSucceeded v2 added Currency; v1 payments were all in USD
+type PaymentSucceeded struct {
+	PaymentID string  `json:"payment_id"`
+	SessionID string  `json:"session_id"`
+	UserID    string  `json:"user_id"`
+	Amount    float64 `json:"amount"`
+	Currency  string  `json:"currency"`
+}
+
+type PaymentFailed struct {
+	PaymentID string `json:"payment_id"`
+	SessionID string `json:"session_id"`
+	UserID    string `json:"user_id"`
+	Reason    string `json:"reason"`
+}
+
+type PaymentRefunded struct {
+	PaymentID string `json:"payment_id"`
+	SessionID string `json:"session_id"`
+	UserID    string `json:"user_id"`
+	RefundID  string `json:"refund_id"`
+}
+
+type UserTokensRevoked struct {
+	UserID        string    `json:"user_id"`
+	RevokedBefore time.Time `json:"revoked_before"`
+	Reason        string    `json:"reason"`
+}
+
+// UserEmailVerificationRequested v2 replaced the plaintext token with SealedToken
+type UserEmailVerificationRequested struct {
+	UserID      string    `json:"user_id"`
+	Email       string    `json:"email"`
+	SealedToken string    `json:"sealed_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type UserEmailVerified struct {
+	UserID     string    `json:"user_id"`
+	Email      string    `json:"email"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
+// UserPasswordResetRequested v2 replaced the plaintext token with SealedToken
+type UserPasswordResetRequested struct {
+	UserID      string    `json:"user_id"`
+	Email       string    `json:"email"`
+	SealedToken string    `json:"sealed_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type UserPasswordChanged struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// UserLoginLocked is emitted when repeated failed sign-ins lock an account (Scope "account") or a client
+// address (Scope "ip"); UserID is empty when the email belongs to no account
+type UserLoginLocked struct {
+	Scope       string    `json:"scope"`
+	UserID      string    `json:"user_id,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	ClientIP    string    `json:"client_ip,omitempty"`
+	Failures    int       `json:"failures"`
+	Lockouts    int       `json:"lockouts"`
+	LockedUntil time.Time `json:"locked_until"`
+}
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrUnsealable = errors.New("sealed value cannot be opened")
+
+// Sealer encrypts the secrets an event has to carry, such as single-use account tokens, so streams
+// and dead-letter queues only ever hold ciphertext. The producer and its consumers share the secret.
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+func NewSealer(secret string) (*Sealer, error) {
+	if secret == "" {
+		return nil, errors.New("seal secret is empty")
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sealer{aead: aead}, nil
+}
+
+// Seal binds the ciphertext to subject, so a value sealed for one event type cannot be opened as another
+func (s *Sealer) Seal(subject, plaintext string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := s.aead.Seal(nonce, nonce, []byte(plaintext), []byte(subject))
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+func (s *Sealer) Open(subject, sealed string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil || len(data) < s.aead.NonceSize() {
+		return "", ErrUnsealable
+	}
+
+	nonce, ciphertext := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, []byte(subject))
+	if err != nil {
+		return "", ErrUnsealable
+	}
+
+	return string(plaintext), nil
+}
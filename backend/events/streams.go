@@ -0,0 +1,60 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Stream groups the subjects of one producing service into a JetStream stream
+type Stream struct {
+	Name     string
+	Subjects []string
+}
+
+const (
+	StreamReservations = "RESERVATIONS"
+	StreamSessions     = "SESSIONS"
+	StreamPayments     = "PAYMENTS"
+	StreamUsers        = "USERS"
+)
+
+func Streams() []Stream {
+	return []Stream{
+		{Name: StreamReservations, Subjects: []string{"reservation.>"}},
+		{Name: StreamSessions, Subjects: []string{"session.>"}},
+		{Name: StreamPayments, Subjects: []string{"payment.>"}},
+		{Name: StreamUsers, Subjects: []string{"user.>"}},
+	}
+}
+
+func StreamByName(name string) (Stream, bool) {
+	for _, stream := range Streams() {
+		if stream.Name == name {
+			return stream, true
+		}
+	}
+	return Stream{}, false
+}
+
+// EnsureStream creates the named stream or brings its config up to date. Producers call it at startup
+// so their events are stored before any consumer has subscribed; every caller builds the same config.
+func EnsureStream(ctx context.Context, js jetstream.JetStream, name string, maxAge time.Duration) error {
+	stream, ok := StreamByName(name)
+	if !ok {
+		return fmt.Errorf("unknown stream %s", name)
+	}
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     stream.Name,
+		Subjects: stream.Subjects,
+		Storage:  jetstream.FileStorage,
+		MaxAge:   maxAge,
+	}); err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", stream.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package events
+
+const (
+	SubjectReservationCreated   = "reservation.created"
+	SubjectReservationConfirmed = "reservation.confirmed"
+	SubjectReservationCancelled = "reservation.cancelled"
+	SubjectReservationExpired   = "reservation.expired"
+
+	SubjectSessionCreated   = "session.created"
+	SubjectSessionJoined    = "session.joined"
+	SubjectSessionFull      = "session.full"
+	SubjectSessionCancelled = "session.cancelled"
+	SubjectSessionLeft      = "session.left"
+
+	SubjectPaymentCreated   = "payment.created"
+	SubjectPaymentSucceeded = "payment.succeeded"
+	SubjectPaymentFailed    = "payment.failed"
+	SubjectPaymentRefunded  = "payment.refunded"
+
+	// SubjectUserTokensRevoked tells verifiers to reject the user's access tokens issued before RevokedBefore
+	SubjectUserTokensRevoked = "user.tokens_revoked"
+
+	// The requested events carry the single-use token sealed with a Sealer, so only notification-svc
+	// can read it to mail the link
+	SubjectUserEmailVerificationRequested = "user.email_verification_requested"
+	SubjectUserEmailVerified              = "user.email_verified"
+	SubjectUserPasswordResetRequested     = "user.password_reset_requested"
+	SubjectUserPasswordChanged            = "user.password_changed"
+
+	// SubjectUserLoginLocked audits a sign-in lockout of an account or a client address
+	SubjectUserLoginLocked = "user.login_locked"
+)
+
+// schemaVersions is the current payload version for every event type; bump it on breaking payload changes
+var schemaVersions = map[string]int{
+	SubjectReservationCreated:   1,
+	SubjectReservationConfirmed: 1,
+	SubjectReservationCancelled: 1,
+	SubjectReservationExpired:   1,
+
+	SubjectSessionCreated:   1,
+	SubjectSessionJoined:    1,
+	SubjectSessionFull:      1,
+	SubjectSessionCancelled: 1,
+	SubjectSessionLeft:      1,
+
+	SubjectPaymentCreated:   2,
+	SubjectPaymentSucceeded: 2,
+	SubjectPaymentFailed:    1,
+	SubjectPaymentRefunded:  1,
+
+	SubjectUserTokensRevoked:              1,
+	SubjectUserEmailVerificationRequested: 2,
+	SubjectUserEmailVerified:              1,
+	SubjectUserPasswordResetRequested:     2,
+	SubjectUserPasswordChanged:            1,
+	SubjectUserLoginLocked:                1,
+}
+
+func SchemaVersion(eventType string) (int, bool) {
+	version, ok := schemaVersions[eventType]
+	return version, ok
+}
+
+// CarriesSecret reports event types whose payload holds a secret, even a sealed one; consumers must not
+// keep such payloads around, e.g. in a dead-letter queue
+func CarriesSecret(eventType string) bool {
+	switch eventType {
+	case SubjectUserEmailVerificationRequested, SubjectUserPasswordResetRequested:
+		return true
+	}
+	return false
+}
+
+func Subjects() []string {
+	return []string{
+		SubjectReservationCreated,
+		SubjectReservationConfirmed,
+		SubjectReservationCancelled,
+		SubjectReservationExpired,
+		SubjectSessionCreated,
+		SubjectSessionJoined,
+		SubjectSessionFull,
+		SubjectSessionCancelled,
+		SubjectSessionLeft,
+		SubjectPaymentCreated,
+		SubjectPaymentSucceeded,
+		SubjectPaymentFailed,
+		SubjectPaymentRefunded,
+		SubjectUserTokensRevoked,
+		SubjectUserEmailVerificationRequested,
+		SubjectUserEmailVerified,
+		SubjectUserPasswordResetRequested,
+		SubjectUserPasswordChanged,
+		SubjectUserLoginLocked,
+	}
+}
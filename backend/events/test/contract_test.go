@@ -0,0 +1,260 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diploma/events"
+)
+
+var update = flag.Bool("update", false, "rewrite golden payload files")
+
+var startTime = time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+
+// samples pins one payload per subject; the golden files under testdata are the wire contract
+var samples = map[string]interface{}{
+	events.SubjectReservationCreated: &events.ReservationCreated{
+		ReservationID: "9f0c7a52-7e0a-4f0e-9a57-0a3c1b0b6d11",
+		UserID:        "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		ResourceID:    "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+		StartTime:     startTime,
+		EndTime:       startTime.Add(time.Hour),
+		Status:        "PENDING",
+	},
+	events.SubjectReservationConfirmed: &events.ReservationConfirmed{
+		ReservationID: "9f0c7a52-7e0a-4f0e-9a57-0a3c1b0b6d11",
+		UserID:        "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		ResourceID:    "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+	},
+	events.SubjectReservationCancelled: &events.ReservationCancelled{
+		ReservationID: "9f0c7a52-7e0a-4f0e-9a57-0a3c1b0b6d11",
+		UserID:        "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		ResourceID:    "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+	},
+	events.SubjectReservationExpired: &events.ReservationExpired{
+		ReservationID: "9f0c7a52-7e0a-4f0e-9a57-0a3c1b0b6d11",
+		UserID:        "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		ResourceID:    "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+	},
+	events.SubjectSessionCreated: &events.SessionCreated{
+		SessionID:     "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		ReservationID: "9f0c7a52-7e0a-4f0e-9a57-0a3c1b0b6d11",
+		HostID:        "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		StartTime:     startTime,
+		EndTime:       startTime.Add(time.Hour),
+	},
+	events.SubjectSessionJoined: &events.SessionJoined{
+		SessionID:           "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		UserID:              "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+		CurrentParticipants: 3,
+	},
+	events.SubjectSessionFull: &events.SessionFull{
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+	},
+	events.SubjectSessionCancelled: &events.SessionCancelled{
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+	},
+	events.SubjectSessionLeft: &events.SessionLeft{
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		UserID:    "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+	},
+	events.SubjectPaymentCreated: &events.PaymentCreated{
+		PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b",
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		UserID:    "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+		Amount:    12.5,
+		Currency:  "USD",
+	},
+	events.SubjectPaymentSucceeded: &events.PaymentSucceeded{
+		PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b",
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		UserID:    "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+		Amount:    12.5,
+		Currency:  "USD",
+	},
+	events.SubjectPaymentFailed: &events.PaymentFailed{
+		PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b",
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		UserID:    "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+		Reason:    "Your card was declined.",
+	},
+	events.SubjectPaymentRefunded: &events.PaymentRefunded{
+		PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b",
+		SessionID: "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+		UserID:    "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+		RefundID:  "re_3NqG2a2eZvKYlo2C",
+	},
+	events.SubjectUserTokensRevoked: &events.UserTokensRevoked{
+		UserID:        "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		RevokedBefore: startTime,
+		Reason:        "logout_all",
+	},
+	events.SubjectUserEmailVerificationRequested: &events.UserEmailVerificationRequested{
+		UserID:      "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		Email:       "aigerim@sportsapp.test",
+		SealedToken: "Yx3mQ8pL1vN6tR0wK4cZ9sJ2hF7dB5aG-eU1iO8nM3qT6yW0rE4kP9lV2jH7gD5fS1bC8xA",
+		ExpiresAt:   startTime,
+	},
+	events.SubjectUserEmailVerified: &events.UserEmailVerified{
+		UserID:     "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		Email:      "aigerim@sportsapp.test",
+		VerifiedAt: startTime,
+	},
+	events.SubjectUserPasswordResetRequested: &events.UserPasswordResetRequested{
+		UserID:      "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		Email:       "aigerim@sportsapp.test",
+		SealedToken: "Hd6Tq1Wn8Zk3Xp0Mv5Rb9Yc2Lf7Js4Ga1Ue-Io8Ek6Nr3Qm0Tw5Bz9Kc2Vx7Py4Sd1Fh6gA",
+		ExpiresAt:   startTime,
+	},
+	events.SubjectUserPasswordChanged: &events.UserPasswordChanged{
+		UserID:    "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		Email:     "aigerim@sportsapp.test",
+		ChangedAt: startTime,
+	},
+	events.SubjectUserLoginLocked: &events.UserLoginLocked{
+		Scope:       "account",
+		UserID:      "4b7d1c2e-93a1-4d2c-8a2f-5e6b7c8d9e01",
+		Email:       "aigerim@sportsapp.test",
+		ClientIP:    "203.0.113.7",
+		Failures:    5,
+		Lockouts:    2,
+		LockedUntil: startTime,
+	},
+}
+
+func goldenPath(subject string) string {
+	version, _ := events.SchemaVersion(subject)
+	return filepath.Join("testdata", fmt.Sprintf("%s.v%d.json", subject, version))
+}
+
+func TestContract_EverySubjectIsVersionedAndPinned(t *testing.T) {
+	for _, subject := range events.Subjects() {
+		if _, ok := events.SchemaVersion(subject); !ok {
+			t.Errorf("%s has no schema version", subject)
+		}
+		if _, ok := samples[subject]; !ok {
+			t.Errorf("%s has no contract sample", subject)
+		}
+	}
+
+	if len(samples) != len(events.Subjects()) {
+		t.Errorf("Expected %d samples, got %d", len(events.Subjects()), len(samples))
+	}
+}
+
+func TestStreams_CoverEverySubject(t *testing.T) {
+	for _, subject := range events.Subjects() {
+		matches := 0
+		for _, stream := range events.Streams() {
+			for _, pattern := range stream.Subjects {
+				if strings.HasSuffix(pattern, ".>") && strings.HasPrefix(subject, strings.TrimSuffix(pattern, ">")) {
+					matches++
+				}
+			}
+		}
+		if matches != 1 {
+			t.Errorf("%s is captured by %d streams, want exactly 1", subject, matches)
+		}
+	}
+}
+
+func TestContract_PayloadsMatchGoldenFiles(t *testing.T) {
+	for subject, payload := range samples {
+		t.Run(subject, func(t *testing.T) {
+			got, err := json.MarshalIndent(payload, "", "  ")
+			if err != nil {
+				t.Fatalf("Failed to marshal payload: %v", err)
+			}
+			got = append(got, '\n')
+
+			if *update {
+				if err := os.WriteFile(goldenPath(subject), got, 0o644); err != nil {
+					t.Fatalf("Failed to write golden file: %v", err)
+				}
+			}
+
+			want, err := os.ReadFile(goldenPath(subject))
+			if err != nil {
+				t.Fatalf("Failed to read golden file: %v", err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("Payload drifted from %s\ngot:\n%s\nwant:\n%s", goldenPath(subject), got, want)
+			}
+
+			// Golden fields the struct no longer knows about are a breaking change for consumers
+			decoded := reflect.New(reflect.TypeOf(payload).Elem()).Interface()
+			decoder := json.NewDecoder(bytes.NewReader(want))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(decoded); err != nil {
+				t.Errorf("Golden payload no longer decodes: %v", err)
+			}
+		})
+	}
+}
+
+func TestEnvelope_RoundTrip(t *testing.T) {
+	codec := events.NewCodec("reservation-svc").WithTraceInjector(func(ctx context.Context, carrier events.TraceCarrier) {
+		carrier.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	})
+
+	payload := samples[events.SubjectReservationCreated].(*events.ReservationCreated)
+	data, err := codec.Encode(context.Background(), events.SubjectReservationCreated, payload)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	envelope, err := events.Decode(data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if envelope.ID == "" || envelope.Type != events.SubjectReservationCreated || envelope.Version != 1 {
+		t.Errorf("Unexpected envelope header: %+v", envelope)
+	}
+
+	if envelope.Producer != "reservation-svc" || envelope.OccurredAt.IsZero() {
+		t.Errorf("Expected producer and occurred_at to be set, got %+v", envelope)
+	}
+
+	if envelope.TraceContext.Get("traceparent") == "" {
+		t.Error("Expected trace context to be propagated")
+	}
+
+	var decoded events.ReservationCreated
+	if err := envelope.UnmarshalPayload(&decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(&decoded, payload) {
+		t.Errorf("Payload changed in transit: got %+v, want %+v", decoded, payload)
+	}
+}
+
+func TestEnvelope_RejectsUnknownTypesAndVersions(t *testing.T) {
+	codec := events.NewCodec("test")
+
+	if _, err := codec.Encode(context.Background(), "RESERVATION.CREATED", struct{}{}); !errors.Is(err, events.ErrUnknownEventType) {
+		t.Errorf("Expected ErrUnknownEventType, got %v", err)
+	}
+
+	future, _ := json.Marshal(events.Envelope{
+		ID:      "evt",
+		Type:    events.SubjectPaymentCreated,
+		Version: 99,
+		Payload: json.RawMessage(`{}`),
+	})
+	if _, err := events.Decode(future); !errors.Is(err, events.ErrUnsupportedVersion) {
+		t.Errorf("Expected ErrUnsupportedVersion, got %v", err)
+	}
+}
@@ -0,0 +1,239 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/diploma/events"
+	"github.com/diploma/events/outbox"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type MockOutboxRepository struct {
+	messages []*outbox.Message
+}
+
+func (m *MockOutboxRepository) Add(ctx context.Context, message *outbox.Message) error {
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+func (m *MockOutboxRepository) ClaimPending(ctx context.Context, now, claimedUntil time.Time, limit int) ([]*outbox.Message, error) {
+	var pending []*outbox.Message
+	for _, message := range m.messages {
+		if !message.IsSent() && !message.NextAttemptAt.After(now) && len(pending) < limit {
+			message.NextAttemptAt = claimedUntil
+			pending = append(pending, message)
+		}
+	}
+	return pending, nil
+}
+
+func (m *MockOutboxRepository) MarkSent(ctx context.Context, ids []uuid.UUID, sentAt time.Time) error {
+	for _, id := range ids {
+		m.find(id).SentAt = &sentAt
+	}
+	return nil
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error {
+	message := m.find(id)
+	message.Attempts++
+	message.LastError = &lastError
+	message.NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (m *MockOutboxRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockOutboxRepository) find(id uuid.UUID) *outbox.Message {
+	for _, message := range m.messages {
+		if message.ID == id {
+			return message
+		}
+	}
+	return nil
+}
+
+type MockMessagePublisher struct {
+	published  []*nats.Msg
+	publishErr error
+	// unacked subjects fail as if the stream never acknowledged them
+	unacked map[string]bool
+	// beforePublish runs ahead of every publish, while the relay is waiting on the stream
+	beforePublish func()
+}
+
+func (m *MockMessagePublisher) PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	if m.beforePublish != nil {
+		m.beforePublish()
+	}
+	if m.publishErr != nil {
+		return nil, m.publishErr
+	}
+	if m.unacked[msg.Subject] {
+		return nil, errors.New("nats: timeout")
+	}
+	m.published = append(m.published, msg)
+	return &jetstream.PubAck{Stream: events.StreamReservations, Sequence: uint64(len(m.published))}, nil
+}
+
+var _ outbox.Repository = (*MockOutboxRepository)(nil)
+var _ outbox.MessagePublisher = (*MockMessagePublisher)(nil)
+
+var testRelayOptions = outbox.RelayOptions{
+	PollInterval: time.Second,
+	BatchSize:    10,
+	MaxBackoff:   time.Minute,
+	ClaimTimeout: time.Minute,
+}
+
+func TestOutboxRelay_PublishesAndMarksSent(t *testing.T) {
+	repo := &MockOutboxRepository{}
+	repo.Add(context.Background(), outbox.NewMessage("evt-1", events.SubjectReservationCreated, []byte(`{}`)))
+	repo.Add(context.Background(), outbox.NewMessage("evt-2", events.SubjectReservationConfirmed, []byte(`{}`)))
+	publisher := &MockMessagePublisher{}
+
+	relay := outbox.NewRelay(repo, publisher, testRelayOptions)
+	fetched, err := relay.RelayBatch(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if fetched != 2 || len(publisher.published) != 2 {
+		t.Fatalf("Expected 2 messages relayed, got fetched=%d published=%d", fetched, len(publisher.published))
+	}
+
+	if publisher.published[0].Subject != events.SubjectReservationCreated || publisher.published[0].Header.Get(jetstream.MsgIDHeader) != "evt-1" {
+		t.Errorf("Unexpected first message: subject=%s id=%s", publisher.published[0].Subject, publisher.published[0].Header.Get(jetstream.MsgIDHeader))
+	}
+
+	for _, message := range repo.messages {
+		if !message.IsSent() {
+			t.Errorf("Expected message %s to be marked sent", message.EventID)
+		}
+	}
+
+	fetched, _ = relay.RelayBatch(context.Background())
+	if fetched != 0 {
+		t.Errorf("Expected sent messages not to be relayed again, got %d", fetched)
+	}
+}
+
+func TestOutboxRelay_MarksOnlyAcknowledgedMessagesSent(t *testing.T) {
+	repo := &MockOutboxRepository{}
+	repo.Add(context.Background(), outbox.NewMessage("evt-1", events.SubjectReservationCreated, []byte(`{}`)))
+	repo.Add(context.Background(), outbox.NewMessage("evt-2", events.SubjectReservationConfirmed, []byte(`{}`)))
+	publisher := &MockMessagePublisher{unacked: map[string]bool{events.SubjectReservationCreated: true}}
+
+	relay := outbox.NewRelay(repo, publisher, testRelayOptions)
+	if _, err := relay.RelayBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	unacked, acked := repo.messages[0], repo.messages[1]
+	if unacked.IsSent() || unacked.Attempts != 1 {
+		t.Errorf("Expected unacknowledged message to be kept for retry, got %+v", unacked)
+	}
+	if !acked.IsSent() {
+		t.Errorf("Expected acknowledged message to be marked sent")
+	}
+}
+
+func TestOutboxRelay_ClaimHoldsBatchOffOtherRelays(t *testing.T) {
+	repo := &MockOutboxRepository{}
+	repo.Add(context.Background(), outbox.NewMessage("evt-1", events.SubjectReservationCreated, []byte(`{}`)))
+	publisher := &MockMessagePublisher{}
+	other := outbox.NewRelay(repo, publisher, testRelayOptions)
+
+	otherFetched := -1
+	publisher.beforePublish = func() {
+		publisher.beforePublish = nil
+		otherFetched, _ = other.RelayBatch(context.Background())
+	}
+
+	relay := outbox.NewRelay(repo, publisher, testRelayOptions)
+	if fetched, err := relay.RelayBatch(context.Background()); err != nil || fetched != 1 {
+		t.Fatalf("Expected one message claimed, got fetched=%d err=%v", fetched, err)
+	}
+
+	if otherFetched != 0 {
+		t.Errorf("Expected the claimed message to be held off another relay while it was published, got %d", otherFetched)
+	}
+	if len(publisher.published) != 1 || !repo.messages[0].IsSent() {
+		t.Errorf("Expected the message published once and marked sent, got %d publishes", len(publisher.published))
+	}
+}
+
+func TestOutboxRelay_ReclaimsLapsedClaim(t *testing.T) {
+	repo := &MockOutboxRepository{}
+	message := outbox.NewMessage("evt-1", events.SubjectReservationCreated, []byte(`{}`))
+	repo.Add(context.Background(), message)
+
+	// A relay that died mid-batch left its claim behind
+	if _, err := repo.ClaimPending(context.Background(), time.Now(), time.Now().Add(-time.Second), 10); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	publisher := &MockMessagePublisher{}
+	relay := outbox.NewRelay(repo, publisher, testRelayOptions)
+	if fetched, _ := relay.RelayBatch(context.Background()); fetched != 1 || !message.IsSent() {
+		t.Errorf("Expected the lapsed claim to be taken over and sent, got fetched=%d", fetched)
+	}
+}
+
+func TestOutboxRelay_RetriesWithBackoff(t *testing.T) {
+	tests := []struct {
+		name      string
+		publisher *MockMessagePublisher
+	}{
+		{"no stream", &MockMessagePublisher{publishErr: jetstream.ErrNoStreamResponse}},
+		{"ack times out", &MockMessagePublisher{publishErr: context.DeadlineExceeded}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockOutboxRepository{}
+			repo.Add(context.Background(), outbox.NewMessage("evt-1", events.SubjectReservationCreated, []byte(`{}`)))
+
+			relay := outbox.NewRelay(repo, tt.publisher, testRelayOptions)
+			if _, err := relay.RelayBatch(context.Background()); err != nil {
+				t.Fatalf("Expected failures to be recorded rather than returned, got %v", err)
+			}
+
+			message := repo.messages[0]
+			if message.IsSent() || message.Attempts != 1 || message.LastError == nil {
+				t.Fatalf("Expected one failed attempt, got %+v", message)
+			}
+
+			if delay := time.Until(message.NextAttemptAt); delay <= 0 || delay > testRelayOptions.PollInterval {
+				t.Errorf("Expected first retry within one poll interval, got %v", delay)
+			}
+
+			if fetched, _ := relay.RelayBatch(context.Background()); fetched != 0 {
+				t.Errorf("Expected message to wait for its backoff, got %d fetched", fetched)
+			}
+		})
+	}
+}
+
+func TestOutboxRelay_BackoffIsCapped(t *testing.T) {
+	repo := &MockOutboxRepository{}
+	message := outbox.NewMessage("evt-1", events.SubjectReservationCreated, []byte(`{}`))
+	message.Attempts = 20
+	repo.Add(context.Background(), message)
+
+	relay := outbox.NewRelay(repo, &MockMessagePublisher{publishErr: fmt.Errorf("down")}, testRelayOptions)
+	relay.RelayBatch(context.Background())
+
+	if delay := time.Until(message.NextAttemptAt); delay > testRelayOptions.MaxBackoff {
+		t.Errorf("Expected backoff to be capped at %v, got %v", testRelayOptions.MaxBackoff, delay)
+	}
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/diploma/events"
+)
+
+func TestSealer_RoundTrip(t *testing.T) {
+	sealer, err := events.NewSealer("event-seal-secret")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sealed, err := sealer.Seal(events.SubjectUserPasswordResetRequested, "reset-token")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Contains(sealed, "reset-token") {
+		t.Fatalf("Expected the token not to appear in %s", sealed)
+	}
+
+	opened, err := sealer.Open(events.SubjectUserPasswordResetRequested, sealed)
+	if err != nil || opened != "reset-token" {
+		t.Errorf("Expected reset-token, got %q (%v)", opened, err)
+	}
+}
+
+func TestSealer_RejectsOtherSubjectsKeysAndTampering(t *testing.T) {
+	sealer, _ := events.NewSealer("event-seal-secret")
+	other, _ := events.NewSealer("another-secret")
+	sealed, _ := sealer.Seal(events.SubjectUserPasswordResetRequested, "reset-token")
+	tampered := []byte(sealed)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name    string
+		sealer  *events.Sealer
+		subject string
+		sealed  string
+	}{
+		{"other subject", sealer, events.SubjectUserEmailVerificationRequested, sealed},
+		{"other secret", other, events.SubjectUserPasswordResetRequested, sealed},
+		{"tampered", sealer, events.SubjectUserPasswordResetRequested, string(tampered)},
+		{"not base64", sealer, events.SubjectUserPasswordResetRequested, "%%%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.sealer.Open(tt.subject, tt.sealed); !errors.Is(err, events.ErrUnsealable) {
+				t.Errorf("Expected ErrUnsealable, got %v", err)
+			}
+		})
+	}
+
+	if _, err := events.NewSealer(""); err == nil {
+		t.Error("Expected an empty secret to be rejected")
+	}
+}
@@ -0,0 +1,222 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const DefaultProdID = "-//SportsApp//Calendar 1.0//EN"
+
+type Method string
+
+const (
+	MethodPublish Method = "PUBLISH" // Subscribed feeds
+	MethodRequest Method = "REQUEST" // Invites and updates to them
+	MethodCancel  Method = "CANCEL"
+)
+
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusTentative Status = "TENTATIVE"
+	StatusCancelled Status = "CANCELLED"
+)
+
+type Person struct {
+	Name  string
+	Email string
+}
+
+type Geo struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type Event struct {
+	UID string
+	// Sequence must grow with every revision sent under the same UID, otherwise clients ignore the update
+	Sequence     int
+	Status       Status
+	Summary      string
+	Description  string
+	Location     string
+	Geo          *Geo
+	URL          string
+	Start        time.Time
+	End          time.Time
+	LastModified time.Time
+	Organizer    *Person
+	Attendees    []Person
+}
+
+type Calendar struct {
+	ProdID          string
+	Method          Method
+	Name            string        // Shown by clients when subscribing to a feed
+	RefreshInterval time.Duration // How often subscribers should poll; 0 leaves it to the client
+	Stamp           time.Time     // DTSTAMP of every event; defaults to now
+	Events          []Event
+}
+
+// Marshal renders the calendar as RFC 5545 text: CRLF line endings, escaped values and
+// content lines folded at 75 octets without splitting UTF-8 sequences
+func (c *Calendar) Marshal() []byte {
+	stamp := c.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	prodID := c.ProdID
+	if prodID == "" {
+		prodID = DefaultProdID
+	}
+
+	var buf bytes.Buffer
+	w := &writer{buf: &buf}
+	w.line("BEGIN", nil, "VCALENDAR")
+	w.line("VERSION", nil, "2.0")
+	w.line("PRODID", nil, escapeText(prodID))
+	w.line("CALSCALE", nil, "GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD", nil, string(c.Method))
+	}
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", nil, escapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		w.line("REFRESH-INTERVAL", []string{"VALUE=DURATION"}, formatDuration(c.RefreshInterval))
+		w.line("X-PUBLISHED-TTL", nil, formatDuration(c.RefreshInterval))
+	}
+	for i := range c.Events {
+		w.event(&c.Events[i], stamp)
+	}
+	w.line("END", nil, "VCALENDAR")
+
+	return buf.Bytes()
+}
+
+type writer struct {
+	buf *bytes.Buffer
+}
+
+func (w *writer) event(e *Event, stamp time.Time) {
+	w.line("BEGIN", nil, "VEVENT")
+	w.line("UID", nil, escapeText(e.UID))
+	w.line("DTSTAMP", nil, formatTime(stamp))
+	w.line("SEQUENCE", nil, strconv.Itoa(e.Sequence))
+	w.line("DTSTART", nil, formatTime(e.Start))
+	if !e.End.IsZero() {
+		w.line("DTEND", nil, formatTime(e.End))
+	}
+	if !e.LastModified.IsZero() {
+		w.line("LAST-MODIFIED", nil, formatTime(e.LastModified))
+	}
+	if e.Status != "" {
+		w.line("STATUS", nil, string(e.Status))
+	}
+	w.line("SUMMARY", nil, escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION", nil, escapeText(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION", nil, escapeText(e.Location))
+	}
+	if e.Geo != nil {
+		w.line("GEO", nil, fmt.Sprintf("%.6f;%.6f", e.Geo.Latitude, e.Geo.Longitude))
+	}
+	if e.URL != "" {
+		w.line("URL", []string{"VALUE=URI"}, e.URL)
+	}
+	if e.Organizer != nil {
+		w.line("ORGANIZER", personParams(e.Organizer), "mailto:"+e.Organizer.Email)
+	}
+	for i := range e.Attendees {
+		params := append(personParams(&e.Attendees[i]), "ROLE=REQ-PARTICIPANT", "PARTSTAT=ACCEPTED", "RSVP=FALSE")
+		w.line("ATTENDEE", params, "mailto:"+e.Attendees[i].Email)
+	}
+	w.line("TRANSP", nil, transparency(e.Status))
+	w.line("END", nil, "VEVENT")
+}
+
+// line writes one content line, folding it with CRLF + space so no physical line exceeds 75 octets
+func (w *writer) line(name string, params []string, value string) {
+	content := name
+	for _, param := range params {
+		content += ";" + param
+	}
+	content += ":" + value
+
+	const maxLine = 75
+	limit := maxLine
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		limit = maxLine - 1
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+func personParams(p *Person) []string {
+	if p.Name == "" {
+		return nil
+	}
+	return []string{"CN=" + paramValue(p.Name)}
+}
+
+// Cancelled events no longer block time in the attendee's free/busy
+func transparency(status Status) string {
+	if status == StatusCancelled {
+		return "TRANSPARENT"
+	}
+	return "OPAQUE"
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "")
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// paramValue quotes values containing delimiters; DQUOTE and control characters cannot be represented at all
+func paramValue(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r == '"' || r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+	if strings.ContainsAny(value, ":;,") {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		d = time.Minute
+	}
+	hours := int(d / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case minutes == 0:
+		return fmt.Sprintf("PT%dH", hours)
+	case hours == 0:
+		return fmt.Sprintf("PT%dM", minutes)
+	default:
+		return fmt.Sprintf("PT%dH%dM", hours, minutes)
+	}
+}
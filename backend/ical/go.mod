@@ -0,0 +1,3 @@
+module github.com/diploma/ical
+
+go 1.22
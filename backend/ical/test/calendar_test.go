@@ -0,0 +1,154 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/diploma/ical"
+)
+
+var (
+	stamp     = time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	startTime = time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+)
+
+func sampleEvent() ical.Event {
+	return ical.Event{
+		UID:         ical.ReservationUID("9f0c7a52"),
+		Sequence:    3,
+		Status:      ical.StatusConfirmed,
+		Summary:     "Court 1, Central Arena",
+		Description: "Booking 9f0c7a52\nBring your own racket",
+		Location:    "Central Arena, 1 Main St; Springfield",
+		Geo:         &ical.Geo{Latitude: 51.5074, Longitude: -0.1278},
+		Start:       startTime,
+		End:         startTime.Add(90 * time.Minute),
+		Organizer:   &ical.Person{Name: "SportsApp", Email: "notifications@sportsapp.com"},
+		Attendees:   []ical.Person{{Name: "Doe, Jane", Email: "jane@example.com"}},
+	}
+}
+
+func unfold(data string) string {
+	return strings.ReplaceAll(data, "\r\n ", "")
+}
+
+func TestMarshalRendersEvent(t *testing.T) {
+	cal := &ical.Calendar{Method: ical.MethodRequest, Stamp: stamp, Events: []ical.Event{sampleEvent()}}
+	out := unfold(string(cal.Marshal()))
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"METHOD:REQUEST\r\n",
+		"UID:reservation-9f0c7a52@sportsapp\r\n",
+		"DTSTAMP:20260301T093000Z\r\n",
+		"SEQUENCE:3\r\n",
+		"DTSTART:20260314T180000Z\r\n",
+		"DTEND:20260314T193000Z\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"SUMMARY:Court 1\\, Central Arena\r\n",
+		"DESCRIPTION:Booking 9f0c7a52\\nBring your own racket\r\n",
+		"LOCATION:Central Arena\\, 1 Main St\\; Springfield\r\n",
+		"GEO:51.507400;-0.127800\r\n",
+		"ORGANIZER;CN=SportsApp:mailto:notifications@sportsapp.com\r\n",
+		`ATTENDEE;CN="Doe, Jane";ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED;RSVP=FALSE:mailto:jane@example.com` + "\r\n",
+		"TRANSP:OPAQUE\r\n",
+		"END:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestMarshalConvertsTimesToUTC(t *testing.T) {
+	event := sampleEvent()
+	event.Start = time.Date(2026, 3, 14, 20, 0, 0, 0, time.FixedZone("EET", 2*60*60))
+	event.End = time.Time{}
+
+	out := string((&ical.Calendar{Stamp: stamp, Events: []ical.Event{event}}).Marshal())
+	if !strings.Contains(out, "DTSTART:20260314T180000Z\r\n") {
+		t.Errorf("Expected DTSTART in UTC, got:\n%s", out)
+	}
+	if strings.Contains(out, "DTEND") {
+		t.Errorf("Expected no DTEND for an event without an end, got:\n%s", out)
+	}
+}
+
+func TestMarshalFoldsLongLines(t *testing.T) {
+	event := sampleEvent()
+	event.Description = strings.Repeat("Привет, мир! ", 20)
+
+	out := string((&ical.Calendar{Stamp: stamp, Events: []ical.Event{event}}).Marshal())
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("Expected lines of at most 75 octets, got %d: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("Expected folding to keep UTF-8 sequences whole, got %q", line)
+		}
+	}
+
+	want := "DESCRIPTION:" + strings.ReplaceAll(event.Description, ",", `\,`)
+	if !strings.Contains(unfold(out), want+"\r\n") {
+		t.Errorf("Expected the unfolded description to round-trip, got:\n%s", unfold(out))
+	}
+}
+
+func TestMarshalCancelledEventIsTransparent(t *testing.T) {
+	event := sampleEvent()
+	event.Status = ical.StatusCancelled
+
+	out := string((&ical.Calendar{Method: ical.MethodCancel, Stamp: stamp, Events: []ical.Event{event}}).Marshal())
+	for _, want := range []string{"METHOD:CANCEL\r\n", "STATUS:CANCELLED\r\n", "TRANSP:TRANSPARENT\r\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestMarshalFeedHeaders(t *testing.T) {
+	cal := &ical.Calendar{
+		Method:          ical.MethodPublish,
+		Name:            "SportsApp",
+		RefreshInterval: 90 * time.Minute,
+		Stamp:           stamp,
+	}
+
+	out := string(cal.Marshal())
+	for _, want := range []string{
+		"METHOD:PUBLISH\r\n",
+		"X-WR-CALNAME:SportsApp\r\n",
+		"REFRESH-INTERVAL;VALUE=DURATION:PT1H30M\r\n",
+		"X-PUBLISHED-TTL:PT1H30M\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "BEGIN:VEVENT") {
+		t.Errorf("Expected an empty calendar, got:\n%s", out)
+	}
+}
+
+func TestSequenceAtIncreases(t *testing.T) {
+	if got := ical.SequenceAt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)); got != 0 {
+		t.Errorf("Expected 0 before the epoch, got %d", got)
+	}
+
+	earlier := ical.SequenceAt(startTime)
+	later := ical.SequenceAt(startTime.Add(time.Second))
+	if later <= earlier {
+		t.Errorf("Expected a later change to have a higher sequence, got %d after %d", later, earlier)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	if got := ical.ContentType(ical.MethodRequest); got != "text/calendar; charset=UTF-8; method=REQUEST" {
+		t.Errorf("Unexpected content type %q", got)
+	}
+	if got := ical.SessionUID("42"); got != "session-42@sportsapp" {
+		t.Errorf("Unexpected session UID %q", got)
+	}
+}
@@ -0,0 +1,38 @@
+package ical
+
+import (
+	"mime"
+	"time"
+)
+
+const uidDomain = "sportsapp"
+
+// ReservationUID and SessionUID are shared by email invites and the calendar feed, so a
+// client that received an invite updates that same event when it later syncs the feed
+func ReservationUID(reservationID string) string {
+	return "reservation-" + reservationID + "@" + uidDomain
+}
+
+func SessionUID(sessionID string) string {
+	return "session-" + sessionID + "@" + uidDomain
+}
+
+var sequenceEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// SequenceAt derives SEQUENCE from the time of a change. Producers that do not track
+// revisions still emit increasing numbers, e.g. a re-join after leaving outranks the cancel.
+func SequenceAt(t time.Time) int {
+	if t.Before(sequenceEpoch) {
+		return 0
+	}
+	return int(t.Sub(sequenceEpoch) / time.Second)
+}
+
+// ContentType is the media type for a calendar, carrying the method as mail clients expect
+func ContentType(method Method) string {
+	params := map[string]string{"charset": "UTF-8"}
+	if method != "" {
+		params["method"] = string(method)
+	}
+	return mime.FormatMediaType("text/calendar", params)
+}
@@ -2,16 +2,50 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+	_ "time/tzdata"
 
+	"github.com/diploma/authz"
+	sharedevents "github.com/diploma/events"
+	notificationv1 "github.com/diploma/notification-svc/api/v1"
+	grpchandler "github.com/diploma/notification-svc/internal/adapters/inbound/grpc/handler"
 	"github.com/diploma/notification-svc/internal/adapters/inbound/nats"
+	"github.com/diploma/notification-svc/internal/adapters/inbound/scheduler"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/auth"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/calendar"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/database/repository"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/dlq"
 	"github.com/diploma/notification-svc/internal/adapters/outbound/email"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/fake"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/inapp"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/push"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/reservation"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/session"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/sms"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/templates"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/venue"
+	deadletterusecase "github.com/diploma/notification-svc/internal/application/deadletter/usecase"
+	deliveryusecase "github.com/diploma/notification-svc/internal/application/delivery/usecase"
 	"github.com/diploma/notification-svc/internal/application/event/handler"
+	inboxusecase "github.com/diploma/notification-svc/internal/application/inbox/usecase"
+	preferenceusecase "github.com/diploma/notification-svc/internal/application/preference/usecase"
+	reminderusecase "github.com/diploma/notification-svc/internal/application/reminder/usecase"
 	"github.com/diploma/notification-svc/internal/config"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
 	natsclient "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -28,6 +62,29 @@ func run() error {
 		return err
 	}
 
+	db, err := gorm.Open(postgres.Open(cfg.DBConfig.ConnectionString()), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	if err := sqlDB.Ping(); err != nil {
+		return err
+	}
+	log.Println("Connected to database")
+
+	txManager := repository.NewTransactionManager(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	preferenceRepo := repository.NewPreferenceRepository(db)
+	deferredRepo := repository.NewDeferredDeliveryRepository(db)
+	reminderRepo := repository.NewReminderRepository(db)
+	deliveryLogRepo := repository.NewDeliveryLogRepository(db)
+
 	nc, err := natsclient.Connect(cfg.NATSConfig.URL)
 	if err != nil {
 		return err
@@ -35,30 +92,207 @@ func run() error {
 	defer nc.Close()
 	log.Printf("Connected to NATS at %s", cfg.NATSConfig.URL)
 
-	emailSender := email.NewSMTPSender(
-		cfg.SMTPConfig.Host,
-		cfg.SMTPConfig.Port,
-		cfg.SMTPConfig.Username,
-		cfg.SMTPConfig.Password,
-		cfg.SMTPConfig.From,
-	)
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	deadLetterQueue, err := dlq.NewJetStreamQueue(ctx, js, cfg.JetStreamConfig.DLQMaxAge)
+	if err != nil {
+		return err
+	}
+
+	emailSender, err := email.NewSMTPSender(email.SMTPOptions{
+		Host:          cfg.SMTPConfig.Host,
+		Port:          cfg.SMTPConfig.Port,
+		Username:      cfg.SMTPConfig.Username,
+		Password:      cfg.SMTPConfig.Password,
+		From:          cfg.SMTPConfig.From,
+		TLSMode:       email.TLSMode(cfg.SMTPConfig.TLSMode),
+		Timeout:       cfg.SMTPConfig.Timeout,
+		PoolSize:      cfg.SMTPConfig.PoolSize,
+		IdleTimeout:   cfg.SMTPConfig.IdleTimeout,
+		RatePerSecond: cfg.SMTPConfig.RatePerSecond,
+		RateBurst:     cfg.SMTPConfig.RateBurst,
+	})
+	if err != nil {
+		return err
+	}
+	defer emailSender.Close()
 	log.Println("Email sender initialized")
 
-	reservationEventHandler := handler.NewReservationEventHandler(emailSender)
-	sessionEventHandler := handler.NewSessionEventHandler(emailSender)
-	paymentEventHandler := handler.NewPaymentEventHandler(emailSender)
+	senders := []port.ChannelSender{emailSender, inapp.NewInAppSender(notificationRepo)}
+	if pushSender := newPushSender(cfg.PushConfig); pushSender != nil {
+		senders = append(senders, pushSender)
+	}
+	if smsSender := newSMSSender(cfg.SMSConfig); smsSender != nil {
+		senders = append(senders, smsSender)
+	}
+
+	routes, err := service.ParseRoutes(service.DefaultRoutes(), cfg.Routes)
+	if err != nil {
+		return err
+	}
+
+	authClient, err := auth.NewAuthClient(cfg.AuthServiceURL)
+	if err != nil {
+		return err
+	}
+	defer authClient.Close()
+
+	sessionClient, err := session.NewSessionClient(cfg.SessionServiceURL)
+	if err != nil {
+		return err
+	}
+	defer sessionClient.Close()
+
+	reservationClient, err := reservation.NewReservationClient(cfg.ReservationServiceURL, cfg.JWT.ServiceKey)
+	if err != nil {
+		return err
+	}
+	defer reservationClient.Close()
+
+	venueClient, err := venue.NewVenueClient(cfg.VenueServiceURL)
+	if err != nil {
+		return err
+	}
+	defer venueClient.Close()
+
+	location, err := time.LoadLocation(cfg.TemplateConfig.Timezone)
+	if err != nil {
+		return err
+	}
+
+	renderer, err := templates.NewRenderer(cfg.TemplateConfig.Dir, cfg.TemplateConfig.DefaultLocale, location)
+	if err != nil {
+		return err
+	}
+	log.Printf("Loaded notification templates for locales %v", renderer.Locales())
+
+	organizer := emailSender.From()
+	inviteRenderer := service.NewCalendarInviteRenderer(renderer, calendar.NewICalEncoder(organizer.Name, organizer.Address))
+
+	recipientService := service.NewRecipientService(
+		auth.NewCachedUserDirectory(authClient, cfg.RecipientConfig.CacheTTL, cfg.RecipientConfig.CacheSize),
+		sessionClient,
+	)
+	preferenceService := service.NewPreferenceService(preferenceRepo, cfg.TemplateConfig.Timezone)
+	notificationService := service.NewNotificationService(
+		senders,
+		recipientService,
+		inviteRenderer,
+		service.NewChannelRouter(routes),
+		preferenceService,
+		deferredRepo,
+		deliveryLogRepo,
+		cfg.TemplateConfig.Currency,
+	)
+	eventDetailsService := service.NewEventDetailsService(sessionClient, reservationClient, venueClient)
+	reminderService := service.NewReminderService(
+		reminderRepo,
+		notificationService,
+		eventDetailsService,
+		cfg.ReminderConfig.ParticipantLeads,
+		cfg.ReminderConfig.HostWarningLead,
+	)
+
+	reservationEventHandler := handler.NewReservationEventHandler(notificationService, eventDetailsService)
+	sessionEventHandler := handler.NewSessionEventHandler(notificationService, eventDetailsService, reminderService)
+	paymentEventHandler := handler.NewPaymentEventHandler(notificationService, eventDetailsService)
+	tokenSealer, err := sharedevents.NewSealer(cfg.TemplateConfig.TokenSealKey)
+	if err != nil {
+		return err
+	}
+	userEventHandler := handler.NewUserEventHandler(notificationService, tokenSealer, cfg.TemplateConfig.AppURL)
 
 	eventSubscriber := nats.NewEventSubscriber(
-		nc,
+		js,
+		nats.SubscriberOptions{
+			Durable:      cfg.JetStreamConfig.ConsumerName,
+			StreamMaxAge: cfg.JetStreamConfig.StreamMaxAge,
+			Retry: nats.RetryPolicy{
+				MaxDeliver: cfg.JetStreamConfig.MaxDeliver,
+				AckWait:    cfg.JetStreamConfig.AckWait,
+				BaseDelay:  cfg.JetStreamConfig.RetryBaseDelay,
+				MaxDelay:   cfg.JetStreamConfig.RetryMaxDelay,
+			},
+		},
+		deadLetterQueue,
 		reservationEventHandler,
 		sessionEventHandler,
 		paymentEventHandler,
+		userEventHandler,
 	)
 
-	ctx := context.Background()
 	if err := eventSubscriber.SubscribeAll(ctx); err != nil {
 		return err
 	}
+	defer eventSubscriber.Stop()
+
+	adminHandler := grpchandler.NewAdminGRPCHandler(
+		deadletterusecase.NewListDeadLettersUseCase(deadLetterQueue),
+		deadletterusecase.NewReplayDeadLetterUseCase(deadLetterQueue, deadLetterQueue),
+		deadletterusecase.NewDeleteDeadLetterUseCase(deadLetterQueue),
+		deliveryusecase.NewListDeliveriesUseCase(deliveryLogRepo),
+	)
+
+	notificationHandler := grpchandler.NewNotificationGRPCHandler(
+		inboxusecase.NewListNotificationsUseCase(notificationRepo),
+		inboxusecase.NewMarkReadUseCase(notificationRepo),
+		preferenceusecase.NewGetPreferencesUseCase(preferenceService),
+		preferenceusecase.NewUpdatePreferencesUseCase(preferenceService),
+	)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authz.UnaryServerInterceptor(
+		authz.NewJWKSVerifier(authz.NewHTTPKeySource(cfg.JWT.JWKSURL), cfg.JWT.Issuer, cfg.JWT.KeyCacheTTL),
+		grpchandler.AccessPolicy,
+	)))
+	notificationv1.RegisterNotificationServiceServer(grpcServer, notificationHandler)
+	notificationv1.RegisterNotificationAdminServiceServer(grpcServer, adminHandler)
+	reflection.Register(grpcServer)
+
+	addr := fmt.Sprintf(":%s", cfg.GRPCPort)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Printf("gRPC server stopped: %v", err)
+		}
+	}()
+	log.Printf("gRPC server listening on %s", addr)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	deferredFlusher := scheduler.NewDeferredFlusher(
+		deliveryusecase.NewFlushDeferredUseCase(
+			notificationService,
+			deferredRepo,
+			txManager,
+			cfg.DeferredConfig.RetryDelay,
+			cfg.DeferredConfig.MaxAttempts,
+		),
+		cfg.DeferredConfig.FlushInterval,
+		cfg.DeferredConfig.BatchSize,
+	)
+	go deferredFlusher.Run(workerCtx)
+
+	reminderScheduler := scheduler.NewReminderScheduler(
+		reminderusecase.NewSendDueRemindersUseCase(
+			reminderService,
+			reminderRepo,
+			cfg.ReminderConfig.ClaimTimeout,
+			cfg.ReminderConfig.RetryDelay,
+			cfg.ReminderConfig.MaxAttempts,
+		),
+		cfg.ReminderConfig.PollInterval,
+		cfg.ReminderConfig.BatchSize,
+	)
+	go reminderScheduler.Run(workerCtx)
 
 	log.Println("notification-svc is running and listening for events...")
 
@@ -67,6 +301,32 @@ func run() error {
 	<-sigChan
 
 	log.Println("Shutting down notification-svc...")
+	stopWorkers()
+	grpcServer.GracefulStop()
+	return nil
+}
+
+func newPushSender(cfg config.PushConfig) port.ChannelSender {
+	switch cfg.Provider {
+	case "http":
+		log.Printf("Push sender initialized (%s)", cfg.Endpoint)
+		return push.NewHTTPPushSender(cfg.Endpoint, cfg.APIKey, cfg.TopicPrefix, cfg.Timeout)
+	case "fake":
+		log.Println("Push sender initialized (fake)")
+		return fake.NewFakeSender(entity.ChannelPush)
+	}
+	return nil
+}
+
+func newSMSSender(cfg config.SMSConfig) port.ChannelSender {
+	switch cfg.Provider {
+	case "http":
+		log.Printf("SMS sender initialized (%s)", cfg.GatewayURL)
+		return sms.NewHTTPSMSGateway(cfg.GatewayURL, cfg.APIKey, cfg.From, cfg.Timeout)
+	case "fake":
+		log.Println("SMS sender initialized (fake)")
+		return fake.NewFakeSender(entity.ChannelSMS)
+	}
 	return nil
 }
 
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/diploma/authz"
+	notificationv1 "github.com/diploma/notification-svc/api/v1"
+)
+
+// AccessPolicy gates each RPC by permission; handlers taking a user_id must also check it against
+// the caller with authz.AuthorizeUser. The admin service exposes other users' messages, so it is
+// restricted to admins.
+var AccessPolicy = authz.Policy{
+	notificationv1.NotificationService_ListNotifications_FullMethodName: authz.Authenticated(),
+	notificationv1.NotificationService_MarkRead_FullMethodName:          authz.Authenticated(),
+	notificationv1.NotificationService_GetPreferences_FullMethodName:    authz.Authenticated(),
+	notificationv1.NotificationService_UpdatePreferences_FullMethodName: authz.Authenticated(),
+
+	notificationv1.NotificationAdminService_ListDeadLetters_FullMethodName:  authz.Require(authz.PermManageNotifications),
+	notificationv1.NotificationAdminService_ReplayDeadLetter_FullMethodName: authz.Require(authz.PermManageNotifications),
+	notificationv1.NotificationAdminService_DeleteDeadLetter_FullMethodName: authz.Require(authz.PermManageNotifications),
+	notificationv1.NotificationAdminService_ListDeliveries_FullMethodName:   authz.Require(authz.PermManageNotifications),
+}
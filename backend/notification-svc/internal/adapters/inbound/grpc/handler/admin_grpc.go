@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	notificationv1 "github.com/diploma/notification-svc/api/v1"
+	"github.com/diploma/notification-svc/internal/application/deadletter/dto"
+	"github.com/diploma/notification-svc/internal/application/deadletter/usecase"
+	deliverydto "github.com/diploma/notification-svc/internal/application/delivery/dto"
+	deliveryusecase "github.com/diploma/notification-svc/internal/application/delivery/usecase"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminGRPCHandler struct {
+	notificationv1.UnimplementedNotificationAdminServiceServer
+	listDeadLettersUseCase  *usecase.ListDeadLettersUseCase
+	replayDeadLetterUseCase *usecase.ReplayDeadLetterUseCase
+	deleteDeadLetterUseCase *usecase.DeleteDeadLetterUseCase
+	listDeliveriesUseCase   *deliveryusecase.ListDeliveriesUseCase
+}
+
+func NewAdminGRPCHandler(
+	listDeadLettersUseCase *usecase.ListDeadLettersUseCase,
+	replayDeadLetterUseCase *usecase.ReplayDeadLetterUseCase,
+	deleteDeadLetterUseCase *usecase.DeleteDeadLetterUseCase,
+	listDeliveriesUseCase *deliveryusecase.ListDeliveriesUseCase,
+) *AdminGRPCHandler {
+	return &AdminGRPCHandler{
+		listDeadLettersUseCase:  listDeadLettersUseCase,
+		replayDeadLetterUseCase: replayDeadLetterUseCase,
+		deleteDeadLetterUseCase: deleteDeadLetterUseCase,
+		listDeliveriesUseCase:   listDeliveriesUseCase,
+	}
+}
+
+func (h *AdminGRPCHandler) ListDeadLetters(ctx context.Context, req *notificationv1.ListDeadLettersRequest) (*notificationv1.ListDeadLettersResponse, error) {
+	output, err := h.listDeadLettersUseCase.Execute(ctx, dto.ListDeadLettersInput{
+		Subject:       req.Subject,
+		AfterSequence: req.AfterSequence,
+		Limit:         int(req.Limit),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	letters := make([]*notificationv1.DeadLetter, 0, len(output.DeadLetters))
+	for _, letter := range output.DeadLetters {
+		letters = append(letters, &notificationv1.DeadLetter{
+			Sequence:       letter.Sequence,
+			Subject:        letter.Subject,
+			Payload:        letter.Payload,
+			Error:          letter.Error,
+			Deliveries:     int32(letter.Deliveries),
+			Stream:         letter.Stream,
+			StreamSequence: letter.StreamSequence,
+			FailedAt:       letter.FailedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &notificationv1.ListDeadLettersResponse{
+		DeadLetters:  letters,
+		NextSequence: output.NextSequence,
+	}, nil
+}
+
+func (h *AdminGRPCHandler) ReplayDeadLetter(ctx context.Context, req *notificationv1.ReplayDeadLetterRequest) (*notificationv1.ReplayDeadLetterResponse, error) {
+	output, err := h.replayDeadLetterUseCase.Execute(ctx, dto.ReplayDeadLetterInput{
+		Sequence: req.Sequence,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &notificationv1.ReplayDeadLetterResponse{
+		Success: output.Success,
+	}, nil
+}
+
+func (h *AdminGRPCHandler) DeleteDeadLetter(ctx context.Context, req *notificationv1.DeleteDeadLetterRequest) (*notificationv1.DeleteDeadLetterResponse, error) {
+	output, err := h.deleteDeadLetterUseCase.Execute(ctx, dto.DeleteDeadLetterInput{
+		Sequence: req.Sequence,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &notificationv1.DeleteDeadLetterResponse{
+		Success: output.Success,
+	}, nil
+}
+
+func (h *AdminGRPCHandler) ListDeliveries(ctx context.Context, req *notificationv1.ListDeliveriesRequest) (*notificationv1.ListDeliveriesResponse, error) {
+	output, err := h.listDeliveriesUseCase.Execute(ctx, deliverydto.ListDeliveriesInput{
+		EventID: req.EventId,
+		UserID:  req.UserId,
+		Channel: req.Channel,
+		Status:  req.Status,
+		Limit:   int(req.Limit),
+		Offset:  int(req.Offset),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	deliveries := make([]*notificationv1.Delivery, 0, len(output.Deliveries))
+	for _, delivery := range output.Deliveries {
+		pb := &notificationv1.Delivery{
+			Id:                delivery.ID,
+			EventId:           delivery.EventID,
+			UserId:            delivery.UserID,
+			Channel:           delivery.Channel,
+			Type:              delivery.Type,
+			Status:            delivery.Status,
+			Attempts:          int32(delivery.Attempts),
+			ProviderMessageId: delivery.ProviderMessageID,
+			LastError:         delivery.LastError,
+			CreatedAt:         delivery.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:         delivery.UpdatedAt.Format(time.RFC3339),
+		}
+		if delivery.SentAt != nil {
+			pb.SentAt = delivery.SentAt.Format(time.RFC3339)
+		}
+		deliveries = append(deliveries, pb)
+	}
+
+	return &notificationv1.ListDeliveriesResponse{
+		Deliveries: deliveries,
+		Total:      output.Total,
+	}, nil
+}
+
+func mapErrorToGRPCStatus(err error) error {
+	switch pkgerrors.GetErrorCode(err) {
+	case pkgerrors.CodeNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case pkgerrors.CodeInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case pkgerrors.CodeExternalAPI:
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/authz"
+	notificationv1 "github.com/diploma/notification-svc/api/v1"
+	"github.com/diploma/notification-svc/internal/application/inbox/dto"
+	"github.com/diploma/notification-svc/internal/application/inbox/usecase"
+	preferencedto "github.com/diploma/notification-svc/internal/application/preference/dto"
+	preferenceusecase "github.com/diploma/notification-svc/internal/application/preference/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationGRPCHandler struct {
+	notificationv1.UnimplementedNotificationServiceServer
+	listNotificationsUseCase *usecase.ListNotificationsUseCase
+	markReadUseCase          *usecase.MarkReadUseCase
+	getPreferencesUseCase    *preferenceusecase.GetPreferencesUseCase
+	updatePreferencesUseCase *preferenceusecase.UpdatePreferencesUseCase
+}
+
+func NewNotificationGRPCHandler(
+	listNotificationsUseCase *usecase.ListNotificationsUseCase,
+	markReadUseCase *usecase.MarkReadUseCase,
+	getPreferencesUseCase *preferenceusecase.GetPreferencesUseCase,
+	updatePreferencesUseCase *preferenceusecase.UpdatePreferencesUseCase,
+) *NotificationGRPCHandler {
+	return &NotificationGRPCHandler{
+		listNotificationsUseCase: listNotificationsUseCase,
+		markReadUseCase:          markReadUseCase,
+		getPreferencesUseCase:    getPreferencesUseCase,
+		updatePreferencesUseCase: updatePreferencesUseCase,
+	}
+}
+
+func (h *NotificationGRPCHandler) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.listNotificationsUseCase.Execute(ctx, dto.ListNotificationsInput{
+		UserID:     req.UserId,
+		UnreadOnly: req.UnreadOnly,
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	notifications := make([]*notificationv1.Notification, 0, len(output.Notifications))
+	for _, notification := range output.Notifications {
+		notifications = append(notifications, toProtoNotification(notification))
+	}
+
+	return &notificationv1.ListNotificationsResponse{
+		Notifications: notifications,
+		Total:         output.Total,
+		UnreadCount:   output.UnreadCount,
+	}, nil
+}
+
+func (h *NotificationGRPCHandler) MarkRead(ctx context.Context, req *notificationv1.MarkReadRequest) (*notificationv1.MarkReadResponse, error) {
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.markReadUseCase.Execute(ctx, dto.MarkReadInput{
+		UserID:          req.UserId,
+		NotificationIDs: req.NotificationIds,
+		All:             req.All,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &notificationv1.MarkReadResponse{
+		Updated:     output.Updated,
+		UnreadCount: output.UnreadCount,
+	}, nil
+}
+
+func (h *NotificationGRPCHandler) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest) (*notificationv1.GetPreferencesResponse, error) {
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.getPreferencesUseCase.Execute(ctx, preferencedto.GetPreferencesInput{
+		UserID: req.UserId,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &notificationv1.GetPreferencesResponse{
+		Preferences: toProtoPreferences(output),
+	}, nil
+}
+
+func (h *NotificationGRPCHandler) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest) (*notificationv1.UpdatePreferencesResponse, error) {
+	if req.Preferences == nil {
+		return nil, status.Error(codes.InvalidArgument, "preferences are required")
+	}
+
+	if err := authz.AuthorizeUser(ctx, req.Preferences.UserId); err != nil {
+		return nil, err
+	}
+
+	input := preferencedto.UpdatePreferencesInput{
+		UserID:          req.Preferences.UserId,
+		Timezone:        req.Preferences.Timezone,
+		QuietHoursStart: req.Preferences.QuietHoursStart,
+		QuietHoursEnd:   req.Preferences.QuietHoursEnd,
+		DigestHour:      int(req.Preferences.DigestHour),
+	}
+	for _, rule := range req.Preferences.Channels {
+		input.Channels = append(input.Channels, preferencedto.ChannelPreference{
+			Category: rule.Category,
+			Channel:  rule.Channel,
+			Enabled:  rule.Enabled,
+			Delivery: rule.Delivery,
+		})
+	}
+
+	output, err := h.updatePreferencesUseCase.Execute(ctx, input)
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &notificationv1.UpdatePreferencesResponse{
+		Preferences: toProtoPreferences(output),
+	}, nil
+}
+
+func toProtoNotification(notification dto.NotificationOutput) *notificationv1.Notification {
+	pb := &notificationv1.Notification{
+		Id:        notification.ID,
+		UserId:    notification.UserID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Read:      notification.ReadAt != nil,
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+	if notification.ReadAt != nil {
+		pb.ReadAt = notification.ReadAt.Format(time.RFC3339)
+	}
+	return pb
+}
+
+func toProtoPreferences(preferences *preferencedto.PreferencesOutput) *notificationv1.Preferences {
+	pb := &notificationv1.Preferences{
+		UserId:          preferences.UserID,
+		Timezone:        preferences.Timezone,
+		QuietHoursStart: preferences.QuietHoursStart,
+		QuietHoursEnd:   preferences.QuietHoursEnd,
+		DigestHour:      int32(preferences.DigestHour),
+		Channels:        make([]*notificationv1.ChannelPreference, 0, len(preferences.Channels)),
+	}
+	for _, rule := range preferences.Channels {
+		pb.Channels = append(pb.Channels, &notificationv1.ChannelPreference{
+			Category: rule.Category,
+			Channel:  rule.Channel,
+			Enabled:  rule.Enabled,
+			Delivery: rule.Delivery,
+		})
+	}
+	if !preferences.UpdatedAt.IsZero() {
+		pb.UpdatedAt = preferences.UpdatedAt.Format(time.RFC3339)
+	}
+	return pb
+}
@@ -2,184 +2,233 @@ package nats
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
-	"github.com/diploma/notification-svc/internal/application/event/dto"
+	sharedevents "github.com/diploma/events"
 	"github.com/diploma/notification-svc/internal/application/event/handler"
-	"github.com/nats-io/nats.go"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/entity"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
+var errMalformedEvent = errors.New("malformed event")
+
+type RetryPolicy struct {
+	MaxDeliver int
+	AckWait    time.Duration
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// Delay is the exponential backoff before redelivery attempt delivered+1
+func (p RetryPolicy) Delay(delivered int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < delivered && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+type SubscriberOptions struct {
+	Durable      string
+	StreamMaxAge time.Duration
+	Retry        RetryPolicy
+}
+
+// Delivery is the part of jetstream.Msg the subscriber relies on
+type Delivery interface {
+	Subject() string
+	Data() []byte
+	Metadata() (*jetstream.MsgMetadata, error)
+	Ack() error
+	NakWithDelay(delay time.Duration) error
+	Term() error
+}
+
 type EventSubscriber struct {
-	nc                      *nats.Conn
-	reservationEventHandler *handler.ReservationEventHandler
-	sessionEventHandler     *handler.SessionEventHandler
-	paymentEventHandler     *handler.PaymentEventHandler
+	js          jetstream.JetStream
+	opts        SubscriberOptions
+	deadLetters port.DeadLetterQueue
+	handlers    map[string]EnvelopeHandler
+	consumers   []jetstream.ConsumeContext
 }
 
 func NewEventSubscriber(
-	nc *nats.Conn,
+	js jetstream.JetStream,
+	opts SubscriberOptions,
+	deadLetters port.DeadLetterQueue,
 	reservationEventHandler *handler.ReservationEventHandler,
 	sessionEventHandler *handler.SessionEventHandler,
 	paymentEventHandler *handler.PaymentEventHandler,
+	userEventHandler *handler.UserEventHandler,
 ) *EventSubscriber {
 	return &EventSubscriber{
-		nc:                      nc,
-		reservationEventHandler: reservationEventHandler,
-		sessionEventHandler:     sessionEventHandler,
-		paymentEventHandler:     paymentEventHandler,
+		js:          js,
+		opts:        opts,
+		deadLetters: deadLetters,
+		handlers: map[string]EnvelopeHandler{
+			sharedevents.SubjectReservationCreated:   payloadHandler(reservationEventHandler.HandleReservationCreated),
+			sharedevents.SubjectReservationConfirmed: payloadHandler(reservationEventHandler.HandleReservationConfirmed),
+			sharedevents.SubjectReservationCancelled: payloadHandler(reservationEventHandler.HandleReservationCancelled),
+			sharedevents.SubjectReservationExpired:   payloadHandler(reservationEventHandler.HandleReservationExpired),
+
+			sharedevents.SubjectSessionCreated:   payloadHandler(sessionEventHandler.HandleSessionCreated),
+			sharedevents.SubjectSessionJoined:    payloadHandler(sessionEventHandler.HandleSessionJoined),
+			sharedevents.SubjectSessionFull:      payloadHandler(sessionEventHandler.HandleSessionFull),
+			sharedevents.SubjectSessionCancelled: payloadHandler(sessionEventHandler.HandleSessionCancelled),
+			sharedevents.SubjectSessionLeft:      payloadHandler(sessionEventHandler.HandleSessionLeft),
+
+			sharedevents.SubjectPaymentCreated:   payloadHandler(paymentEventHandler.HandlePaymentCreated),
+			sharedevents.SubjectPaymentSucceeded: payloadHandler(paymentEventHandler.HandlePaymentSucceeded),
+			sharedevents.SubjectPaymentFailed:    payloadHandler(paymentEventHandler.HandlePaymentFailed),
+			sharedevents.SubjectPaymentRefunded:  payloadHandler(paymentEventHandler.HandlePaymentRefunded),
+
+			sharedevents.SubjectUserEmailVerificationRequested: payloadHandler(userEventHandler.HandleEmailVerificationRequested),
+			sharedevents.SubjectUserPasswordResetRequested:     payloadHandler(userEventHandler.HandlePasswordResetRequested),
+			sharedevents.SubjectUserPasswordChanged:            payloadHandler(userEventHandler.HandlePasswordChanged),
+		},
 	}
 }
 
-func (s *EventSubscriber) SubscribeAll(ctx context.Context) error {
-	if _, err := s.nc.Subscribe("reservation.created", s.handleReservationCreated); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("reservation.confirmed", s.handleReservationConfirmed); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("reservation.cancelled", s.handleReservationCancelled); err != nil {
-		return err
-	}
+type EnvelopeHandler func(ctx context.Context, envelope *sharedevents.Envelope) error
 
-	if _, err := s.nc.Subscribe("session.created", s.handleSessionCreated); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("session.joined", s.handleSessionJoined); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("session.full", s.handleSessionFull); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("session.cancelled", s.handleSessionCancelled); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("session.left", s.handleSessionLeft); err != nil {
-		return err
-	}
-
-	if _, err := s.nc.Subscribe("payment.created", s.handlePaymentCreated); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("payment.succeeded", s.handlePaymentSucceeded); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("payment.failed", s.handlePaymentFailed); err != nil {
-		return err
-	}
-	if _, err := s.nc.Subscribe("payment.refunded", s.handlePaymentRefunded); err != nil {
-		return err
-	}
-
-	log.Println("Subscribed to all NATS events")
+func (s *EventSubscriber) SubscribeAll(ctx context.Context) error {
+	for _, stream := range sharedevents.Streams() {
+		// The producers own their streams; ensuring them here as well lets the consumers bind whatever
+		// order the services start in, and the shared config keeps both sides in agreement
+		if err := sharedevents.EnsureStream(ctx, s.js, stream.Name, s.opts.StreamMaxAge); err != nil {
+			return err
+		}
+
+		consumer, err := s.js.CreateOrUpdateConsumer(ctx, stream.Name, jetstream.ConsumerConfig{
+			Durable:   s.opts.Durable,
+			AckPolicy: jetstream.AckExplicitPolicy,
+			AckWait:   s.opts.Retry.AckWait,
+			// The delivery limit is enforced in Process so exhausted messages reach the DLQ instead of being dropped
+			MaxDeliver: -1,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create consumer on %s: %w", stream.Name, err)
+		}
+
+		consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+			s.Process(ctx, msg)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to consume from %s: %w", stream.Name, err)
+		}
+		s.consumers = append(s.consumers, consumeCtx)
+	}
+
+	log.Printf("Consuming JetStream events as durable %s", s.opts.Durable)
 	return nil
 }
 
-func (s *EventSubscriber) handleReservationCreated(msg *nats.Msg) {
-	var event dto.ReservationCreatedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal reservation.created event: %v", err)
-		return
+func (s *EventSubscriber) Stop() {
+	for _, consumeCtx := range s.consumers {
+		consumeCtx.Stop()
 	}
-	_ = s.reservationEventHandler.HandleReservationCreated(context.Background(), event)
 }
 
-func (s *EventSubscriber) handleReservationConfirmed(msg *nats.Msg) {
-	var event dto.ReservationConfirmedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal reservation.confirmed event: %v", err)
+func (s *EventSubscriber) Process(ctx context.Context, msg Delivery) {
+	handle, ok := s.handlers[msg.Subject()]
+	if !ok {
+		log.Printf("No handler for %s, acknowledging", msg.Subject())
+		_ = msg.Ack()
 		return
 	}
-	_ = s.reservationEventHandler.HandleReservationConfirmed(context.Background(), event)
-}
 
-func (s *EventSubscriber) handleReservationCancelled(msg *nats.Msg) {
-	var event dto.ReservationCancelledEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal reservation.cancelled event: %v", err)
+	meta, err := msg.Metadata()
+	if err != nil {
+		log.Printf("Failed to read metadata of %s message: %v", msg.Subject(), err)
+		_ = msg.NakWithDelay(s.opts.Retry.BaseDelay)
 		return
 	}
-	_ = s.reservationEventHandler.HandleReservationCancelled(context.Background(), event)
-}
 
-func (s *EventSubscriber) handleSessionCreated(msg *nats.Msg) {
-	var event dto.SessionCreatedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal session.created event: %v", err)
+	err = HandleMessage(ctx, msg.Subject(), msg.Data(), handle)
+	if err == nil {
+		_ = msg.Ack()
 		return
 	}
-	_ = s.sessionEventHandler.HandleSessionCreated(context.Background(), event)
-}
 
-func (s *EventSubscriber) handleSessionJoined(msg *nats.Msg) {
-	var event dto.SessionJoinedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal session.joined event: %v", err)
+	delivered := int(meta.NumDelivered)
+	if isPermanent(err) || delivered >= s.opts.Retry.MaxDeliver {
+		s.deadLetter(ctx, msg, meta, err)
 		return
 	}
-	_ = s.sessionEventHandler.HandleSessionJoined(context.Background(), event)
-}
 
-func (s *EventSubscriber) handleSessionFull(msg *nats.Msg) {
-	var event dto.SessionFullEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal session.full event: %v", err)
-		return
-	}
-	_ = s.sessionEventHandler.HandleSessionFull(context.Background(), event)
+	delay := s.opts.Retry.Delay(delivered)
+	log.Printf("Failed to handle %s (delivery %d/%d), retrying in %v: %v", msg.Subject(), delivered, s.opts.Retry.MaxDeliver, delay, err)
+	_ = msg.NakWithDelay(delay)
 }
 
-func (s *EventSubscriber) handleSessionCancelled(msg *nats.Msg) {
-	var event dto.SessionCancelledEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal session.cancelled event: %v", err)
+func (s *EventSubscriber) deadLetter(ctx context.Context, msg Delivery, meta *jetstream.MsgMetadata, cause error) {
+	letter := &entity.DeadLetter{
+		Subject:        msg.Subject(),
+		Data:           msg.Data(),
+		Error:          cause.Error(),
+		Deliveries:     int(meta.NumDelivered),
+		Stream:         meta.Stream,
+		StreamSequence: meta.Sequence.Stream,
+		FailedAt:       time.Now(),
+	}
+	// The dead-letter stream outlives the event streams and is listed to admins; a sealed token is still
+	// not something to keep there, and the user can simply ask for a new link
+	if sharedevents.CarriesSecret(msg.Subject()) {
+		letter.Data = nil
+	}
+
+	if err := s.deadLetters.Add(ctx, letter); err != nil {
+		log.Printf("Failed to dead-letter %s (stream seq %d), will retry: %v", msg.Subject(), meta.Sequence.Stream, err)
+		_ = msg.NakWithDelay(s.opts.Retry.MaxDelay)
 		return
 	}
-	_ = s.sessionEventHandler.HandleSessionCancelled(context.Background(), event)
-}
 
-func (s *EventSubscriber) handleSessionLeft(msg *nats.Msg) {
-	var event dto.SessionLeftEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal session.left event: %v", err)
-		return
-	}
-	_ = s.sessionEventHandler.HandleSessionLeft(context.Background(), event)
+	log.Printf("Moved %s (stream seq %d) to the dead letter queue after %d deliveries: %v", msg.Subject(), meta.Sequence.Stream, meta.NumDelivered, cause)
+	_ = msg.Term()
 }
 
-func (s *EventSubscriber) handlePaymentCreated(msg *nats.Msg) {
-	var event dto.PaymentCreatedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal payment.created event: %v", err)
-		return
+// isPermanent reports failures that redelivery cannot fix
+func isPermanent(err error) bool {
+	if errors.Is(err, errMalformedEvent) {
+		return true
+	}
+	switch pkgerrors.GetErrorCode(err) {
+	case pkgerrors.CodeNotFound, pkgerrors.CodeInvalidArgument:
+		return true
 	}
-	_ = s.paymentEventHandler.HandlePaymentCreated(context.Background(), event)
+	return false
 }
 
-func (s *EventSubscriber) handlePaymentSucceeded(msg *nats.Msg) {
-	var event dto.PaymentSucceededEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal payment.succeeded event: %v", err)
-		return
+func HandleMessage(ctx context.Context, subject string, data []byte, handle EnvelopeHandler) error {
+	envelope, err := sharedevents.Decode(data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMalformedEvent, err)
 	}
-	_ = s.paymentEventHandler.HandlePaymentSucceeded(context.Background(), event)
-}
 
-func (s *EventSubscriber) handlePaymentFailed(msg *nats.Msg) {
-	var event dto.PaymentFailedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal payment.failed event: %v", err)
-		return
+	if envelope.Type != subject {
+		log.Printf("Ignoring %s event %s delivered on %s", envelope.Type, envelope.ID, subject)
+		return nil
 	}
-	_ = s.paymentEventHandler.HandlePaymentFailed(context.Background(), event)
+
+	// Redeliveries carry the same envelope ID, which is what the delivery log dedups on
+	return handle(service.WithEventID(ctx, envelope.ID), envelope)
 }
 
-func (s *EventSubscriber) handlePaymentRefunded(msg *nats.Msg) {
-	var event dto.PaymentRefundedEvent
-	if err := json.Unmarshal(msg.Data, &event); err != nil {
-		log.Printf("Failed to unmarshal payment.refunded event: %v", err)
-		return
+func payloadHandler[T any](fn func(ctx context.Context, event T) error) EnvelopeHandler {
+	return func(ctx context.Context, envelope *sharedevents.Envelope) error {
+		var event T
+		if err := envelope.UnmarshalPayload(&event); err != nil {
+			return fmt.Errorf("%w: %v", errMalformedEvent, err)
+		}
+		return fn(ctx, event)
 	}
-	_ = s.paymentEventHandler.HandlePaymentRefunded(context.Background(), event)
 }
-
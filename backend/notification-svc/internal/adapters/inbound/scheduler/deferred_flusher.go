@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/application/delivery/dto"
+	"github.com/diploma/notification-svc/internal/application/delivery/usecase"
+)
+
+// DeferredFlusher releases notifications held back by quiet hours and sends due digests
+type DeferredFlusher struct {
+	flushDeferredUseCase *usecase.FlushDeferredUseCase
+	interval             time.Duration
+	batchSize            int
+}
+
+func NewDeferredFlusher(
+	flushDeferredUseCase *usecase.FlushDeferredUseCase,
+	interval time.Duration,
+	batchSize int,
+) *DeferredFlusher {
+	return &DeferredFlusher{
+		flushDeferredUseCase: flushDeferredUseCase,
+		interval:             interval,
+		batchSize:            batchSize,
+	}
+}
+
+func (f *DeferredFlusher) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.flush(ctx)
+		}
+	}
+}
+
+func (f *DeferredFlusher) flush(ctx context.Context) {
+	for {
+		output, err := f.flushDeferredUseCase.Execute(ctx, dto.FlushDeferredInput{
+			BatchSize: f.batchSize,
+		})
+		if err != nil {
+			log.Printf("Failed to flush deferred notifications: %v", err)
+			return
+		}
+		if output.Fetched > 0 {
+			log.Printf("Flushed %d deferred notifications (%d to retry)", output.Settled, output.Retried)
+		}
+		if output.Fetched < f.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
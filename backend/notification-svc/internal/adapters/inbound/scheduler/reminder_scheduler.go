@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/application/reminder/dto"
+	"github.com/diploma/notification-svc/internal/application/reminder/usecase"
+)
+
+// ReminderScheduler polls the scheduled_reminders table, so reminders survive restarts and are shared across replicas
+type ReminderScheduler struct {
+	sendDueRemindersUseCase *usecase.SendDueRemindersUseCase
+	interval                time.Duration
+	batchSize               int
+}
+
+func NewReminderScheduler(
+	sendDueRemindersUseCase *usecase.SendDueRemindersUseCase,
+	interval time.Duration,
+	batchSize int,
+) *ReminderScheduler {
+	return &ReminderScheduler{
+		sendDueRemindersUseCase: sendDueRemindersUseCase,
+		interval:                interval,
+		batchSize:               batchSize,
+	}
+}
+
+func (s *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sendDue(ctx)
+		}
+	}
+}
+
+func (s *ReminderScheduler) sendDue(ctx context.Context) {
+	for {
+		output, err := s.sendDueRemindersUseCase.Execute(ctx, dto.SendDueRemindersInput{
+			BatchSize: s.batchSize,
+		})
+		if err != nil {
+			log.Printf("Failed to send due reminders: %v", err)
+			return
+		}
+		if output.Fetched > 0 {
+			log.Printf("Settled %d due reminders (%d to retry)", output.Settled, output.Retried)
+		}
+		if output.Fetched < s.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
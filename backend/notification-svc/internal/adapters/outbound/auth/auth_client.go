@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+
+	authv1 "github.com/diploma/notification-svc/api/proto/auth/v1"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type AuthClient struct {
+	client authv1.AuthServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewAuthClient(address string) (*AuthClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthClient{
+		client: authv1.NewAuthServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *AuthClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *AuthClient) GetRecipient(ctx context.Context, userID string) (*port.Recipient, error) {
+	profile, err := c.client.GetUserProfile(ctx, &authv1.GetUserProfileRequest{UserId: userID})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, pkgerrors.NewNotFoundError("user not found")
+		case codes.InvalidArgument:
+			return nil, pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+		default:
+			return nil, pkgerrors.NewExternalAPIError("failed to get user profile", err)
+		}
+	}
+
+	return &port.Recipient{
+		UserID:   profile.UserId,
+		FullName: profile.FullName,
+		Email:    profile.Email,
+		Phone:    profile.Phone,
+	}, nil
+}
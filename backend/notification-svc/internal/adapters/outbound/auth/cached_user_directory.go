@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+)
+
+type cacheEntry struct {
+	recipient *port.Recipient
+	expiresAt time.Time
+}
+
+// CachedUserDirectory keeps resolved profiles for ttl so fan-outs don't hit auth-svc once per event
+type CachedUserDirectory struct {
+	next    port.UserDirectory
+	ttl     time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func NewCachedUserDirectory(next port.UserDirectory, ttl time.Duration, maxSize int) *CachedUserDirectory {
+	return &CachedUserDirectory{
+		next:    next,
+		ttl:     ttl,
+		maxSize: maxSize,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (d *CachedUserDirectory) GetRecipient(ctx context.Context, userID string) (*port.Recipient, error) {
+	now := time.Now()
+
+	d.mu.Lock()
+	entry, ok := d.entries[userID]
+	d.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.recipient, nil
+	}
+
+	recipient, err := d.next.GetRecipient(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.entries) >= d.maxSize {
+		d.evict(now)
+	}
+	d.entries[userID] = cacheEntry{recipient: recipient, expiresAt: now.Add(d.ttl)}
+
+	return recipient, nil
+}
+
+// evict drops expired entries, or the whole cache if everything is still fresh
+func (d *CachedUserDirectory) evict(now time.Time) {
+	for userID, entry := range d.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(d.entries, userID)
+		}
+	}
+	if len(d.entries) >= d.maxSize {
+		d.entries = make(map[string]cacheEntry)
+	}
+}
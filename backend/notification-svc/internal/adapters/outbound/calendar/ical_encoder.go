@@ -0,0 +1,84 @@
+package calendar
+
+import (
+	"strings"
+
+	"github.com/diploma/ical"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+const inviteFilename = "invite.ics"
+
+type ICalEncoder struct {
+	organizer ical.Person
+}
+
+// NewICalEncoder takes the organizer clients show on the invite, normally the address notifications are sent from
+func NewICalEncoder(organizerName, organizerEmail string) *ICalEncoder {
+	return &ICalEncoder{organizer: ical.Person{Name: organizerName, Email: organizerEmail}}
+}
+
+func (e *ICalEncoder) EncodeInvite(invite *port.CalendarInvite) (*port.Attachment, error) {
+	var uid string
+	switch {
+	case invite.ReservationID != "":
+		uid = ical.ReservationUID(invite.ReservationID)
+	case invite.SessionID != "":
+		uid = ical.SessionUID(invite.SessionID)
+	default:
+		return nil, pkgerrors.NewInvalidArgumentError("calendar invite needs a reservation or session")
+	}
+
+	method := ical.MethodRequest
+	status := ical.StatusConfirmed
+	if invite.Method == port.InviteCancel {
+		method = ical.MethodCancel
+		status = ical.StatusCancelled
+	}
+
+	organizer := e.organizer
+	event := ical.Event{
+		UID:          uid,
+		Sequence:     ical.SequenceAt(invite.IssuedAt),
+		Status:       status,
+		Summary:      invite.Summary,
+		Description:  invite.Description,
+		Start:        invite.StartTime,
+		End:          invite.EndTime,
+		LastModified: invite.IssuedAt,
+		Organizer:    &organizer,
+	}
+	if venue := invite.Venue; venue != nil {
+		event.Location = location(venue)
+		if venue.Latitude != 0 || venue.Longitude != 0 {
+			event.Geo = &ical.Geo{Latitude: venue.Latitude, Longitude: venue.Longitude}
+		}
+	}
+	if invite.Attendee != nil && invite.Attendee.Email != "" {
+		event.Attendees = []ical.Person{{Name: invite.Attendee.FullName, Email: invite.Attendee.Email}}
+	}
+
+	calendar := &ical.Calendar{
+		Method: method,
+		Stamp:  invite.IssuedAt,
+		Events: []ical.Event{event},
+	}
+
+	return &port.Attachment{
+		Filename:    inviteFilename,
+		ContentType: ical.ContentType(method),
+		Data:        calendar.Marshal(),
+	}, nil
+}
+
+// location joins the venue name and its street address the way map lookups expect
+func location(venue *port.VenueDetails) string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{venue.VenueName, venue.Address, venue.City} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const deferredDeliveryTable = "deferred_deliveries"
+
+type DeferredDeliveryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDeferredDeliveryRepository(db *gorm.DB) port.DeferredDeliveryRepository {
+	return &DeferredDeliveryRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *DeferredDeliveryRepositoryImpl) Create(ctx context.Context, delivery *entity.DeferredDelivery) error {
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now()
+	}
+
+	result := dbFromContext(ctx, r.db).Table(deferredDeliveryTable).Create(delivery)
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to defer notification", result.Error)
+	}
+
+	return nil
+}
+
+func (r *DeferredDeliveryRepositoryImpl) FetchDue(ctx context.Context, now time.Time, limit int) ([]*entity.DeferredDelivery, error) {
+	var deliveries []*entity.DeferredDelivery
+	// SKIP LOCKED lets flushers on other replicas claim disjoint batches
+	result := dbFromContext(ctx, r.db).Raw(`
+		SELECT * FROM `+deferredDeliveryTable+`
+		WHERE deliver_after <= ?
+		ORDER BY deliver_after, created_at
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED`,
+		now, limit).
+		Scan(&deliveries)
+
+	if result.Error != nil {
+		return nil, pkgerrors.NewInternalError("failed to fetch deferred notifications", result.Error)
+	}
+
+	return deliveries, nil
+}
+
+func (r *DeferredDeliveryRepositoryImpl) Delete(ctx context.Context, ids []uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Table(deferredDeliveryTable).Where("id IN ?", ids).Delete(&entity.DeferredDelivery{})
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to delete deferred notifications", result.Error)
+	}
+
+	return nil
+}
+
+func (r *DeferredDeliveryRepositoryImpl) Reschedule(ctx context.Context, ids []uuid.UUID, deliverAfter time.Time) error {
+	result := dbFromContext(ctx, r.db).Table(deferredDeliveryTable).Where("id IN ?", ids).Updates(map[string]interface{}{
+		"attempts":      gorm.Expr("attempts + 1"),
+		"deliver_after": deliverAfter,
+	})
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to reschedule deferred notifications", result.Error)
+	}
+
+	return nil
+}
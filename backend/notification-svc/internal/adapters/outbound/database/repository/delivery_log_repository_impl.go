@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const deliveryLogTable = "delivery_log"
+
+// staleClaimAfter is how long a pending entry blocks other attempts; past it the attempt is presumed
+// to have died before recording its outcome
+const staleClaimAfter = 5 * time.Minute
+
+type DeliveryLogRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDeliveryLogRepository(db *gorm.DB) port.DeliveryLogRepository {
+	return &DeliveryLogRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *DeliveryLogRepositoryImpl) Claim(ctx context.Context, record *entity.DeliveryRecord) (bool, error) {
+	return r.claim(ctx, record, entity.DeliveryStatusFailed)
+}
+
+func (r *DeliveryLogRepositoryImpl) ClaimDeferred(ctx context.Context, record *entity.DeliveryRecord) (bool, error) {
+	return r.claim(ctx, record, entity.DeliveryStatusFailed, entity.DeliveryStatusDeferred)
+}
+
+func (r *DeliveryLogRepositoryImpl) claim(ctx context.Context, record *entity.DeliveryRecord, reclaimable ...entity.DeliveryStatus) (bool, error) {
+	now := time.Now()
+	var claimed struct {
+		ID        string
+		Attempts  int
+		CreatedAt time.Time
+	}
+
+	// The conflict update only fires for reclaimable rows; otherwise nothing is returned and the key stays as it was
+	result := dbFromContext(ctx, r.db).Raw(`
+		INSERT INTO `+deliveryLogTable+` (id, event_id, user_id, channel, type, status, attempts, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, 1, ?, ?)
+		ON CONFLICT (event_id, user_id, channel, type) DO UPDATE
+		SET status = EXCLUDED.status, attempts = `+deliveryLogTable+`.attempts + 1, updated_at = EXCLUDED.updated_at
+		WHERE `+deliveryLogTable+`.status IN ?
+			OR (`+deliveryLogTable+`.status = ? AND `+deliveryLogTable+`.updated_at < ?)
+		RETURNING id, attempts, created_at`,
+		record.ID, record.EventID, record.UserID, record.Channel, record.Type, entity.DeliveryStatusPending, now, now,
+		reclaimable, entity.DeliveryStatusPending, now.Add(-staleClaimAfter)).
+		Scan(&claimed)
+
+	if result.Error != nil {
+		return false, pkgerrors.NewInternalError("failed to claim delivery", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := record.ID.UnmarshalText([]byte(claimed.ID)); err != nil {
+		return false, pkgerrors.NewInternalError("failed to claim delivery", err)
+	}
+	record.Status = entity.DeliveryStatusPending
+	record.Attempts = claimed.Attempts
+	record.CreatedAt = claimed.CreatedAt
+	record.UpdatedAt = now
+	return true, nil
+}
+
+func (r *DeliveryLogRepositoryImpl) Finish(ctx context.Context, record *entity.DeliveryRecord) error {
+	result := dbFromContext(ctx, r.db).Table(deliveryLogTable).Where("id = ?", record.ID).Updates(map[string]interface{}{
+		"status":              record.Status,
+		"provider_message_id": record.ProviderMessageID,
+		"last_error":          record.LastError,
+		"sent_at":             record.SentAt,
+		"updated_at":          record.UpdatedAt,
+	})
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to record delivery", result.Error)
+	}
+
+	return nil
+}
+
+func (r *DeliveryLogRepositoryImpl) List(ctx context.Context, filter port.DeliveryLogFilter) ([]*entity.DeliveryRecord, int64, error) {
+	query := dbFromContext(ctx, r.db).Table(deliveryLogTable)
+	if filter.EventID != "" {
+		query = query.Where("event_id = ?", filter.EventID)
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Channel != "" {
+		query = query.Where("channel = ?", filter.Channel)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pkgerrors.NewInternalError("failed to count deliveries", err)
+	}
+
+	var records []*entity.DeliveryRecord
+	result := query.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&records)
+	if result.Error != nil {
+		return nil, 0, pkgerrors.NewInternalError("failed to list deliveries", result.Error)
+	}
+
+	return records, total, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type NotificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) port.NotificationRepository {
+	return &NotificationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *NotificationRepositoryImpl) Create(ctx context.Context, notification *entity.Notification) error {
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+
+	result := dbFromContext(ctx, r.db).Create(notification)
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to create notification", result.Error)
+	}
+
+	return nil
+}
+
+func (r *NotificationRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*entity.Notification, int64, error) {
+	query := dbFromContext(ctx, r.db).Model(&entity.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pkgerrors.NewInternalError("failed to count notifications", err)
+	}
+
+	var notifications []*entity.Notification
+	result := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&notifications)
+	if result.Error != nil {
+		return nil, 0, pkgerrors.NewInternalError("failed to list notifications", result.Error)
+	}
+
+	return notifications, total, nil
+}
+
+func (r *NotificationRepositoryImpl) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	result := dbFromContext(ctx, r.db).
+		Model(&entity.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count)
+
+	if result.Error != nil {
+		return 0, pkgerrors.NewInternalError("failed to count unread notifications", result.Error)
+	}
+
+	return count, nil
+}
+
+func (r *NotificationRepositoryImpl) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, readAt time.Time) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result := dbFromContext(ctx, r.db).
+		Model(&entity.Notification{}).
+		Where("user_id = ? AND id IN ? AND read_at IS NULL", userID, ids).
+		Update("read_at", readAt)
+
+	if result.Error != nil {
+		return 0, pkgerrors.NewInternalError("failed to mark notifications read", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *NotificationRepositoryImpl) MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&entity.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", readAt)
+
+	if result.Error != nil {
+		return 0, pkgerrors.NewInternalError("failed to mark notifications read", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
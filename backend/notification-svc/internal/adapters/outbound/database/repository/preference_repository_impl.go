@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type preferenceModel struct {
+	UserID          uuid.UUID `gorm:"primaryKey"`
+	Timezone        string
+	QuietHoursStart *string
+	QuietHoursEnd   *string
+	DigestHour      int
+	UpdatedAt       time.Time
+}
+
+func (preferenceModel) TableName() string {
+	return "notification_preferences"
+}
+
+type channelPreferenceModel struct {
+	UserID   uuid.UUID `gorm:"primaryKey"`
+	Category string    `gorm:"primaryKey"`
+	Channel  string    `gorm:"primaryKey"`
+	Enabled  bool
+	Delivery string
+}
+
+func (channelPreferenceModel) TableName() string {
+	return "notification_channel_preferences"
+}
+
+type PreferenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPreferenceRepository(db *gorm.DB) port.PreferenceRepository {
+	return &PreferenceRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *PreferenceRepositoryImpl) Get(ctx context.Context, userID uuid.UUID) (*entity.Preferences, error) {
+	var model preferenceModel
+	result := dbFromContext(ctx, r.db).Where("user_id = ?", userID).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NewNotFoundError(fmt.Sprintf("preferences not found: %s", userID))
+		}
+		return nil, pkgerrors.NewInternalError("failed to get preferences", result.Error)
+	}
+
+	var rules []channelPreferenceModel
+	result = dbFromContext(ctx, r.db).Where("user_id = ?", userID).Find(&rules)
+	if result.Error != nil {
+		return nil, pkgerrors.NewInternalError("failed to get channel preferences", result.Error)
+	}
+
+	preferences := &entity.Preferences{
+		UserID:     model.UserID,
+		Timezone:   model.Timezone,
+		DigestHour: model.DigestHour,
+		Channels:   make([]entity.ChannelPreference, 0, len(rules)),
+		UpdatedAt:  model.UpdatedAt,
+	}
+	if model.QuietHoursStart != nil && model.QuietHoursEnd != nil {
+		preferences.QuietHoursStart = *model.QuietHoursStart
+		preferences.QuietHoursEnd = *model.QuietHoursEnd
+	}
+	for _, rule := range rules {
+		preferences.Channels = append(preferences.Channels, entity.ChannelPreference{
+			Category: entity.Category(rule.Category),
+			Channel:  entity.Channel(rule.Channel),
+			Enabled:  rule.Enabled,
+			Delivery: entity.DeliveryMode(rule.Delivery),
+		})
+	}
+
+	return preferences, nil
+}
+
+// Save replaces the user's preferences and rules in one transaction
+func (r *PreferenceRepositoryImpl) Save(ctx context.Context, preferences *entity.Preferences) error {
+	model := preferenceModel{
+		UserID:     preferences.UserID,
+		Timezone:   preferences.Timezone,
+		DigestHour: preferences.DigestHour,
+		UpdatedAt:  preferences.UpdatedAt,
+	}
+	if preferences.HasQuietHours() {
+		model.QuietHoursStart = &preferences.QuietHoursStart
+		model.QuietHoursEnd = &preferences.QuietHoursEnd
+	}
+
+	rules := make([]channelPreferenceModel, 0, len(preferences.Channels))
+	for _, rule := range preferences.Channels {
+		rules = append(rules, channelPreferenceModel{
+			UserID:   preferences.UserID,
+			Category: string(rule.Category),
+			Channel:  string(rule.Channel),
+			Enabled:  rule.Enabled,
+			Delivery: string(rule.Delivery),
+		})
+	}
+
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&model).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", preferences.UserID).Delete(&channelPreferenceModel{}).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		return tx.Create(&rules).Error
+	})
+	if err != nil {
+		return pkgerrors.NewInternalError("failed to save preferences", err)
+	}
+
+	return nil
+}
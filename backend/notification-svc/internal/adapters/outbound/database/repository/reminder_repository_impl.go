@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reminderModel struct {
+	ID           uuid.UUID `gorm:"primaryKey"`
+	SessionID    uuid.UUID
+	HostID       uuid.UUID
+	Kind         string
+	LeadSeconds  int64
+	SessionStart time.Time
+	DueAt        time.Time
+	Attempts     int
+	LockedUntil  *time.Time
+	SentAt       *time.Time
+	CreatedAt    time.Time
+}
+
+func (reminderModel) TableName() string {
+	return "scheduled_reminders"
+}
+
+func (m *reminderModel) toEntity() *entity.Reminder {
+	return &entity.Reminder{
+		ID:           m.ID,
+		SessionID:    m.SessionID,
+		HostID:       m.HostID,
+		Kind:         entity.ReminderKind(m.Kind),
+		Lead:         time.Duration(m.LeadSeconds) * time.Second,
+		SessionStart: m.SessionStart,
+		DueAt:        m.DueAt,
+		Attempts:     m.Attempts,
+		SentAt:       m.SentAt,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+type ReminderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) port.ReminderRepository {
+	return &ReminderRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ReminderRepositoryImpl) Schedule(ctx context.Context, reminders []*entity.Reminder) error {
+	models := make([]reminderModel, 0, len(reminders))
+	for _, reminder := range reminders {
+		if reminder.CreatedAt.IsZero() {
+			reminder.CreatedAt = time.Now()
+		}
+		models = append(models, reminderModel{
+			ID:           reminder.ID,
+			SessionID:    reminder.SessionID,
+			HostID:       reminder.HostID,
+			Kind:         string(reminder.Kind),
+			LeadSeconds:  int64(reminder.Lead / time.Second),
+			SessionStart: reminder.SessionStart,
+			DueAt:        reminder.DueAt,
+			Attempts:     reminder.Attempts,
+			SentAt:       reminder.SentAt,
+			CreatedAt:    reminder.CreatedAt,
+		})
+	}
+
+	// Redelivered session.created events hit the (session_id, kind, lead_seconds) key and change nothing
+	result := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&models)
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to schedule reminders", result.Error)
+	}
+
+	return nil
+}
+
+func (r *ReminderRepositoryImpl) ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Reminder, error) {
+	var models []reminderModel
+	// SKIP LOCKED only guards this statement; locked_until keeps the batch claimed while it is being sent
+	result := dbFromContext(ctx, r.db).Raw(`
+		UPDATE scheduled_reminders
+		SET locked_until = ?, attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM scheduled_reminders
+			WHERE sent_at IS NULL AND due_at <= ?
+				AND (locked_until IS NULL OR locked_until <= ?)
+			ORDER BY due_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		lockedUntil, now, now, limit).
+		Scan(&models)
+
+	if result.Error != nil {
+		return nil, pkgerrors.NewInternalError("failed to claim due reminders", result.Error)
+	}
+
+	reminders := make([]*entity.Reminder, 0, len(models))
+	for i := range models {
+		reminders = append(reminders, models[i].toEntity())
+	}
+
+	return reminders, nil
+}
+
+func (r *ReminderRepositoryImpl) MarkSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	result := dbFromContext(ctx, r.db).Model(&reminderModel{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"sent_at":      sentAt,
+		"locked_until": nil,
+	})
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to mark reminder sent", result.Error)
+	}
+
+	return nil
+}
+
+func (r *ReminderRepositoryImpl) Reschedule(ctx context.Context, id uuid.UUID, dueAt time.Time) error {
+	result := dbFromContext(ctx, r.db).Model(&reminderModel{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"due_at":       dueAt,
+		"locked_until": nil,
+	})
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to reschedule reminder", result.Error)
+	}
+
+	return nil
+}
+
+func (r *ReminderRepositoryImpl) CancelBySession(ctx context.Context, sessionID uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Where("session_id = ? AND sent_at IS NULL", sessionID).Delete(&reminderModel{})
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to cancel reminders", result.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package dlq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/deadletter/entity"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	StreamName    = "NOTIFICATIONS_DLQ"
+	SubjectPrefix = "notifications.dlq."
+
+	HeaderOriginalSubject = "Dlq-Original-Subject"
+	HeaderError           = "Dlq-Error"
+	HeaderDeliveries      = "Dlq-Deliveries"
+	HeaderStream          = "Dlq-Stream"
+	HeaderStreamSequence  = "Dlq-Stream-Sequence"
+	HeaderFailedAt        = "Dlq-Failed-At"
+)
+
+// JetStreamQueue keeps dead letters in their own stream so they survive restarts and can be replayed
+type JetStreamQueue struct {
+	js     jetstream.JetStream
+	stream jetstream.Stream
+}
+
+func NewJetStreamQueue(ctx context.Context, js jetstream.JetStream, maxAge time.Duration) (*JetStreamQueue, error) {
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     StreamName,
+		Subjects: []string{SubjectPrefix + ">"},
+		Storage:  jetstream.FileStorage,
+		MaxAge:   maxAge,
+	})
+	if err != nil {
+		return nil, pkgerrors.NewExternalAPIError("failed to create dead letter stream", err)
+	}
+
+	return &JetStreamQueue{
+		js:     js,
+		stream: stream,
+	}, nil
+}
+
+func (q *JetStreamQueue) Add(ctx context.Context, letter *entity.DeadLetter) error {
+	msg := nats.NewMsg(SubjectPrefix + letter.Subject)
+	msg.Data = letter.Data
+	msg.Header.Set(HeaderOriginalSubject, letter.Subject)
+	msg.Header.Set(HeaderError, letter.Error)
+	msg.Header.Set(HeaderDeliveries, strconv.Itoa(letter.Deliveries))
+	msg.Header.Set(HeaderStream, letter.Stream)
+	msg.Header.Set(HeaderStreamSequence, strconv.FormatUint(letter.StreamSequence, 10))
+	msg.Header.Set(HeaderFailedAt, letter.FailedAt.UTC().Format(time.RFC3339))
+
+	if _, err := q.js.PublishMsg(ctx, msg); err != nil {
+		return pkgerrors.NewExternalAPIError("failed to publish dead letter", err)
+	}
+
+	return nil
+}
+
+func (q *JetStreamQueue) List(ctx context.Context, subject string, afterSequence uint64, limit int) ([]*entity.DeadLetter, error) {
+	info, err := q.stream.Info(ctx)
+	if err != nil {
+		return nil, pkgerrors.NewExternalAPIError("failed to get dead letter stream info", err)
+	}
+
+	seq := afterSequence + 1
+	if seq < info.State.FirstSeq {
+		seq = info.State.FirstSeq
+	}
+
+	letters := make([]*entity.DeadLetter, 0)
+	for ; seq <= info.State.LastSeq && len(letters) < limit; seq++ {
+		raw, err := q.stream.GetMsg(ctx, seq)
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			continue // replayed or deleted
+		}
+		if err != nil {
+			return nil, pkgerrors.NewExternalAPIError("failed to read dead letter", err)
+		}
+
+		letter := toDeadLetter(raw)
+		if subject != "" && letter.Subject != subject {
+			continue
+		}
+		letters = append(letters, letter)
+	}
+
+	return letters, nil
+}
+
+func (q *JetStreamQueue) Get(ctx context.Context, sequence uint64) (*entity.DeadLetter, error) {
+	raw, err := q.stream.GetMsg(ctx, sequence)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil, pkgerrors.NewNotFoundError("dead letter not found")
+	}
+	if err != nil {
+		return nil, pkgerrors.NewExternalAPIError("failed to read dead letter", err)
+	}
+
+	return toDeadLetter(raw), nil
+}
+
+func (q *JetStreamQueue) Delete(ctx context.Context, sequence uint64) error {
+	if _, err := q.Get(ctx, sequence); err != nil {
+		return err
+	}
+
+	if err := q.stream.DeleteMsg(ctx, sequence); err != nil {
+		return pkgerrors.NewExternalAPIError("failed to delete dead letter", err)
+	}
+
+	return nil
+}
+
+// Republish sends a replayed event back through JetStream so it lands in its original stream
+func (q *JetStreamQueue) Republish(ctx context.Context, subject string, data []byte) error {
+	if _, err := q.js.Publish(ctx, subject, data); err != nil {
+		return pkgerrors.NewExternalAPIError("failed to republish event", err)
+	}
+
+	return nil
+}
+
+func toDeadLetter(raw *jetstream.RawStreamMsg) *entity.DeadLetter {
+	letter := &entity.DeadLetter{
+		Sequence: raw.Sequence,
+		Subject:  raw.Header.Get(HeaderOriginalSubject),
+		Data:     raw.Data,
+		Error:    raw.Header.Get(HeaderError),
+		Stream:   raw.Header.Get(HeaderStream),
+		FailedAt: raw.Time,
+	}
+
+	letter.Deliveries, _ = strconv.Atoi(raw.Header.Get(HeaderDeliveries))
+	letter.StreamSequence, _ = strconv.ParseUint(raw.Header.Get(HeaderStreamSequence), 10, 64)
+	if failedAt, err := time.Parse(time.RFC3339, raw.Header.Get(HeaderFailedAt)); err == nil {
+		letter.FailedAt = failedAt
+	}
+
+	return letter
+}
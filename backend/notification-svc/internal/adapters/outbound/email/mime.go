@@ -0,0 +1,203 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+// Envelope is everything BuildMessage needs besides the notification itself
+type Envelope struct {
+	From      *mail.Address
+	MessageID string
+	Date      time.Time
+}
+
+// BuildMessage renders an RFC 5322 message. Text, text+HTML and attachments nest as
+// text/plain, multipart/alternative and multipart/mixed respectively; non-ASCII headers
+// are RFC 2047 encoded and bodies are quoted-printable or base64, so every line stays 7-bit.
+func BuildMessage(envelope Envelope, notification port.EmailNotification) ([]byte, error) {
+	if err := checkHeaderValues(notification); err != nil {
+		return nil, err
+	}
+
+	to := &mail.Address{Name: notification.ToName, Address: notification.To}
+	if _, err := mail.ParseAddress(to.String()); err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError(fmt.Sprintf("invalid recipient address %q", notification.To))
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", envelope.From.String())
+	writeHeader(&buf, "To", to.String())
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", notification.Subject))
+	writeHeader(&buf, "Date", envelope.Date.Format(time.RFC1123Z))
+	writeHeader(&buf, "Message-ID", envelope.MessageID)
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	if len(notification.Attachments) == 0 {
+		if err := writeBody(&buf, nil, notification); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	writeHeader(&buf, "Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": mixed.Boundary()}))
+	buf.WriteString("\r\n")
+
+	if err := writeBody(&buf, mixed, notification); err != nil {
+		return nil, err
+	}
+	for _, attachment := range notification.Attachments {
+		if err := writeAttachment(mixed, attachment); err != nil {
+			return nil, err
+		}
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, pkgerrors.NewInternalError("failed to build email", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeBody writes the text (and HTML) body either as the top-level entity or, with parent set, as its first part
+func writeBody(buf *bytes.Buffer, parent *multipart.Writer, notification port.EmailNotification) error {
+	if notification.HTMLBody == "" {
+		return writeTextPart(buf, parent, "text/plain", notification.Body)
+	}
+
+	var nested bytes.Buffer
+	alternative := multipart.NewWriter(&nested)
+	contentType := mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": alternative.Boundary()})
+
+	if err := writeTextPart(nil, alternative, "text/plain", notification.Body); err != nil {
+		return err
+	}
+	if err := writeTextPart(nil, alternative, "text/html", notification.HTMLBody); err != nil {
+		return err
+	}
+	if err := alternative.Close(); err != nil {
+		return pkgerrors.NewInternalError("failed to build email", err)
+	}
+
+	if parent == nil {
+		writeHeader(buf, "Content-Type", contentType)
+		buf.WriteString("\r\n")
+		buf.Write(nested.Bytes())
+		return nil
+	}
+
+	part, err := parent.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return pkgerrors.NewInternalError("failed to build email", err)
+	}
+	_, err = part.Write(nested.Bytes())
+	return err
+}
+
+func writeTextPart(buf *bytes.Buffer, parent *multipart.Writer, mediaType, text string) error {
+	contentType := mime.FormatMediaType(mediaType, map[string]string{"charset": "UTF-8"})
+
+	var encoded bytes.Buffer
+	qp := quotedprintable.NewWriter(&encoded)
+	if _, err := qp.Write([]byte(text)); err != nil {
+		return pkgerrors.NewInternalError("failed to encode email body", err)
+	}
+	if err := qp.Close(); err != nil {
+		return pkgerrors.NewInternalError("failed to encode email body", err)
+	}
+
+	if parent == nil {
+		writeHeader(buf, "Content-Type", contentType)
+		writeHeader(buf, "Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		buf.Write(encoded.Bytes())
+		return nil
+	}
+
+	part, err := parent.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return pkgerrors.NewInternalError("failed to build email", err)
+	}
+	_, err = part.Write(encoded.Bytes())
+	return err
+}
+
+func writeAttachment(parent *multipart.Writer, attachment port.Attachment) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if _, _, err := mime.ParseMediaType(contentType); err != nil {
+		return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("invalid attachment content type %q", contentType))
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"base64"},
+	}
+	if attachment.Filename != "" {
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	}
+
+	part, err := parent.CreatePart(header)
+	if err != nil {
+		return pkgerrors.NewInternalError("failed to build email", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		if _, err := fmt.Fprintf(part, "%s\r\n", encoded[:76]); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = fmt.Fprintf(part, "%s\r\n", encoded)
+	return err
+}
+
+// writeHeader folds long values at whitespace, aiming for the recommended 78 character lines
+func writeHeader(buf *bytes.Buffer, name, value string) {
+	const maxLine = 78
+
+	buf.WriteString(name)
+	buf.WriteString(":")
+	lineLen := len(name) + 1
+	for _, word := range strings.Split(value, " ") {
+		if lineLen+1+len(word) > maxLine && lineLen > len(name)+1 {
+			buf.WriteString("\r\n")
+			lineLen = 0
+		}
+		buf.WriteString(" ")
+		buf.WriteString(word)
+		lineLen += 1 + len(word)
+	}
+	buf.WriteString("\r\n")
+}
+
+// checkHeaderValues rejects line breaks that would let a value inject headers of its own
+func checkHeaderValues(notification port.EmailNotification) error {
+	values := []string{notification.To, notification.ToName, notification.Subject}
+	for _, attachment := range notification.Attachments {
+		values = append(values, attachment.Filename, attachment.ContentType)
+	}
+	for _, value := range values {
+		if strings.ContainsAny(value, "\r\n") {
+			return pkgerrors.NewInvalidArgumentError("email header values must not contain line breaks")
+		}
+	}
+	return nil
+}
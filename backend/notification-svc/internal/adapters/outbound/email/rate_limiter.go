@@ -0,0 +1,59 @@
+package email
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces sends evenly at a fixed rate while allowing short bursts (GCRA)
+type rateLimiter struct {
+	mu        sync.Mutex
+	interval  time.Duration
+	tolerance time.Duration
+	tat       time.Time // Theoretical arrival time of the next send
+}
+
+func newRateLimiter(perSecond, burst int) *rateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	interval := time.Second / time.Duration(perSecond)
+	return &rateLimiter{
+		interval:  interval,
+		tolerance: time.Duration(burst-1) * interval,
+	}
+}
+
+// Wait blocks until a send is allowed; a nil limiter never blocks
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.tat.Before(now) {
+		l.tat = now
+	}
+	delay := l.tat.Add(-l.tolerance).Sub(now)
+	l.tat = l.tat.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
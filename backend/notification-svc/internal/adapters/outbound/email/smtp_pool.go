@@ -0,0 +1,222 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+type TLSMode string
+
+const (
+	TLSModeNone     TLSMode = "none"
+	TLSModeSTARTTLS TLSMode = "starttls" // Upgrade a plain connection; fails if the server does not offer it
+	TLSModeImplicit TLSMode = "tls"      // TLS from the first byte, usually port 465
+)
+
+func (m TLSMode) IsValid() bool {
+	switch m {
+	case TLSModeNone, TLSModeSTARTTLS, TLSModeImplicit:
+		return true
+	}
+	return false
+}
+
+var errPoolClosed = errors.New("smtp pool is closed")
+
+type smtpConn struct {
+	client   *smtp.Client
+	conn     net.Conn
+	lastUsed time.Time
+}
+
+// smtpPool keeps up to size authenticated connections, idle ones for at most idleTimeout
+type smtpPool struct {
+	addr        string
+	host        string
+	username    string
+	password    string
+	tlsMode     TLSMode
+	tlsConfig   *tls.Config
+	timeout     time.Duration
+	idleTimeout time.Duration
+
+	slots chan struct{}
+	idle  chan *smtpConn
+	done  chan struct{}
+}
+
+func newSMTPPool(opts SMTPOptions) *smtpPool {
+	tlsConfig := opts.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = opts.Host
+	}
+
+	return &smtpPool{
+		addr:        net.JoinHostPort(opts.Host, opts.Port),
+		host:        opts.Host,
+		username:    opts.Username,
+		password:    opts.Password,
+		tlsMode:     opts.TLSMode,
+		tlsConfig:   tlsConfig,
+		timeout:     opts.Timeout,
+		idleTimeout: opts.IdleTimeout,
+		slots:       make(chan struct{}, opts.PoolSize),
+		idle:        make(chan *smtpConn, opts.PoolSize),
+		done:        make(chan struct{}),
+	}
+}
+
+// get returns a connection and whether it was reused; the caller must hand it back with put
+func (p *smtpPool) get(ctx context.Context) (*smtpConn, bool, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-p.done:
+		return nil, false, errPoolClosed
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+
+	for {
+		select {
+		case c := <-p.idle:
+			if time.Since(c.lastUsed) > p.idleTimeout {
+				c.close()
+				continue
+			}
+			return c, true, nil
+		default:
+		}
+
+		c, err := p.dial(ctx)
+		if err != nil {
+			<-p.slots
+			return nil, false, err
+		}
+		return c, false, nil
+	}
+}
+
+// put returns a healthy connection to the idle set and closes a broken one
+func (p *smtpPool) put(c *smtpConn, healthy bool) {
+	defer func() { <-p.slots }()
+
+	if healthy {
+		c.lastUsed = time.Now()
+		select {
+		case <-p.done:
+		case p.idle <- c:
+			return
+		default:
+		}
+	}
+	c.close()
+}
+
+func (p *smtpPool) dial(ctx context.Context) (*smtpConn, error) {
+	dialer := &net.Dialer{Timeout: p.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &smtpConn{conn: conn}
+	err = c.do(ctx, p.timeout, func() error {
+		if p.tlsMode == TLSModeImplicit {
+			tlsConn := tls.Client(conn, p.tlsConfig)
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				return err
+			}
+			c.conn = tlsConn
+		}
+
+		client, err := smtp.NewClient(c.conn, p.host)
+		if err != nil {
+			return err
+		}
+		c.client = client
+
+		if p.tlsMode == TLSModeSTARTTLS {
+			if ok, _ := client.Extension("STARTTLS"); !ok {
+				return errors.New("server does not support STARTTLS")
+			}
+			if err := client.StartTLS(p.tlsConfig); err != nil {
+				return err
+			}
+		}
+
+		if p.username != "" {
+			if ok, _ := client.Extension("AUTH"); !ok {
+				return errors.New("server does not support AUTH")
+			}
+			return client.Auth(smtp.PlainAuth("", p.username, p.password, p.host))
+		}
+		return nil
+	})
+	if err != nil {
+		c.close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// close drains idle connections; connections in use are closed as they are returned
+func (p *smtpPool) close() {
+	select {
+	case <-p.done:
+		return
+	default:
+		close(p.done)
+	}
+
+	for {
+		select {
+		case c := <-p.idle:
+			_ = c.do(context.Background(), p.timeout, c.client.Quit)
+			c.close()
+		default:
+			return
+		}
+	}
+}
+
+// do runs fn under the tighter of ctx and timeout; cancelling ctx interrupts blocked I/O
+func (c *smtpConn) do(ctx context.Context, timeout time.Duration, fn func() error) error {
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	// A cancellation that lands after fn completed changes nothing: the next call resets the deadline
+	if err := fn(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *smtpConn) close() {
+	if c.client != nil {
+		_ = c.client.Close()
+		return
+	}
+	_ = c.conn.Close()
+}
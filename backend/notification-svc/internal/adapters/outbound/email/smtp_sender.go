@@ -2,56 +2,193 @@ package email
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
-	"net/smtp"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
 
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
 	"github.com/diploma/notification-svc/internal/domain/notification/port"
 	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
 )
 
+type SMTPOptions struct {
+	Host     string // "" or "stub" logs emails instead of sending them
+	Port     string
+	Username string // Empty skips AUTH
+	Password string
+	From     string // Address, optionally with a display name: "SportsApp <notifications@sportsapp.com>"
+
+	TLSMode   TLSMode
+	TLSConfig *tls.Config // Optional; ServerName defaults to Host
+
+	Timeout       time.Duration // Per SMTP exchange, on top of the caller's context
+	PoolSize      int
+	IdleTimeout   time.Duration
+	RatePerSecond int // 0 disables rate limiting
+	RateBurst     int
+}
+
 type SMTPSender struct {
-	host     string
-	port     string
-	username string
-	password string
-	from     string
+	from    *mail.Address
+	stub    bool
+	pool    *smtpPool
+	limiter *rateLimiter
+	timeout time.Duration
 }
 
-func NewSMTPSender(host, port, username, password, from string) *SMTPSender {
-	return &SMTPSender{
-		host:     host,
-		port:     port,
-		username: username,
-		password: password,
-		from:     from,
+func NewSMTPSender(opts SMTPOptions) (*SMTPSender, error) {
+	from, err := mail.ParseAddress(opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", opts.From, err)
+	}
+
+	sender := &SMTPSender{from: from}
+	if opts.Host == "" || opts.Host == "stub" {
+		sender.stub = true
+		return sender, nil
+	}
+
+	if opts.TLSMode == "" {
+		opts.TLSMode = TLSModeSTARTTLS
+	}
+	if !opts.TLSMode.IsValid() {
+		return nil, fmt.Errorf("invalid SMTP TLS mode %q", opts.TLSMode)
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 15 * time.Second
+	}
+	if opts.PoolSize <= 0 {
+		opts.PoolSize = 1
 	}
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = 30 * time.Second
+	}
+
+	sender.pool = newSMTPPool(opts)
+	sender.limiter = newRateLimiter(opts.RatePerSecond, opts.RateBurst)
+	sender.timeout = opts.Timeout
+	return sender, nil
+}
+
+// From is the sender address stamped on every email
+func (s *SMTPSender) From() *mail.Address {
+	return s.from
+}
+
+func (s *SMTPSender) Channel() entity.Channel {
+	return entity.ChannelEmail
 }
 
-func (s *SMTPSender) SendEmail(ctx context.Context, notification port.EmailNotification) error {
-	if s.host == "" || s.host == "stub" {
+func (s *SMTPSender) Send(ctx context.Context, recipient *port.Recipient, notificationType string, message *port.Message) (string, error) {
+	return s.SendEmail(ctx, port.EmailNotification{
+		To:          recipient.Email,
+		ToName:      recipient.FullName,
+		Subject:     message.Subject,
+		Body:        message.Text,
+		HTMLBody:    message.HTML,
+		Attachments: message.Attachments,
+	})
+}
+
+// SendEmail returns the Message-ID it stamped on the email, which is what a bounce or a mail log refers back to
+func (s *SMTPSender) SendEmail(ctx context.Context, notification port.EmailNotification) (string, error) {
+	messageID := fmt.Sprintf("<%s@%s>", uuid.NewString(), domainOf(s.from.Address))
+	data, err := BuildMessage(Envelope{From: s.from, MessageID: messageID, Date: time.Now()}, notification)
+	if err != nil {
+		return "", err
+	}
+
+	if s.stub {
 		log.Printf("📧 [STUB] Email to %s: %s - %s", notification.To, notification.Subject, notification.Body)
-		return nil
+		return "", nil
 	}
 
-	auth := smtp.PlainAuth("", s.username, s.password, s.host)
+	if err := s.limiter.Wait(ctx); err != nil {
+		return "", pkgerrors.NewExternalAPIError("failed to send email", err)
+	}
+
+	if err := s.deliver(ctx, notification.To, data); err != nil {
+		var reply *textproto.Error
+		if errors.As(err, &reply) && reply.Code >= 500 {
+			// Permanent rejection (unknown mailbox, policy); retrying will not help
+			return "", pkgerrors.NewInvalidArgumentError(fmt.Sprintf("email to %s rejected: %d %s", notification.To, reply.Code, reply.Msg))
+		}
+		return "", pkgerrors.NewExternalAPIError("failed to send email", err)
+	}
+
+	log.Printf("📧 Sent email to %s: %s", notification.To, notification.Subject)
+	return messageID, nil
+}
 
-	message := fmt.Sprintf("From: %s\r\n", s.from)
-	message += fmt.Sprintf("To: %s\r\n", notification.To)
-	message += fmt.Sprintf("Subject: %s\r\n", notification.Subject)
-	if notification.IsHTML {
-		message += "Content-Type: text/html; charset=UTF-8\r\n"
+// deliver sends over a pooled connection, retrying once on a fresh one when a reused
+// connection turns out to have been dropped by the server while idle
+func (s *SMTPSender) deliver(ctx context.Context, to string, data []byte) error {
+	for attempt := 0; ; attempt++ {
+		c, reused, err := s.pool.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = c.do(ctx, s.timeout, func() error {
+			return transmit(c, s.from.Address, to, data)
+		})
+		s.pool.put(c, s.settle(ctx, c, err))
+
+		var reply *textproto.Error
+		if err == nil || errors.As(err, &reply) || !reused || attempt > 0 || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+func transmit(c *smtpConn, from, to string, data []byte) error {
+	if err := c.client.Mail(from); err != nil {
+		return err
+	}
+	if err := c.client.Rcpt(to); err != nil {
+		return err
 	}
-	message += "\r\n"
-	message += notification.Body
 
-	addr := fmt.Sprintf("%s:%s", s.host, s.port)
-	err := smtp.SendMail(addr, auth, s.from, []string{notification.To}, []byte(message))
+	w, err := c.client.Data()
 	if err != nil {
-		return pkgerrors.NewExternalAPIError("failed to send email", err)
+		return err
 	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return w.Close()
+}
 
-	log.Printf("📧 Sent email to %s: %s", notification.To, notification.Subject)
-	return nil
+// settle reports whether the connection can be reused: a server rejection only needs the
+// transaction reset, anything else (I/O errors, timeouts) leaves the session in an unknown state
+func (s *SMTPSender) settle(ctx context.Context, c *smtpConn, err error) bool {
+	if err == nil {
+		return true
+	}
+
+	var reply *textproto.Error
+	if !errors.As(err, &reply) || ctx.Err() != nil {
+		return false
+	}
+	return c.do(ctx, s.timeout, c.client.Reset) == nil
 }
 
+// Close quits idle connections; the sender must not be used afterwards
+func (s *SMTPSender) Close() {
+	if s.pool != nil {
+		s.pool.close()
+	}
+}
+
+func domainOf(address string) string {
+	if at := strings.LastIndex(address, "@"); at >= 0 && at < len(address)-1 {
+		return address[at+1:]
+	}
+	return "localhost"
+}
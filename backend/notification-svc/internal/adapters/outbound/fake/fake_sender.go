@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+)
+
+type Delivery struct {
+	Recipient port.Recipient
+	Type      string
+	Message   port.Message
+}
+
+// FakeSender stands in for an external channel: it logs and records deliveries instead of calling a provider
+type FakeSender struct {
+	channel entity.Channel
+
+	mu         sync.Mutex
+	deliveries []Delivery
+	err        error
+}
+
+func NewFakeSender(channel entity.Channel) *FakeSender {
+	return &FakeSender{
+		channel: channel,
+	}
+}
+
+func (s *FakeSender) Channel() entity.Channel {
+	return s.channel
+}
+
+func (s *FakeSender) Send(ctx context.Context, recipient *port.Recipient, notificationType string, message *port.Message) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return "", s.err
+	}
+
+	s.deliveries = append(s.deliveries, Delivery{Recipient: *recipient, Type: notificationType, Message: *message})
+	log.Printf("[FAKE %s] %s to user %s: %s", s.channel, notificationType, recipient.UserID, message.Short)
+	return fmt.Sprintf("fake-%s-%d", s.channel, len(s.deliveries)), nil
+}
+
+// FailWith makes every following Send return err; nil restores delivery
+func (s *FakeSender) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *FakeSender) Deliveries() []Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Delivery(nil), s.deliveries...)
+}
@@ -0,0 +1,39 @@
+package inapp
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// InAppSender stores notifications in the user's inbox, read back through ListNotifications
+type InAppSender struct {
+	notifications port.NotificationRepository
+}
+
+func NewInAppSender(notifications port.NotificationRepository) *InAppSender {
+	return &InAppSender{
+		notifications: notifications,
+	}
+}
+
+func (s *InAppSender) Channel() entity.Channel {
+	return entity.ChannelInApp
+}
+
+func (s *InAppSender) Send(ctx context.Context, recipient *port.Recipient, notificationType string, message *port.Message) (string, error) {
+	userID, err := uuid.Parse(recipient.UserID)
+	if err != nil {
+		return "", pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	notification := entity.NewNotification(userID, notificationType, message.Subject, message.Short)
+	if err := s.notifications.Create(ctx, notification); err != nil {
+		return "", err
+	}
+
+	return notification.ID.String(), nil
+}
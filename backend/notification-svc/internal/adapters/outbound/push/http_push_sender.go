@@ -0,0 +1,97 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+// HTTPPushSender posts FCM v1 style messages addressed to a per-user topic, so
+// devices subscribe to "<prefix><user_id>" and no token registry is needed here
+type HTTPPushSender struct {
+	endpoint    string
+	apiKey      string
+	topicPrefix string
+	client      *http.Client
+}
+
+func NewHTTPPushSender(endpoint, apiKey, topicPrefix string, timeout time.Duration) *HTTPPushSender {
+	return &HTTPPushSender{
+		endpoint:    endpoint,
+		apiKey:      apiKey,
+		topicPrefix: topicPrefix,
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+type pushRequest struct {
+	Message pushMessage `json:"message"`
+}
+
+type pushMessage struct {
+	Topic        string            `json:"topic"`
+	Notification pushNotification  `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type pushNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type pushResponse struct {
+	Name string `json:"name"`
+}
+
+func (s *HTTPPushSender) Channel() entity.Channel {
+	return entity.ChannelPush
+}
+
+func (s *HTTPPushSender) Send(ctx context.Context, recipient *port.Recipient, notificationType string, message *port.Message) (string, error) {
+	body, err := json.Marshal(pushRequest{
+		Message: pushMessage{
+			Topic: s.topicPrefix + recipient.UserID,
+			Notification: pushNotification{
+				Title: message.Subject,
+				Body:  message.Short,
+			},
+			Data: map[string]string{"type": notificationType},
+		},
+	})
+	if err != nil {
+		return "", pkgerrors.NewInternalError("failed to encode push message", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", pkgerrors.NewInternalError("failed to build push request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", pkgerrors.NewExternalAPIError("failed to send push notification", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", pkgerrors.NewExternalAPIError("failed to send push notification", fmt.Errorf("status %d: %s", resp.StatusCode, bytes.TrimSpace(detail)))
+	}
+
+	// FCM answers with the message resource name, e.g. "projects/app/messages/0:1500415314455276%31bd1c9631bd1c96"
+	var sent pushResponse
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&sent)
+	return sent.Name, nil
+}
@@ -0,0 +1,69 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/authz"
+	reservationv1 "github.com/diploma/notification-svc/api/proto/reservation/v1"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type ReservationClient struct {
+	client reservationv1.ReservationServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewReservationClient authenticates with the internal service key, since reservations are read
+// on behalf of users who are not part of the call
+func NewReservationClient(address, serviceKey string) (*ReservationClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(authz.ServiceKeyInterceptor(serviceKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservationClient{
+		client: reservationv1.NewReservationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *ReservationClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *ReservationClient) GetReservation(ctx context.Context, reservationID string) (*port.ReservationDetails, error) {
+	reservation, err := c.client.GetReservation(ctx, &reservationv1.GetReservationRequest{ReservationId: reservationID})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, pkgerrors.NewNotFoundError("reservation not found")
+		case codes.InvalidArgument:
+			return nil, pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+		default:
+			return nil, pkgerrors.NewExternalAPIError("failed to get reservation", err)
+		}
+	}
+
+	startTime, err := time.Parse(time.RFC3339, reservation.StartTime)
+	if err != nil {
+		return nil, pkgerrors.NewExternalAPIError("invalid reservation start_time", err)
+	}
+
+	endTime, err := time.Parse(time.RFC3339, reservation.EndTime)
+	if err != nil {
+		return nil, pkgerrors.NewExternalAPIError("invalid reservation end_time", err)
+	}
+
+	return &port.ReservationDetails{
+		ID:         reservation.Id,
+		ResourceID: reservation.ResourceId,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package session
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sessionv1 "github.com/diploma/notification-svc/api/proto/session/v1"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type SessionClient struct {
+	client sessionv1.SessionServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewSessionClient(address string) (*SessionClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionClient{
+		client: sessionv1.NewSessionServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *SessionClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *SessionClient) GetSession(ctx context.Context, sessionID string) (*port.SessionDetails, error) {
+	session, err := c.client.GetSession(ctx, &sessionv1.GetSessionRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, mapSessionError(err, "failed to get session")
+	}
+
+	var startTime time.Time
+	if session.StartTime != "" {
+		startTime, err = time.Parse(time.RFC3339, session.StartTime)
+		if err != nil {
+			return nil, pkgerrors.NewExternalAPIError("invalid session start_time", err)
+		}
+	}
+
+	var endTime time.Time
+	if session.EndTime != "" {
+		endTime, err = time.Parse(time.RFC3339, session.EndTime)
+		if err != nil {
+			return nil, pkgerrors.NewExternalAPIError("invalid session end_time", err)
+		}
+	}
+
+	return &port.SessionDetails{
+		ID:                  session.Id,
+		HostID:              session.HostId,
+		SportType:           session.SportType,
+		SkillLevel:          session.SkillLevel,
+		Description:         session.Description,
+		Status:              strings.TrimPrefix(session.Status.String(), "SESSION_STATUS_"),
+		PricePerParticipant: session.PricePerParticipant,
+		MaxParticipants:     int(session.MaxParticipants),
+		MinParticipants:     int(session.MinParticipants),
+		CurrentParticipants: int(session.CurrentParticipants),
+		StartTime:           startTime,
+		EndTime:             endTime,
+		ReservationID:       session.ReservationId,
+	}, nil
+}
+
+func (c *SessionClient) ListParticipantIDs(ctx context.Context, sessionID string) ([]string, error) {
+	response, err := c.client.ListSessionParticipants(ctx, &sessionv1.ListSessionParticipantsRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, mapSessionError(err, "failed to list session participants")
+	}
+
+	userIDs := make([]string, 0, len(response.Participants))
+	for _, participant := range response.Participants {
+		if participant.Status != sessionv1.ParticipantStatus_PARTICIPANT_STATUS_JOINED {
+			continue
+		}
+		userIDs = append(userIDs, participant.UserId)
+	}
+
+	return userIDs, nil
+}
+
+func mapSessionError(err error, message string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return pkgerrors.NewNotFoundError("session not found")
+	case codes.InvalidArgument:
+		return pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+	default:
+		return pkgerrors.NewExternalAPIError(message, err)
+	}
+}
@@ -0,0 +1,86 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+// HTTPSMSGateway sends {"from","to","text"} JSON to a generic SMS gateway endpoint
+type HTTPSMSGateway struct {
+	endpoint string
+	apiKey   string
+	from     string
+	client   *http.Client
+}
+
+func NewHTTPSMSGateway(endpoint, apiKey, from string, timeout time.Duration) *HTTPSMSGateway {
+	return &HTTPSMSGateway{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		from:     from,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+type smsRequest struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+type smsResponse struct {
+	ID        string `json:"id"`
+	MessageID string `json:"message_id"`
+}
+
+func (g *HTTPSMSGateway) Channel() entity.Channel {
+	return entity.ChannelSMS
+}
+
+func (g *HTTPSMSGateway) Send(ctx context.Context, recipient *port.Recipient, notificationType string, message *port.Message) (string, error) {
+	body, err := json.Marshal(smsRequest{
+		From: g.from,
+		To:   recipient.Phone,
+		Text: message.Short,
+	})
+	if err != nil {
+		return "", pkgerrors.NewInternalError("failed to encode sms", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", pkgerrors.NewInternalError("failed to build sms request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if g.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+g.apiKey)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", pkgerrors.NewExternalAPIError("failed to send sms", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", pkgerrors.NewExternalAPIError("failed to send sms", fmt.Errorf("status %d: %s", resp.StatusCode, bytes.TrimSpace(detail)))
+	}
+
+	// Gateways differ on the field name; an unparseable body still means the message was accepted
+	var sent smsResponse
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&sent)
+	if sent.MessageID != "" {
+		return sent.MessageID, nil
+	}
+	return sent.ID, nil
+}
@@ -0,0 +1,82 @@
+package templates
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type localeFormat struct {
+	dateTime string
+	time     string
+	decimal  string
+	hours    [3]string // one, few, many
+	minutes  [3]string
+	plural   func(n int) int
+}
+
+var localeFormats = map[string]localeFormat{
+	"en": {
+		dateTime: "Mon, Jan 2, 2006 at 15:04 MST",
+		time:     "15:04",
+		decimal:  ".",
+		hours:    [3]string{"hour", "hours", "hours"},
+		minutes:  [3]string{"minute", "minutes", "minutes"},
+		plural:   englishPlural,
+	},
+	"ru": {
+		dateTime: "02.01.2006 в 15:04 MST",
+		time:     "15:04",
+		decimal:  ",",
+		hours:    [3]string{"час", "часа", "часов"},
+		minutes:  [3]string{"минуту", "минуты", "минут"},
+		plural:   russianPlural,
+	},
+}
+
+func englishPlural(n int) int {
+	if n == 1 {
+		return 0
+	}
+	return 2
+}
+
+func russianPlural(n int) int {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return 1
+	}
+	return 2
+}
+
+func (r *Renderer) funcs(locale string) map[string]interface{} {
+	format, ok := localeFormats[locale]
+	if !ok {
+		format = localeFormats["en"] // Override locales without their own formats
+	}
+
+	return map[string]interface{}{
+		"datetime": func(t time.Time) string {
+			return t.In(r.location).Format(format.dateTime)
+		},
+		"time": func(t time.Time) string {
+			return t.In(r.location).Format(format.time)
+		},
+		// duration renders a lead time as whole hours, or minutes below an hour, e.g. "24 hours"
+		"duration": func(d time.Duration) string {
+			d = d.Round(time.Minute)
+			if d >= time.Hour {
+				n := int(d.Round(time.Hour) / time.Hour)
+				return strconv.Itoa(n) + " " + format.hours[format.plural(n)]
+			}
+			n := int(d / time.Minute)
+			return strconv.Itoa(n) + " " + format.minutes[format.plural(n)]
+		},
+		"money": func(amount float64, currency string) string {
+			value := strconv.FormatFloat(amount, 'f', 2, 64)
+			return strings.Replace(value, ".", format.decimal, 1) + " " + currency
+		},
+	}
+}
@@ -0,0 +1,245 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+//go:embed defaults
+var defaults embed.FS
+
+const (
+	layoutFile   = "layout.html"
+	textPartials = "partials.txt"
+	htmlPartials = "partials.html"
+)
+
+// Renderer loads <locale>/<name>.subject.txt, <name>.short.txt, <name>.txt and optionally <name>.html;
+// files in the override directory shadow the embedded defaults one by one
+type Renderer struct {
+	fsys          fs.FS
+	defaultLocale string
+	location      *time.Location
+
+	mu       sync.RWMutex
+	compiled map[string]*compiledTemplate
+}
+
+type compiledTemplate struct {
+	subject *texttemplate.Template
+	short   *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+func NewRenderer(overrideDir, defaultLocale string, location *time.Location) (*Renderer, error) {
+	embedded, err := fs.Sub(defaults, "defaults")
+	if err != nil {
+		return nil, err
+	}
+
+	var fsys fs.FS = embedded
+	if overrideDir != "" {
+		fsys = overlayFS{primary: os.DirFS(overrideDir), fallback: embedded}
+	}
+
+	renderer := &Renderer{
+		fsys:          fsys,
+		defaultLocale: defaultLocale,
+		location:      location,
+		compiled:      make(map[string]*compiledTemplate),
+	}
+
+	if _, ok := localeFormats[defaultLocale]; !ok {
+		return nil, fmt.Errorf("unsupported default locale %q", defaultLocale)
+	}
+
+	// Fail at startup rather than on the first event if an override does not parse
+	for _, locale := range renderer.Locales() {
+		for _, name := range renderer.Names() {
+			if _, err := renderer.compile(locale, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return renderer, nil
+}
+
+// Locales lists the locales that have templates
+func (r *Renderer) Locales() []string {
+	locales := make([]string, 0, len(localeFormats))
+	for locale := range localeFormats {
+		if _, err := fs.Stat(r.fsys, locale); err == nil {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Names lists the templates available in the default locale
+func (r *Renderer) Names() []string {
+	matches, _ := fs.Glob(r.fsys, path.Join(r.defaultLocale, "*.subject.txt"))
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		names = append(names, strings.TrimSuffix(path.Base(match), ".subject.txt"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Renderer) Render(name, locale string, data port.TemplateData) (*port.Message, error) {
+	tmpl, err := r.compile(r.resolveLocale(locale, name), name)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, short, text, html bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return nil, pkgerrors.NewInternalError("failed to render subject of "+name, err)
+	}
+	if err := tmpl.short.Execute(&short, data); err != nil {
+		return nil, pkgerrors.NewInternalError("failed to render summary of "+name, err)
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return nil, pkgerrors.NewInternalError("failed to render text of "+name, err)
+	}
+	if tmpl.html != nil {
+		if err := tmpl.html.ExecuteTemplate(&html, layoutFile, data); err != nil {
+			return nil, pkgerrors.NewInternalError("failed to render html of "+name, err)
+		}
+	}
+
+	return &port.Message{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Short:   strings.Join(strings.Fields(short.String()), " "),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+// resolveLocale falls back from "ru-RU" to "ru" to the default locale
+func (r *Renderer) resolveLocale(locale, name string) string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	candidates := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, base)
+	}
+
+	for _, candidate := range candidates {
+		if _, ok := localeFormats[candidate]; !ok {
+			continue
+		}
+		if _, err := fs.Stat(r.fsys, path.Join(candidate, name+".subject.txt")); err == nil {
+			return candidate
+		}
+	}
+	return r.defaultLocale
+}
+
+func (r *Renderer) compile(locale, name string) (*compiledTemplate, error) {
+	key := locale + "/" + name
+
+	r.mu.RLock()
+	tmpl, ok := r.compiled[key]
+	r.mu.RUnlock()
+	if ok {
+		return tmpl, nil
+	}
+
+	funcs := r.funcs(locale)
+	base := path.Join(locale, name)
+
+	subject, err := r.parseText(funcs, base+".subject.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	short, err := r.parseText(funcs, base+".short.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := r.parseText(funcs, path.Join(locale, textPartials), base+".txt")
+	if err != nil {
+		return nil, err
+	}
+
+	var html *htmltemplate.Template
+	if _, err := fs.Stat(r.fsys, base+".html"); err == nil {
+		html, err = htmltemplate.New(layoutFile).Funcs(htmltemplate.FuncMap(funcs)).
+			ParseFS(r.fsys, layoutFile, path.Join(locale, htmlPartials), base+".html")
+		if err != nil {
+			return nil, pkgerrors.NewInternalError("failed to parse "+base+".html", err)
+		}
+	}
+
+	tmpl = &compiledTemplate{subject: subject, short: short, text: text, html: html}
+
+	r.mu.Lock()
+	r.compiled[key] = tmpl
+	r.mu.Unlock()
+
+	return tmpl, nil
+}
+
+func (r *Renderer) parseText(funcs map[string]interface{}, files ...string) (*texttemplate.Template, error) {
+	root := files[len(files)-1]
+	tmpl, err := texttemplate.New(path.Base(root)).Funcs(funcs).ParseFS(r.fsys, files...)
+	if err != nil {
+		return nil, pkgerrors.NewInternalError("failed to parse "+root, err)
+	}
+	return tmpl, nil
+}
+
+type overlayFS struct {
+	primary  fs.FS
+	fallback fs.FS
+}
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	file, err := o.primary.Open(name)
+	if err == nil {
+		return file, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return o.fallback.Open(name)
+}
+
+func (o overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	fallback, fallbackErr := fs.ReadDir(o.fallback, name)
+	primary, primaryErr := fs.ReadDir(o.primary, name)
+	if primaryErr != nil && fallbackErr != nil {
+		return nil, primaryErr
+	}
+
+	seen := make(map[string]bool, len(primary))
+	entries := append([]fs.DirEntry{}, primary...)
+	for _, entry := range primary {
+		seen[entry.Name()] = true
+	}
+	for _, entry := range fallback {
+		if !seen[entry.Name()] {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
@@ -0,0 +1,66 @@
+package venue
+
+import (
+	"context"
+
+	venuev1 "github.com/diploma/notification-svc/api/proto/venue/v1"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type VenueClient struct {
+	client venuev1.VenueServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewVenueClient(address string) (*VenueClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &VenueClient{
+		client: venuev1.NewVenueServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *VenueClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *VenueClient) GetResourceVenue(ctx context.Context, resourceID string) (*port.VenueDetails, error) {
+	resource, err := c.client.GetResource(ctx, &venuev1.GetResourceRequest{ResourceId: resourceID})
+	if err != nil {
+		return nil, mapVenueError(err, "resource not found", "failed to get resource")
+	}
+
+	venue, err := c.client.GetVenue(ctx, &venuev1.GetVenueRequest{VenueId: resource.VenueId})
+	if err != nil {
+		return nil, mapVenueError(err, "venue not found", "failed to get venue")
+	}
+
+	return &port.VenueDetails{
+		VenueName:    venue.Name,
+		ResourceName: resource.Name,
+		Address:      venue.Address,
+		City:         venue.City,
+		Latitude:     venue.Latitude,
+		Longitude:    venue.Longitude,
+	}, nil
+}
+
+func mapVenueError(err error, notFound, message string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return pkgerrors.NewNotFoundError(notFound)
+	case codes.InvalidArgument:
+		return pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+	default:
+		return pkgerrors.NewExternalAPIError(message, err)
+	}
+}
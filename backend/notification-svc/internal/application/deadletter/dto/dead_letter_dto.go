@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+type DeadLetterOutput struct {
+	Sequence       uint64
+	Subject        string
+	Payload        string
+	Error          string
+	Deliveries     int
+	Stream         string
+	StreamSequence uint64
+	FailedAt       time.Time
+}
+
+type ListDeadLettersInput struct {
+	Subject       string
+	AfterSequence uint64
+	Limit         int
+}
+
+type ListDeadLettersOutput struct {
+	DeadLetters  []DeadLetterOutput
+	NextSequence uint64
+}
+
+type ReplayDeadLetterInput struct {
+	Sequence uint64
+}
+
+type ReplayDeadLetterOutput struct {
+	Success bool
+}
+
+type DeleteDeadLetterInput struct {
+	Sequence uint64
+}
+
+type DeleteDeadLetterOutput struct {
+	Success bool
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/application/deadletter/dto"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+type DeleteDeadLetterUseCase struct {
+	deadLetters port.DeadLetterQueue
+}
+
+func NewDeleteDeadLetterUseCase(deadLetters port.DeadLetterQueue) *DeleteDeadLetterUseCase {
+	return &DeleteDeadLetterUseCase{
+		deadLetters: deadLetters,
+	}
+}
+
+func (uc *DeleteDeadLetterUseCase) Execute(ctx context.Context, input dto.DeleteDeadLetterInput) (*dto.DeleteDeadLetterOutput, error) {
+	if input.Sequence == 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("sequence is required")
+	}
+
+	if err := uc.deadLetters.Delete(ctx, input.Sequence); err != nil {
+		return nil, err
+	}
+
+	return &dto.DeleteDeadLetterOutput{
+		Success: true,
+	}, nil
+}
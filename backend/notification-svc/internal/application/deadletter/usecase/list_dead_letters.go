@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/application/deadletter/dto"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/port"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+type ListDeadLettersUseCase struct {
+	deadLetters port.DeadLetterQueue
+}
+
+func NewListDeadLettersUseCase(deadLetters port.DeadLetterQueue) *ListDeadLettersUseCase {
+	return &ListDeadLettersUseCase{
+		deadLetters: deadLetters,
+	}
+}
+
+func (uc *ListDeadLettersUseCase) Execute(ctx context.Context, input dto.ListDeadLettersInput) (*dto.ListDeadLettersOutput, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	letters, err := uc.deadLetters.List(ctx, input.Subject, input.AfterSequence, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &dto.ListDeadLettersOutput{
+		DeadLetters: make([]dto.DeadLetterOutput, 0, len(letters)),
+	}
+	for _, letter := range letters {
+		output.DeadLetters = append(output.DeadLetters, dto.DeadLetterOutput{
+			Sequence:       letter.Sequence,
+			Subject:        letter.Subject,
+			Payload:        string(letter.Data),
+			Error:          letter.Error,
+			Deliveries:     letter.Deliveries,
+			Stream:         letter.Stream,
+			StreamSequence: letter.StreamSequence,
+			FailedAt:       letter.FailedAt,
+		})
+	}
+
+	if len(letters) == limit {
+		output.NextSequence = letters[len(letters)-1].Sequence
+	}
+
+	return output, nil
+}
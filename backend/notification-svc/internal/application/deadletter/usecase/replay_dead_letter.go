@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/diploma/notification-svc/internal/application/deadletter/dto"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+type ReplayDeadLetterUseCase struct {
+	deadLetters port.DeadLetterQueue
+	republisher port.EventRepublisher
+}
+
+func NewReplayDeadLetterUseCase(deadLetters port.DeadLetterQueue, republisher port.EventRepublisher) *ReplayDeadLetterUseCase {
+	return &ReplayDeadLetterUseCase{
+		deadLetters: deadLetters,
+		republisher: republisher,
+	}
+}
+
+func (uc *ReplayDeadLetterUseCase) Execute(ctx context.Context, input dto.ReplayDeadLetterInput) (*dto.ReplayDeadLetterOutput, error) {
+	if input.Sequence == 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("sequence is required")
+	}
+
+	letter, err := uc.deadLetters.Get(ctx, input.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(letter.Data) == 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("dead letter kept no payload to replay")
+	}
+
+	if err := uc.republisher.Republish(ctx, letter.Subject, letter.Data); err != nil {
+		return nil, err
+	}
+
+	// A failed delete only leaves a stale copy behind; the event itself is back in its stream
+	if err := uc.deadLetters.Delete(ctx, input.Sequence); err != nil {
+		log.Printf("Replayed dead letter %d but failed to remove it: %v", input.Sequence, err)
+	}
+
+	log.Printf("Replayed dead letter %d to %s", input.Sequence, letter.Subject)
+
+	return &dto.ReplayDeadLetterOutput{
+		Success: true,
+	}, nil
+}
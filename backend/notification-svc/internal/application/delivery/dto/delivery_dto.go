@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+)
+
+type FlushDeferredInput struct {
+	BatchSize int
+}
+
+type FlushDeferredOutput struct {
+	Fetched int
+	Settled int // Sent, or dropped as undeliverable
+	Retried int
+}
+
+type DeliveryOutput struct {
+	ID                string
+	EventID           string
+	UserID            string
+	Channel           string
+	Type              string
+	Status            string
+	Attempts          int
+	ProviderMessageID string
+	LastError         string
+	SentAt            *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type ListDeliveriesInput struct {
+	EventID string
+	UserID  string
+	Channel string
+	Status  string
+	Limit   int
+	Offset  int
+}
+
+type ListDeliveriesOutput struct {
+	Deliveries []DeliveryOutput
+	Total      int64
+}
+
+func ToDeliveryOutput(record *entity.DeliveryRecord) DeliveryOutput {
+	return DeliveryOutput{
+		ID:                record.ID.String(),
+		EventID:           record.EventID,
+		UserID:            record.UserID,
+		Channel:           string(record.Channel),
+		Type:              record.Type,
+		Status:            string(record.Status),
+		Attempts:          record.Attempts,
+		ProviderMessageID: record.ProviderMessageID,
+		LastError:         record.LastError,
+		SentAt:            record.SentAt,
+		CreatedAt:         record.CreatedAt,
+		UpdatedAt:         record.UpdatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/application/delivery/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	"github.com/google/uuid"
+)
+
+type FlushDeferredUseCase struct {
+	notificationService *service.NotificationService
+	deferred            port.DeferredDeliveryRepository
+	txManager           TransactionManager
+	retryDelay          time.Duration
+	maxAttempts         int
+}
+
+func NewFlushDeferredUseCase(
+	notificationService *service.NotificationService,
+	deferred port.DeferredDeliveryRepository,
+	txManager TransactionManager,
+	retryDelay time.Duration,
+	maxAttempts int,
+) *FlushDeferredUseCase {
+	return &FlushDeferredUseCase{
+		notificationService: notificationService,
+		deferred:            deferred,
+		txManager:           txManager,
+		retryDelay:          retryDelay,
+		maxAttempts:         maxAttempts,
+	}
+}
+
+// Execute sends one batch of due deliveries; the rows stay locked until the batch is settled
+func (uc *FlushDeferredUseCase) Execute(ctx context.Context, input dto.FlushDeferredInput) (*dto.FlushDeferredOutput, error) {
+	output := &dto.FlushDeferredOutput{}
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		due, err := uc.deferred.FetchDue(ctx, now, input.BatchSize)
+		if err != nil {
+			return err
+		}
+		output.Fetched = len(due)
+		if len(due) == 0 {
+			return nil
+		}
+
+		done, retry := uc.notificationService.DeliverDeferred(ctx, due)
+
+		attempts := make(map[uuid.UUID]int, len(due))
+		for _, delivery := range due {
+			attempts[delivery.ID] = delivery.Attempts + 1
+		}
+		var keep []uuid.UUID
+		for _, id := range retry {
+			if attempts[id] >= uc.maxAttempts {
+				log.Printf("Giving up on deferred notification %s after %d attempts", id, attempts[id])
+				done = append(done, id)
+				continue
+			}
+			keep = append(keep, id)
+		}
+		retry = keep
+		if len(done) > 0 {
+			if err := uc.deferred.Delete(ctx, done); err != nil {
+				return err
+			}
+		}
+		if len(retry) > 0 {
+			if err := uc.deferred.Reschedule(ctx, retry, now.Add(uc.retryDelay)); err != nil {
+				return err
+			}
+		}
+
+		output.Settled = len(done)
+		output.Retried = len(retry)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to flush deferred notifications: %w", err)
+	}
+
+	return output, nil
+}
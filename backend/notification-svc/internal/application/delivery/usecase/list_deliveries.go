@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/application/delivery/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+type ListDeliveriesUseCase struct {
+	deliveries port.DeliveryLogRepository
+}
+
+func NewListDeliveriesUseCase(deliveries port.DeliveryLogRepository) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{
+		deliveries: deliveries,
+	}
+}
+
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, input dto.ListDeliveriesInput) (*dto.ListDeliveriesOutput, error) {
+	if input.EventID == "" && input.UserID == "" {
+		return nil, pkgerrors.NewInvalidArgumentError("event_id or user_id is required")
+	}
+	channel := entity.Channel(input.Channel)
+	if channel != "" && !channel.IsValid() {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid channel")
+	}
+	status := entity.DeliveryStatus(input.Status)
+	if status != "" && !status.IsValid() {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid status")
+	}
+	if input.Offset < 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("offset must not be negative")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	records, total, err := uc.deliveries.List(ctx, port.DeliveryLogFilter{
+		EventID: input.EventID,
+		UserID:  input.UserID,
+		Channel: channel,
+		Status:  status,
+		Limit:   limit,
+		Offset:  input.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &dto.ListDeliveriesOutput{
+		Deliveries: make([]dto.DeliveryOutput, 0, len(records)),
+		Total:      total,
+	}
+	for _, record := range records {
+		output.Deliveries = append(output.Deliveries, dto.ToDeliveryOutput(record))
+	}
+
+	return output, nil
+}
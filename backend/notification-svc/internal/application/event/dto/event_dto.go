@@ -1,72 +1,27 @@
 package dto
 
-type ReservationCreatedEvent struct {
-	ReservationID string `json:"reservation_id"`
-	UserID        string `json:"user_id"`
-	ApartmentID   string `json:"apartment_id"`
-	ReservedAt    string `json:"reserved_at"`
-}
-
-type ReservationConfirmedEvent struct {
-	ReservationID string `json:"reservation_id"`
-	UserID        string `json:"user_id"`
-}
-
-type ReservationCancelledEvent struct {
-	ReservationID string `json:"reservation_id"`
-	UserID        string `json:"user_id"`
-}
-
-type SessionCreatedEvent struct {
-	SessionID     string `json:"session_id"`
-	ReservationID string `json:"reservation_id"`
-	HostID        string `json:"host_id"`
-}
-
-type SessionJoinedEvent struct {
-	SessionID           string `json:"session_id"`
-	UserID              string `json:"user_id"`
-	CurrentParticipants int    `json:"current_participants"`
-}
-
-type SessionFullEvent struct {
-	SessionID string `json:"session_id"`
-}
-
-type SessionCancelledEvent struct {
-	SessionID string `json:"session_id"`
-}
-
-type SessionLeftEvent struct {
-	SessionID string `json:"session_id"`
-	UserID    string `json:"user_id"`
-}
-
-type PaymentCreatedEvent struct {
-	PaymentID string  `json:"payment_id"`
-	SessionID string  `json:"session_id"`
-	UserID    string  `json:"user_id"`
-	Amount    float64 `json:"amount"`
-}
-
-type PaymentSucceededEvent struct {
-	PaymentID string  `json:"payment_id"`
-	SessionID string  `json:"session_id"`
-	UserID    string  `json:"user_id"`
-	Amount    float64 `json:"amount"`
-}
-
-type PaymentFailedEvent struct {
-	PaymentID string `json:"payment_id"`
-	SessionID string `json:"session_id"`
-	UserID    string `json:"user_id"`
-	Reason    string `json:"reason"`
-}
-
-type PaymentRefundedEvent struct {
-	PaymentID string `json:"payment_id"`
-	SessionID string `json:"session_id"`
-	UserID    string `json:"user_id"`
-	RefundID  string `json:"refund_id"`
-}
-
+import sharedevents "github.com/diploma/events"
+
+// Event payloads are owned by the shared events module so producers and
+// consumers cannot drift apart
+type (
+	ReservationCreatedEvent   = sharedevents.ReservationCreated
+	ReservationConfirmedEvent = sharedevents.ReservationConfirmed
+	ReservationCancelledEvent = sharedevents.ReservationCancelled
+	ReservationExpiredEvent   = sharedevents.ReservationExpired
+
+	SessionCreatedEvent   = sharedevents.SessionCreated
+	SessionJoinedEvent    = sharedevents.SessionJoined
+	SessionFullEvent      = sharedevents.SessionFull
+	SessionCancelledEvent = sharedevents.SessionCancelled
+	SessionLeftEvent      = sharedevents.SessionLeft
+
+	PaymentCreatedEvent   = sharedevents.PaymentCreated
+	PaymentSucceededEvent = sharedevents.PaymentSucceeded
+	PaymentFailedEvent    = sharedevents.PaymentFailed
+	PaymentRefundedEvent  = sharedevents.PaymentRefunded
+
+	UserEmailVerificationRequestedEvent = sharedevents.UserEmailVerificationRequested
+	UserPasswordResetRequestedEvent     = sharedevents.UserPasswordResetRequested
+	UserPasswordChangedEvent            = sharedevents.UserPasswordChanged
+)
@@ -2,33 +2,41 @@ package handler
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/diploma/notification-svc/internal/application/event/dto"
 	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
 )
 
 type PaymentEventHandler struct {
-	notificationSender port.NotificationSender
+	notifications *service.NotificationService
+	details       *service.EventDetailsService
 }
 
-func NewPaymentEventHandler(notificationSender port.NotificationSender) *PaymentEventHandler {
+func NewPaymentEventHandler(notifications *service.NotificationService, details *service.EventDetailsService) *PaymentEventHandler {
 	return &PaymentEventHandler{
-		notificationSender: notificationSender,
+		notifications: notifications,
+		details:       details,
 	}
 }
 
 func (h *PaymentEventHandler) HandlePaymentCreated(ctx context.Context, event dto.PaymentCreatedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "Payment Initiated",
-		Body:    fmt.Sprintf("Your payment of $%.2f for session %s has been initiated.", event.Amount, event.SessionID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for payment created notification: %v", event.SessionID, err)
+		return err
+	}
+
+	data := port.TemplateData{
+		Session:   session,
+		PaymentID: event.PaymentID,
+		Amount:    event.Amount,
+		Currency:  event.Currency, // Empty on v1 events, which then get the configured currency
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send payment created email: %v", err)
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplatePaymentCreated, data); err != nil {
+		log.Printf("Failed to send payment created notification: %v", err)
 		return err
 	}
 
@@ -37,15 +45,21 @@ func (h *PaymentEventHandler) HandlePaymentCreated(ctx context.Context, event dt
 }
 
 func (h *PaymentEventHandler) HandlePaymentSucceeded(ctx context.Context, event dto.PaymentSucceededEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "Payment Successful",
-		Body:    fmt.Sprintf("Your payment of $%.2f for session %s was successful!", event.Amount, event.SessionID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for payment succeeded notification: %v", event.SessionID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send payment succeeded email: %v", err)
+	data := port.TemplateData{
+		Session:   session,
+		PaymentID: event.PaymentID,
+		Amount:    event.Amount,
+		Currency:  event.Currency, // Empty on v1 events, which then get the configured currency
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplatePaymentSucceeded, data); err != nil {
+		log.Printf("Failed to send payment succeeded notification: %v", err)
 		return err
 	}
 
@@ -54,15 +68,20 @@ func (h *PaymentEventHandler) HandlePaymentSucceeded(ctx context.Context, event
 }
 
 func (h *PaymentEventHandler) HandlePaymentFailed(ctx context.Context, event dto.PaymentFailedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "Payment Failed",
-		Body:    fmt.Sprintf("Your payment for session %s failed. Reason: %s. Please try again.", event.SessionID, event.Reason),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for payment failed notification: %v", event.SessionID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send payment failed email: %v", err)
+	data := port.TemplateData{
+		Session:   session,
+		PaymentID: event.PaymentID,
+		Reason:    event.Reason,
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplatePaymentFailed, data); err != nil {
+		log.Printf("Failed to send payment failed notification: %v", err)
 		return err
 	}
 
@@ -71,19 +90,23 @@ func (h *PaymentEventHandler) HandlePaymentFailed(ctx context.Context, event dto
 }
 
 func (h *PaymentEventHandler) HandlePaymentRefunded(ctx context.Context, event dto.PaymentRefundedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "Payment Refunded",
-		Body:    fmt.Sprintf("Your payment for session %s has been refunded. Refund ID: %s", event.SessionID, event.RefundID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for payment refunded notification: %v", event.SessionID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send payment refunded email: %v", err)
+	data := port.TemplateData{
+		Session:   session,
+		PaymentID: event.PaymentID,
+		RefundID:  event.RefundID,
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplatePaymentRefunded, data); err != nil {
+		log.Printf("Failed to send payment refunded notification: %v", err)
 		return err
 	}
 
 	log.Printf("Sent payment refunded notification to user %s", event.UserID)
 	return nil
 }
-
@@ -2,33 +2,39 @@ package handler
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/diploma/notification-svc/internal/application/event/dto"
 	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
 )
 
 type ReservationEventHandler struct {
-	notificationSender port.NotificationSender
+	notifications *service.NotificationService
+	details       *service.EventDetailsService
 }
 
-func NewReservationEventHandler(notificationSender port.NotificationSender) *ReservationEventHandler {
+func NewReservationEventHandler(notifications *service.NotificationService, details *service.EventDetailsService) *ReservationEventHandler {
 	return &ReservationEventHandler{
-		notificationSender: notificationSender,
+		notifications: notifications,
+		details:       details,
 	}
 }
 
 func (h *ReservationEventHandler) HandleReservationCreated(ctx context.Context, event dto.ReservationCreatedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID), // TODO: Lookup actual email from auth-svc
-		Subject: "Reservation Created",
-		Body:    fmt.Sprintf("Your reservation %s has been created for %s.", event.ReservationID, event.ReservedAt),
-		IsHTML:  false,
+	reservation, err := h.details.ReservationDetails(ctx, event.ReservationID)
+	if err != nil {
+		log.Printf("Failed to load reservation %s for reservation created notification: %v", event.ReservationID, err)
+		return err
+	}
+
+	data := port.TemplateData{
+		Reservation:   reservation,
+		ReservationID: event.ReservationID,
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send reservation created email: %v", err)
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateReservationCreated, data); err != nil {
+		log.Printf("Failed to send reservation created notification: %v", err)
 		return err
 	}
 
@@ -37,15 +43,19 @@ func (h *ReservationEventHandler) HandleReservationCreated(ctx context.Context,
 }
 
 func (h *ReservationEventHandler) HandleReservationConfirmed(ctx context.Context, event dto.ReservationConfirmedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "Reservation Confirmed",
-		Body:    fmt.Sprintf("Your reservation %s has been confirmed!", event.ReservationID),
-		IsHTML:  false,
+	reservation, err := h.details.ReservationDetails(ctx, event.ReservationID)
+	if err != nil {
+		log.Printf("Failed to load reservation %s for reservation confirmed notification: %v", event.ReservationID, err)
+		return err
+	}
+
+	data := port.TemplateData{
+		Reservation:   reservation,
+		ReservationID: event.ReservationID,
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send reservation confirmed email: %v", err)
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateReservationConfirmed, data); err != nil {
+		log.Printf("Failed to send reservation confirmed notification: %v", err)
 		return err
 	}
 
@@ -54,15 +64,19 @@ func (h *ReservationEventHandler) HandleReservationConfirmed(ctx context.Context
 }
 
 func (h *ReservationEventHandler) HandleReservationCancelled(ctx context.Context, event dto.ReservationCancelledEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "Reservation Cancelled",
-		Body:    fmt.Sprintf("Your reservation %s has been cancelled.", event.ReservationID),
-		IsHTML:  false,
+	reservation, err := h.details.ReservationDetails(ctx, event.ReservationID)
+	if err != nil {
+		log.Printf("Failed to load reservation %s for reservation cancelled notification: %v", event.ReservationID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send reservation cancelled email: %v", err)
+	data := port.TemplateData{
+		Reservation:   reservation,
+		ReservationID: event.ReservationID,
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateReservationCancelled, data); err != nil {
+		log.Printf("Failed to send reservation cancelled notification: %v", err)
 		return err
 	}
 
@@ -70,3 +84,23 @@ func (h *ReservationEventHandler) HandleReservationCancelled(ctx context.Context
 	return nil
 }
 
+func (h *ReservationEventHandler) HandleReservationExpired(ctx context.Context, event dto.ReservationExpiredEvent) error {
+	reservation, err := h.details.ReservationDetails(ctx, event.ReservationID)
+	if err != nil {
+		log.Printf("Failed to load reservation %s for reservation expired notification: %v", event.ReservationID, err)
+		return err
+	}
+
+	data := port.TemplateData{
+		Reservation:   reservation,
+		ReservationID: event.ReservationID,
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateReservationExpired, data); err != nil {
+		log.Printf("Failed to send reservation expired notification: %v", err)
+		return err
+	}
+
+	log.Printf("Sent reservation expired notification to user %s", event.UserID)
+	return nil
+}
@@ -2,33 +2,50 @@ package handler
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/diploma/notification-svc/internal/application/event/dto"
 	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
 )
 
 type SessionEventHandler struct {
-	notificationSender port.NotificationSender
+	notifications *service.NotificationService
+	details       *service.EventDetailsService
+	reminders     *service.ReminderService
 }
 
-func NewSessionEventHandler(notificationSender port.NotificationSender) *SessionEventHandler {
+func NewSessionEventHandler(notifications *service.NotificationService, details *service.EventDetailsService, reminders *service.ReminderService) *SessionEventHandler {
 	return &SessionEventHandler{
-		notificationSender: notificationSender,
+		notifications: notifications,
+		details:       details,
+		reminders:     reminders,
 	}
 }
 
 func (h *SessionEventHandler) HandleSessionCreated(ctx context.Context, event dto.SessionCreatedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.HostID),
-		Subject: "Session Created",
-		Body:    fmt.Sprintf("Your game session %s has been created!", event.SessionID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for session created notification: %v", event.SessionID, err)
+		return err
+	}
+
+	// Scheduling is idempotent, so it goes first and a redelivery after a failed send cannot lose reminders
+	startTime := event.StartTime
+	if startTime.IsZero() {
+		startTime = session.StartTime
+	}
+	if err := h.reminders.ScheduleSession(ctx, event.SessionID, event.HostID, startTime); err != nil {
+		log.Printf("Failed to schedule reminders for session %s: %v", event.SessionID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send session created email: %v", err)
+	data := port.TemplateData{
+		Session: session,
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.HostID, port.TemplateSessionCreated, data); err != nil {
+		log.Printf("Failed to send session created notification: %v", err)
 		return err
 	}
 
@@ -37,15 +54,19 @@ func (h *SessionEventHandler) HandleSessionCreated(ctx context.Context, event dt
 }
 
 func (h *SessionEventHandler) HandleSessionJoined(ctx context.Context, event dto.SessionJoinedEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "You Joined a Session",
-		Body:    fmt.Sprintf("You have successfully joined session %s!", event.SessionID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for session joined notification: %v", event.SessionID, err)
+		return err
+	}
+
+	data := port.TemplateData{
+		Session:      session,
+		Participants: event.CurrentParticipants,
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send session joined email: %v", err)
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateSessionJoined, data); err != nil {
+		log.Printf("Failed to send session joined notification: %v", err)
 		return err
 	}
 
@@ -54,53 +75,64 @@ func (h *SessionEventHandler) HandleSessionJoined(ctx context.Context, event dto
 }
 
 func (h *SessionEventHandler) HandleSessionFull(ctx context.Context, event dto.SessionFullEvent) error {
-	notification := port.EmailNotification{
-		To:      "participants@example.com", // TODO: Get all participant emails
-		Subject: "Session is Full",
-		Body:    fmt.Sprintf("Session %s is now full and ready to start!", event.SessionID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for session full notification: %v", event.SessionID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send session full email: %v", err)
+	sent, err := h.notifications.NotifySessionParticipants(ctx, event.SessionID, port.TemplateSessionFull, port.TemplateData{
+		Session: session,
+	})
+	if err != nil {
+		log.Printf("Failed to send session full notification: %v", err)
 		return err
 	}
 
-	log.Printf("Sent session full notification for session %s", event.SessionID)
+	log.Printf("Sent session full notification to %d participants of session %s", sent, event.SessionID)
 	return nil
 }
 
 func (h *SessionEventHandler) HandleSessionCancelled(ctx context.Context, event dto.SessionCancelledEvent) error {
-	notification := port.EmailNotification{
-		To:      "participants@example.com", // TODO: Get all participant emails
-		Subject: "Session Cancelled",
-		Body:    fmt.Sprintf("Session %s has been cancelled.", event.SessionID),
-		IsHTML:  false,
+	if err := h.reminders.CancelSession(ctx, event.SessionID); err != nil {
+		log.Printf("Failed to cancel reminders for session %s: %v", event.SessionID, err)
+		return err
+	}
+
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for session cancelled notification: %v", event.SessionID, err)
+		return err
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send session cancelled email: %v", err)
+	sent, err := h.notifications.NotifySessionParticipants(ctx, event.SessionID, port.TemplateSessionCancelled, port.TemplateData{
+		Session: session,
+	})
+	if err != nil {
+		log.Printf("Failed to send session cancelled notification: %v", err)
 		return err
 	}
 
-	log.Printf("Sent session cancelled notification for session %s", event.SessionID)
+	log.Printf("Sent session cancelled notification to %d participants of session %s", sent, event.SessionID)
 	return nil
 }
 
 func (h *SessionEventHandler) HandleSessionLeft(ctx context.Context, event dto.SessionLeftEvent) error {
-	notification := port.EmailNotification{
-		To:      fmt.Sprintf("user-%s@example.com", event.UserID),
-		Subject: "You Left the Session",
-		Body:    fmt.Sprintf("You have left session %s.", event.SessionID),
-		IsHTML:  false,
+	session, err := h.details.SessionDetails(ctx, event.SessionID)
+	if err != nil {
+		log.Printf("Failed to load session %s for session left notification: %v", event.SessionID, err)
+		return err
+	}
+
+	data := port.TemplateData{
+		Session: session,
 	}
 
-	if err := h.notificationSender.SendEmail(ctx, notification); err != nil {
-		log.Printf("Failed to send session left email: %v", err)
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateSessionLeft, data); err != nil {
+		log.Printf("Failed to send session left notification: %v", err)
 		return err
 	}
 
 	log.Printf("Sent session left notification to user %s", event.UserID)
 	return nil
 }
-
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/notification-svc/internal/application/event/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+// UserEventHandler sends the account emails auth-svc asks for; the links open pages of the web app.
+// The tokens arrive sealed and are opened only to build the link.
+type UserEventHandler struct {
+	notifications *service.NotificationService
+	sealer        *sharedevents.Sealer
+	appURL        string
+}
+
+func NewUserEventHandler(notifications *service.NotificationService, sealer *sharedevents.Sealer, appURL string) *UserEventHandler {
+	return &UserEventHandler{
+		notifications: notifications,
+		sealer:        sealer,
+		appURL:        appURL,
+	}
+}
+
+func (h *UserEventHandler) HandleEmailVerificationRequested(ctx context.Context, event dto.UserEmailVerificationRequestedEvent) error {
+	link, err := h.link("/verify-email", sharedevents.SubjectUserEmailVerificationRequested, event.SealedToken)
+	if err != nil {
+		return err
+	}
+	data := port.TemplateData{
+		Link:      link,
+		ExpiresIn: time.Until(event.ExpiresAt),
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateAccountEmailVerification, data); err != nil {
+		log.Printf("Failed to send email verification to user %s: %v", event.UserID, err)
+		return err
+	}
+
+	log.Printf("Sent email verification to user %s", event.UserID)
+	return nil
+}
+
+func (h *UserEventHandler) HandlePasswordResetRequested(ctx context.Context, event dto.UserPasswordResetRequestedEvent) error {
+	link, err := h.link("/reset-password", sharedevents.SubjectUserPasswordResetRequested, event.SealedToken)
+	if err != nil {
+		return err
+	}
+	data := port.TemplateData{
+		Link:      link,
+		ExpiresIn: time.Until(event.ExpiresAt),
+	}
+
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateAccountPasswordReset, data); err != nil {
+		log.Printf("Failed to send password reset to user %s: %v", event.UserID, err)
+		return err
+	}
+
+	log.Printf("Sent password reset to user %s", event.UserID)
+	return nil
+}
+
+func (h *UserEventHandler) HandlePasswordChanged(ctx context.Context, event dto.UserPasswordChangedEvent) error {
+	if err := h.notifications.NotifyUser(ctx, event.UserID, port.TemplateAccountPasswordChanged, port.TemplateData{}); err != nil {
+		log.Printf("Failed to send password changed notification to user %s: %v", event.UserID, err)
+		return err
+	}
+
+	log.Printf("Sent password changed notification to user %s", event.UserID)
+	return nil
+}
+
+func (h *UserEventHandler) link(path, subject, sealedToken string) (string, error) {
+	token, err := h.sealer.Open(subject, sealedToken)
+	if err != nil {
+		// Redelivery cannot fix a token sealed with another key; the user can ask for a new link
+		return "", pkgerrors.NewInvalidArgumentError(fmt.Sprintf("%s token: %v", subject, err))
+	}
+	return h.appURL + path + "?token=" + url.QueryEscape(token), nil
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+)
+
+type NotificationOutput struct {
+	ID        string
+	UserID    string
+	Type      string
+	Title     string
+	Body      string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+type ListNotificationsInput struct {
+	UserID     string
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+type ListNotificationsOutput struct {
+	Notifications []NotificationOutput
+	Total         int64
+	UnreadCount   int64
+}
+
+type MarkReadInput struct {
+	UserID          string
+	NotificationIDs []string
+	All             bool
+}
+
+type MarkReadOutput struct {
+	Updated     int64
+	UnreadCount int64
+}
+
+func ToNotificationOutput(notification *entity.Notification) NotificationOutput {
+	return NotificationOutput{
+		ID:        notification.ID.String(),
+		UserID:    notification.UserID.String(),
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/application/inbox/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+type ListNotificationsUseCase struct {
+	notifications port.NotificationRepository
+}
+
+func NewListNotificationsUseCase(notifications port.NotificationRepository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{
+		notifications: notifications,
+	}
+}
+
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, input dto.ListNotificationsInput) (*dto.ListNotificationsOutput, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+	if input.Offset < 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("offset must not be negative")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	notifications, total, err := uc.notifications.ListByUser(ctx, userID, input.UnreadOnly, limit, input.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := uc.notifications.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &dto.ListNotificationsOutput{
+		Notifications: make([]dto.NotificationOutput, 0, len(notifications)),
+		Total:         total,
+		UnreadCount:   unread,
+	}
+	for _, notification := range notifications {
+		output.Notifications = append(output.Notifications, dto.ToNotificationOutput(notification))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/application/inbox/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type MarkReadUseCase struct {
+	notifications port.NotificationRepository
+}
+
+func NewMarkReadUseCase(notifications port.NotificationRepository) *MarkReadUseCase {
+	return &MarkReadUseCase{
+		notifications: notifications,
+	}
+}
+
+func (uc *MarkReadUseCase) Execute(ctx context.Context, input dto.MarkReadInput) (*dto.MarkReadOutput, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+	if !input.All && len(input.NotificationIDs) == 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("notification_ids or all is required")
+	}
+
+	now := time.Now()
+	var updated int64
+	if input.All {
+		updated, err = uc.notifications.MarkAllRead(ctx, userID, now)
+	} else {
+		ids := make([]uuid.UUID, 0, len(input.NotificationIDs))
+		for _, raw := range input.NotificationIDs {
+			id, parseErr := uuid.Parse(raw)
+			if parseErr != nil {
+				return nil, pkgerrors.NewInvalidArgumentError("invalid notification id: " + raw)
+			}
+			ids = append(ids, id)
+		}
+		// Scoped by user_id, so ids belonging to someone else are silently ignored
+		updated, err = uc.notifications.MarkRead(ctx, userID, ids, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := uc.notifications.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MarkReadOutput{
+		Updated:     updated,
+		UnreadCount: unread,
+	}, nil
+}
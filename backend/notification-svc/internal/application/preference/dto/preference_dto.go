@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+)
+
+type ChannelPreference struct {
+	Category string
+	Channel  string
+	Enabled  bool
+	Delivery string
+}
+
+type PreferencesOutput struct {
+	UserID          string
+	Timezone        string
+	QuietHoursStart string
+	QuietHoursEnd   string
+	DigestHour      int
+	Channels        []ChannelPreference
+	UpdatedAt       time.Time
+}
+
+type GetPreferencesInput struct {
+	UserID string
+}
+
+type UpdatePreferencesInput struct {
+	UserID          string
+	Timezone        string
+	QuietHoursStart string
+	QuietHoursEnd   string
+	DigestHour      int
+	Channels        []ChannelPreference
+}
+
+// ToPreferencesOutput expands the stored overrides into the full category × channel matrix
+func ToPreferencesOutput(preferences *entity.Preferences) *PreferencesOutput {
+	matrix := preferences.Matrix()
+	channels := make([]ChannelPreference, 0, len(matrix))
+	for _, rule := range matrix {
+		channels = append(channels, ChannelPreference{
+			Category: string(rule.Category),
+			Channel:  string(rule.Channel),
+			Enabled:  rule.Enabled,
+			Delivery: string(rule.Delivery),
+		})
+	}
+
+	return &PreferencesOutput{
+		UserID:          preferences.UserID.String(),
+		Timezone:        preferences.Timezone,
+		QuietHoursStart: preferences.QuietHoursStart,
+		QuietHoursEnd:   preferences.QuietHoursEnd,
+		DigestHour:      preferences.DigestHour,
+		Channels:        channels,
+		UpdatedAt:       preferences.UpdatedAt,
+	}
+}
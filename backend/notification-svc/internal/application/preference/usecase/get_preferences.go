@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/application/preference/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type GetPreferencesUseCase struct {
+	preferenceService *service.PreferenceService
+}
+
+func NewGetPreferencesUseCase(preferenceService *service.PreferenceService) *GetPreferencesUseCase {
+	return &GetPreferencesUseCase{
+		preferenceService: preferenceService,
+	}
+}
+
+func (uc *GetPreferencesUseCase) Execute(ctx context.Context, input dto.GetPreferencesInput) (*dto.PreferencesOutput, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	preferences, err := uc.preferenceService.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToPreferencesOutput(preferences), nil
+}
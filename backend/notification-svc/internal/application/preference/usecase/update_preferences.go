@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/application/preference/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type UpdatePreferencesUseCase struct {
+	preferenceService *service.PreferenceService
+}
+
+func NewUpdatePreferencesUseCase(preferenceService *service.PreferenceService) *UpdatePreferencesUseCase {
+	return &UpdatePreferencesUseCase{
+		preferenceService: preferenceService,
+	}
+}
+
+// Execute replaces the user's preferences; rules left out fall back to enabled and immediate
+func (uc *UpdatePreferencesUseCase) Execute(ctx context.Context, input dto.UpdatePreferencesInput) (*dto.PreferencesOutput, error) {
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return nil, pkgerrors.NewInvalidArgumentError("invalid user_id")
+	}
+
+	preferences := &entity.Preferences{
+		UserID:          userID,
+		Timezone:        input.Timezone,
+		QuietHoursStart: input.QuietHoursStart,
+		QuietHoursEnd:   input.QuietHoursEnd,
+		DigestHour:      input.DigestHour,
+		Channels:        make([]entity.ChannelPreference, 0, len(input.Channels)),
+	}
+	for _, rule := range input.Channels {
+		delivery := entity.DeliveryMode(rule.Delivery)
+		if delivery == "" {
+			delivery = entity.DeliveryImmediate
+		}
+		preferences.Channels = append(preferences.Channels, entity.ChannelPreference{
+			Category: entity.Category(rule.Category),
+			Channel:  entity.Channel(rule.Channel),
+			Enabled:  rule.Enabled,
+			Delivery: delivery,
+		})
+	}
+
+	if err := uc.preferenceService.UpdatePreferences(ctx, preferences); err != nil {
+		return nil, err
+	}
+
+	return dto.ToPreferencesOutput(preferences), nil
+}
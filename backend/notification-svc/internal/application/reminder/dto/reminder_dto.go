@@ -0,0 +1,11 @@
+package dto
+
+type SendDueRemindersInput struct {
+	BatchSize int
+}
+
+type SendDueRemindersOutput struct {
+	Fetched int
+	Settled int // Sent, moot, or dropped as undeliverable
+	Retried int
+}
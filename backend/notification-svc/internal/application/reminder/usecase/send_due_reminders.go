@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/application/reminder/dto"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+)
+
+type SendDueRemindersUseCase struct {
+	reminderService *service.ReminderService
+	reminders       port.ReminderRepository
+	claimTimeout    time.Duration
+	retryDelay      time.Duration
+	maxAttempts     int
+}
+
+func NewSendDueRemindersUseCase(
+	reminderService *service.ReminderService,
+	reminders port.ReminderRepository,
+	claimTimeout time.Duration,
+	retryDelay time.Duration,
+	maxAttempts int,
+) *SendDueRemindersUseCase {
+	return &SendDueRemindersUseCase{
+		reminderService: reminderService,
+		reminders:       reminders,
+		claimTimeout:    claimTimeout,
+		retryDelay:      retryDelay,
+		maxAttempts:     maxAttempts,
+	}
+}
+
+// Execute claims one batch of due reminders, sends them with no transaction open and settles each
+// on its own; a claim left behind by a crashed replica lapses after claimTimeout
+func (uc *SendDueRemindersUseCase) Execute(ctx context.Context, input dto.SendDueRemindersInput) (*dto.SendDueRemindersOutput, error) {
+	now := time.Now()
+	due, err := uc.reminders.ClaimDue(ctx, now, now.Add(uc.claimTimeout), input.BatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+
+	output := &dto.SendDueRemindersOutput{Fetched: len(due)}
+	for _, reminder := range due {
+		if !uc.reminderService.Send(ctx, reminder, now) {
+			if reminder.Attempts < uc.maxAttempts {
+				if err := uc.reminders.Reschedule(ctx, reminder.ID, time.Now().Add(uc.retryDelay)); err != nil {
+					log.Printf("Failed to reschedule reminder %s: %v", reminder.ID, err)
+					continue
+				}
+				output.Retried++
+				continue
+			}
+			log.Printf("Giving up on reminder %s after %d attempts", reminder.ID, reminder.Attempts)
+		}
+
+		// A failed settle leaves the claim to lapse; the delivery log keeps the retry from resending
+		if err := uc.reminders.MarkSent(ctx, reminder.ID, time.Now()); err != nil {
+			log.Printf("Failed to settle reminder %s: %v", reminder.ID, err)
+			continue
+		}
+		output.Settled++
+	}
+
+	return output, nil
+}
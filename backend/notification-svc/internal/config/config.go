@@ -1,47 +1,294 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	NATSConfig NATSConfig
-	SMTPConfig SMTPConfig
+	GRPCPort              string
+	AuthServiceURL        string
+	SessionServiceURL     string
+	ReservationServiceURL string
+	VenueServiceURL       string
+	DBConfig              DatabaseConfig
+	NATSConfig            NATSConfig
+	JetStreamConfig       JetStreamConfig
+	RecipientConfig       RecipientConfig
+	TemplateConfig        TemplateConfig
+	SMTPConfig            SMTPConfig
+	PushConfig            PushConfig
+	SMSConfig             SMSConfig
+	Routes                string
+	DeferredConfig        DeferredConfig
+	ReminderConfig        ReminderConfig
+	JWT                   JWTConfig
 }
 
-type NATSConfig struct {
-	URL string
+// JWTConfig locates the public keys auth-svc signs access tokens with
+type JWTConfig struct {
+	JWKSURL     string
+	Issuer      string
+	KeyCacheTTL time.Duration
+	// ServiceKey is the shared internal key for calls made without a user's token
+	ServiceKey string
 }
 
-type SMTPConfig struct {
+type DatabaseConfig struct {
 	Host     string
 	Port     string
-	Username string
+	User     string
 	Password string
-	From     string
+	DBName   string
+	SSLMode  string
+}
+
+type NATSConfig struct {
+	URL string
+}
+
+type JetStreamConfig struct {
+	ConsumerName   string
+	MaxDeliver     int
+	AckWait        time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	StreamMaxAge   time.Duration
+	DLQMaxAge      time.Duration
+}
+
+type RecipientConfig struct {
+	CacheTTL  time.Duration
+	CacheSize int
+}
+
+type TemplateConfig struct {
+	Dir           string
+	DefaultLocale string
+	Timezone      string
+	Currency      string
+	AppURL        string // Links in account emails open pages of this web app
+	TokenSealKey  string // Opens the account tokens auth-svc seals into its events
+}
+
+type SMTPConfig struct {
+	Host          string
+	Port          string
+	Username      string
+	Password      string
+	From          string
+	TLSMode       string
+	Timeout       time.Duration
+	PoolSize      int
+	IdleTimeout   time.Duration
+	RatePerSecond int
+	RateBurst     int
+}
+
+type DeferredConfig struct {
+	FlushInterval time.Duration
+	BatchSize     int
+	RetryDelay    time.Duration
+	MaxAttempts   int
+}
+
+// ParticipantLeads are how long before a session starts its participants are reminded;
+// the host is warned HostWarningLead before start if MinParticipants has not been reached
+type ReminderConfig struct {
+	ParticipantLeads []time.Duration
+	HostWarningLead  time.Duration
+	PollInterval     time.Duration
+	BatchSize        int
+	ClaimTimeout     time.Duration
+	RetryDelay       time.Duration
+	MaxAttempts      int
+}
+
+// Provider is "http" for the real gateway, "fake" for a logging stand-in, or empty to disable the channel
+type PushConfig struct {
+	Provider    string
+	Endpoint    string
+	APIKey      string
+	TopicPrefix string
+	Timeout     time.Duration
+}
+
+type SMSConfig struct {
+	Provider   string
+	GatewayURL string
+	APIKey     string
+	From       string
+	Timeout    time.Duration
 }
 
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
 	cfg := &Config{
+		GRPCPort:              getEnv("GRPC_PORT", "50056"),
+		AuthServiceURL:        getEnv("AUTH_SERVICE_URL", "localhost:50051"),
+		SessionServiceURL:     getEnv("SESSION_SERVICE_URL", "localhost:50054"),
+		ReservationServiceURL: getEnv("RESERVATION_SERVICE_URL", "localhost:50052"),
+		VenueServiceURL:       getEnv("VENUE_SERVICE_URL", "localhost:50053"),
+		DBConfig: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnv("DB_PORT", "5432"),
+			User:     getEnv("DB_USER", "postgres"),
+			Password: getEnv("DB_PASSWORD", "postgres"),
+			DBName:   getEnv("DB_NAME", "notification_db"),
+			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+		},
 		NATSConfig: NATSConfig{
 			URL: getEnv("NATS_URL", "nats://localhost:4222"),
 		},
+		JWT: JWTConfig{
+			JWKSURL:     getEnv("JWKS_URL", "http://localhost:8080/.well-known/jwks.json"),
+			Issuer:      getEnv("JWT_ISSUER", "auth-svc"),
+			KeyCacheTTL: getEnvAsDuration("JWT_KEY_CACHE_TTL", 10*time.Minute),
+			ServiceKey:  getEnv("INTERNAL_SERVICE_KEY", ""),
+		},
+		JetStreamConfig: JetStreamConfig{
+			ConsumerName:   getEnv("NOTIFY_CONSUMER_NAME", "notification-svc"),
+			MaxDeliver:     getEnvAsInt("NOTIFY_MAX_DELIVER", 6),
+			AckWait:        getEnvAsDuration("NOTIFY_ACK_WAIT", 30*time.Second),
+			RetryBaseDelay: getEnvAsDuration("NOTIFY_RETRY_BASE_DELAY", 2*time.Second),
+			RetryMaxDelay:  getEnvAsDuration("NOTIFY_RETRY_MAX_DELAY", 5*time.Minute),
+			StreamMaxAge:   getEnvAsDuration("EVENT_STREAM_MAX_AGE", 7*24*time.Hour),
+			DLQMaxAge:      getEnvAsDuration("DLQ_MAX_AGE", 30*24*time.Hour),
+		},
+		RecipientConfig: RecipientConfig{
+			CacheTTL:  getEnvAsDuration("RECIPIENT_CACHE_TTL", 10*time.Minute),
+			CacheSize: getEnvAsInt("RECIPIENT_CACHE_SIZE", 10000),
+		},
+		TemplateConfig: TemplateConfig{
+			Dir:           getEnv("NOTIFY_TEMPLATE_DIR", ""),
+			DefaultLocale: getEnv("NOTIFY_DEFAULT_LOCALE", "en"),
+			Timezone:      getEnv("NOTIFY_TIMEZONE", "UTC"),
+			Currency:      getEnv("NOTIFY_CURRENCY", "USD"),
+			AppURL:        strings.TrimRight(getEnv("APP_URL", "http://localhost:3000"), "/"),
+			TokenSealKey:  getEnv("EVENT_SEAL_KEY", ""),
+		},
 		SMTPConfig: SMTPConfig{
 			Host:     getEnv("SMTP_HOST", "stub"), // Default to stub for development
 			Port:     getEnv("SMTP_PORT", "587"),
 			Username: getEnv("SMTP_USERNAME", ""),
 			Password: getEnv("SMTP_PASSWORD", ""),
 			From:     getEnv("SMTP_FROM", "notifications@sportsapp.com"),
+			// "starttls" (submission, port 587), "tls" (implicit TLS, port 465) or "none" (local relays only)
+			TLSMode:       getEnv("SMTP_TLS_MODE", "starttls"),
+			Timeout:       getEnvAsDuration("SMTP_TIMEOUT", 15*time.Second),
+			PoolSize:      getEnvAsInt("SMTP_POOL_SIZE", 4),
+			IdleTimeout:   getEnvAsDuration("SMTP_IDLE_TIMEOUT", 30*time.Second),
+			RatePerSecond: getEnvAsInt("SMTP_RATE_PER_SECOND", 0), // 0 disables the limit
+			RateBurst:     getEnvAsInt("SMTP_RATE_BURST", 1),
 		},
+		PushConfig: PushConfig{
+			Provider:    getEnv("NOTIFY_PUSH_PROVIDER", "fake"),
+			Endpoint:    getEnv("PUSH_ENDPOINT", ""),
+			APIKey:      getEnv("PUSH_API_KEY", ""),
+			TopicPrefix: getEnv("PUSH_TOPIC_PREFIX", "user-"),
+			Timeout:     getEnvAsDuration("PUSH_TIMEOUT", 10*time.Second),
+		},
+		SMSConfig: SMSConfig{
+			Provider:   getEnv("NOTIFY_SMS_PROVIDER", "fake"),
+			GatewayURL: getEnv("SMS_GATEWAY_URL", ""),
+			APIKey:     getEnv("SMS_API_KEY", ""),
+			From:       getEnv("SMS_FROM", ""),
+			Timeout:    getEnvAsDuration("SMS_TIMEOUT", 10*time.Second),
+		},
+		Routes: getEnv("NOTIFY_ROUTES", ""),
+		DeferredConfig: DeferredConfig{
+			FlushInterval: getEnvAsDuration("NOTIFY_DEFERRED_FLUSH_INTERVAL", time.Minute),
+			BatchSize:     getEnvAsInt("NOTIFY_DEFERRED_BATCH_SIZE", 100),
+			RetryDelay:    getEnvAsDuration("NOTIFY_DEFERRED_RETRY_DELAY", 5*time.Minute),
+			MaxAttempts:   getEnvAsInt("NOTIFY_DEFERRED_MAX_ATTEMPTS", 5),
+		},
+		ReminderConfig: ReminderConfig{
+			HostWarningLead: getEnvAsDuration("NOTIFY_HOST_WARNING_LEAD", 6*time.Hour),
+			PollInterval:    getEnvAsDuration("NOTIFY_REMINDER_POLL_INTERVAL", time.Minute),
+			BatchSize:       getEnvAsInt("NOTIFY_REMINDER_BATCH_SIZE", 100),
+			ClaimTimeout:    getEnvAsDuration("NOTIFY_REMINDER_CLAIM_TIMEOUT", 5*time.Minute),
+			RetryDelay:      getEnvAsDuration("NOTIFY_REMINDER_RETRY_DELAY", 5*time.Minute),
+			MaxAttempts:     getEnvAsInt("NOTIFY_REMINDER_MAX_ATTEMPTS", 5),
+		},
+	}
+
+	if cfg.TemplateConfig.TokenSealKey == "" {
+		return nil, fmt.Errorf("EVENT_SEAL_KEY must be set")
+	}
+
+	leads, err := parseDurations(getEnv("NOTIFY_REMINDER_LEADS", "24h,1h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid NOTIFY_REMINDER_LEADS: %w", err)
+	}
+	cfg.ReminderConfig.ParticipantLeads = leads
+
+	if err := validateProvider("NOTIFY_PUSH_PROVIDER", cfg.PushConfig.Provider, cfg.PushConfig.Endpoint, "PUSH_ENDPOINT"); err != nil {
+		return nil, err
+	}
+	if err := validateProvider("NOTIFY_SMS_PROVIDER", cfg.SMSConfig.Provider, cfg.SMSConfig.GatewayURL, "SMS_GATEWAY_URL"); err != nil {
+		return nil, err
 	}
 
 	return cfg, nil
 }
 
+func validateProvider(key, provider, endpoint, endpointKey string) error {
+	switch provider {
+	case "", "none", "fake":
+		return nil
+	case "http":
+		if endpoint == "" {
+			return fmt.Errorf("%s is required when %s=http", endpointKey, key)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown %s %q, expected http, fake or none", key, provider)
+	}
+}
+
+// parseDurations reads a comma-separated list such as "24h,1h"; "none" disables the list
+func parseDurations(value string) ([]time.Duration, error) {
+	if value == "none" {
+		return nil, nil
+	}
+
+	var durations []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("duration %q must be positive", part)
+		}
+		durations = append(durations, duration)
+	}
+
+	return durations, nil
+}
+
+func (c *DatabaseConfig) ConnectionString() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		c.User,
+		c.Password,
+		c.Host,
+		c.Port,
+		c.DBName,
+		c.SSLMode,
+	)
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -49,3 +296,26 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsInt(key string, defaultValue int) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
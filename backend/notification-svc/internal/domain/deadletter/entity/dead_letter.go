@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type DeadLetter struct {
+	Sequence       uint64
+	Subject        string
+	Data           []byte
+	Error          string
+	Deliveries     int
+	Stream         string
+	StreamSequence uint64
+	FailedAt       time.Time
+}
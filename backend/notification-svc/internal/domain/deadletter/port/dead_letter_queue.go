@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/domain/deadletter/entity"
+)
+
+type DeadLetterQueue interface {
+	Add(ctx context.Context, letter *entity.DeadLetter) error
+	List(ctx context.Context, subject string, afterSequence uint64, limit int) ([]*entity.DeadLetter, error)
+	Get(ctx context.Context, sequence uint64) (*entity.DeadLetter, error)
+	Delete(ctx context.Context, sequence uint64) error
+}
+
+type EventRepublisher interface {
+	Republish(ctx context.Context, subject string, data []byte) error
+}
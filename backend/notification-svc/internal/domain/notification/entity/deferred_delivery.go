@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeferredDelivery is a rendered message held back by quiet hours or a digest preference
+type DeferredDelivery struct {
+	ID           uuid.UUID
+	EventID      string
+	UserID       uuid.UUID
+	Channel      Channel
+	Type         string
+	Subject      string
+	Short        string
+	Text         string
+	HTML         string
+	Digest       bool
+	DeliverAfter time.Time
+	Attempts     int
+	CreatedAt    time.Time
+}
+
+func NewDeferredDelivery(eventID string, userID uuid.UUID, channel Channel, notificationType string, digest bool, deliverAfter time.Time) *DeferredDelivery {
+	return &DeferredDelivery{
+		ID:           uuid.New(),
+		EventID:      eventID,
+		UserID:       userID,
+		Channel:      channel,
+		Type:         notificationType,
+		Digest:       digest,
+		DeliverAfter: deliverAfter,
+		CreatedAt:    time.Now(),
+	}
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending is an attempt in flight, or one interrupted before its outcome was recorded
+	DeliveryStatusPending  DeliveryStatus = "pending"
+	DeliveryStatusSent     DeliveryStatus = "sent"
+	DeliveryStatusFailed   DeliveryStatus = "failed"
+	DeliveryStatusDeferred DeliveryStatus = "deferred"
+)
+
+func (s DeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryStatusPending, DeliveryStatusSent, DeliveryStatusFailed, DeliveryStatusDeferred:
+		return true
+	}
+	return false
+}
+
+// DeliveryRecord is the delivery log entry for one event, recipient, channel and notification type; together
+// they are its identity, since one event can notify the same recipient more than once
+type DeliveryRecord struct {
+	ID                uuid.UUID
+	EventID           string
+	UserID            string
+	Channel           Channel
+	Type              string
+	Status            DeliveryStatus
+	Attempts          int
+	ProviderMessageID string
+	LastError         string
+	SentAt            *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewDeliveryRecord(eventID, userID string, channel Channel, notificationType string) *DeliveryRecord {
+	now := time.Now()
+	return &DeliveryRecord{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		UserID:    userID,
+		Channel:   channel,
+		Type:      notificationType,
+		Status:    DeliveryStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r *DeliveryRecord) MarkSent(providerMessageID string) {
+	now := time.Now()
+	r.Status = DeliveryStatusSent
+	r.ProviderMessageID = providerMessageID
+	r.LastError = ""
+	r.SentAt = &now
+	r.UpdatedAt = now
+}
+
+func (r *DeliveryRecord) MarkFailed(err error) {
+	r.Status = DeliveryStatusFailed
+	r.LastError = err.Error()
+	r.UpdatedAt = time.Now()
+}
+
+func (r *DeliveryRecord) MarkDeferred() {
+	r.Status = DeliveryStatusDeferred
+	r.LastError = ""
+	r.UpdatedAt = time.Now()
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelPush  Channel = "push"
+	ChannelSMS   Channel = "sms"
+	ChannelInApp Channel = "in_app"
+)
+
+var Channels = []Channel{ChannelEmail, ChannelPush, ChannelSMS, ChannelInApp}
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelEmail, ChannelPush, ChannelSMS, ChannelInApp:
+		return true
+	}
+	return false
+}
+
+// Notification is an entry in a user's in-app inbox
+type Notification struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Type      string
+	Title     string
+	Body      string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+func NewNotification(userID uuid.UUID, notificationType, title, body string) *Notification {
+	return &Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      notificationType,
+		Title:     title,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
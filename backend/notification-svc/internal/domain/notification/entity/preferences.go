@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/diploma/clock"
+	"github.com/google/uuid"
+)
+
+// Category groups notification types the way users think about them; it is the type's prefix
+type Category string
+
+const (
+	CategoryReservation Category = "reservation"
+	CategorySession     Category = "session"
+	CategoryPayment     Category = "payment"
+)
+
+var Categories = []Category{CategoryReservation, CategorySession, CategoryPayment}
+
+func (c Category) IsValid() bool {
+	for _, category := range Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func CategoryOf(notificationType string) Category {
+	prefix, _, _ := strings.Cut(notificationType, "_")
+	return Category(prefix)
+}
+
+type DeliveryMode string
+
+const (
+	DeliveryImmediate DeliveryMode = "immediate"
+	DeliveryDigest    DeliveryMode = "digest"
+)
+
+func (m DeliveryMode) IsValid() bool {
+	return m == DeliveryImmediate || m == DeliveryDigest
+}
+
+// ChannelPreference overrides the default (enabled, immediate) for one category on one channel
+type ChannelPreference struct {
+	Category Category
+	Channel  Channel
+	Enabled  bool
+	Delivery DeliveryMode
+}
+
+type Preferences struct {
+	UserID          uuid.UUID
+	Timezone        string
+	QuietHoursStart string // "HH:MM" local time, empty when quiet hours are off
+	QuietHoursEnd   string
+	DigestHour      int
+	Channels        []ChannelPreference
+	UpdatedAt       time.Time
+}
+
+func DefaultPreferences(userID uuid.UUID, timezone string) *Preferences {
+	return &Preferences{
+		UserID:     userID,
+		Timezone:   timezone,
+		DigestHour: 8,
+	}
+}
+
+func (p *Preferences) Rule(category Category, channel Channel) ChannelPreference {
+	for _, rule := range p.Channels {
+		if rule.Category == category && rule.Channel == channel {
+			return rule
+		}
+	}
+	return ChannelPreference{Category: category, Channel: channel, Enabled: true, Delivery: DeliveryImmediate}
+}
+
+// Matrix lists the effective rule for every category and channel, defaults included
+func (p *Preferences) Matrix() []ChannelPreference {
+	rules := make([]ChannelPreference, 0, len(Categories)*len(Channels))
+	for _, category := range Categories {
+		for _, channel := range Channels {
+			rules = append(rules, p.Rule(category, channel))
+		}
+	}
+	return rules
+}
+
+func (p *Preferences) Location() *time.Location {
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func (p *Preferences) HasQuietHours() bool {
+	return p.QuietHoursStart != "" && p.QuietHoursEnd != "" && p.QuietHoursStart != p.QuietHoursEnd
+}
+
+// QuietUntil reports whether now falls in quiet hours and, if so, when they end; windows may wrap midnight
+func (p *Preferences) QuietUntil(now time.Time) (time.Time, bool) {
+	if !p.HasQuietHours() {
+		return time.Time{}, false
+	}
+
+	start, errStart := clock.Parse(p.QuietHoursStart)
+	end, errEnd := clock.Parse(p.QuietHoursEnd)
+	if errStart != nil || errEnd != nil {
+		return time.Time{}, false
+	}
+
+	local := now.In(p.Location())
+	minute := local.Hour()*60 + local.Minute()
+
+	var quiet bool
+	if start < end {
+		quiet = minute >= start && minute < end
+	} else {
+		quiet = minute >= start || minute < end
+	}
+	if !quiet {
+		return time.Time{}, false
+	}
+
+	until := atMinute(local, end)
+	if !until.After(local) {
+		until = atMinute(local.AddDate(0, 0, 1), end)
+	}
+	return until, true
+}
+
+// NextDigestAt is the next DigestHour:00 in the user's timezone strictly after now
+func (p *Preferences) NextDigestAt(now time.Time) time.Time {
+	local := now.In(p.Location())
+	next := atMinute(local, p.DigestHour*60)
+	if !next.After(local) {
+		next = atMinute(local.AddDate(0, 0, 1), p.DigestHour*60)
+	}
+	return next
+}
+
+func atMinute(day time.Time, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), minute/60, minute%60, 0, 0, day.Location())
+}
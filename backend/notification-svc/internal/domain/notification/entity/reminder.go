@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReminderKind string
+
+const (
+	// ReminderKindParticipants reminds every joined participant that the session is coming up
+	ReminderKindParticipants ReminderKind = "participants"
+	// ReminderKindHostUnderfilled warns the host while the session is still short of MinParticipants
+	ReminderKindHostUnderfilled ReminderKind = "host_underfilled"
+)
+
+// Reminder is a notification scheduled relative to a session's start; rows outlive restarts
+type Reminder struct {
+	ID           uuid.UUID
+	SessionID    uuid.UUID
+	HostID       uuid.UUID
+	Kind         ReminderKind
+	Lead         time.Duration
+	SessionStart time.Time
+	DueAt        time.Time
+	Attempts     int
+	SentAt       *time.Time
+	CreatedAt    time.Time
+}
+
+func NewReminder(sessionID, hostID uuid.UUID, kind ReminderKind, lead time.Duration, sessionStart time.Time) *Reminder {
+	return &Reminder{
+		ID:           uuid.New(),
+		SessionID:    sessionID,
+		HostID:       hostID,
+		Kind:         kind,
+		Lead:         lead,
+		SessionStart: sessionStart,
+		DueAt:        sessionStart.Add(-lead),
+		CreatedAt:    time.Now(),
+	}
+}
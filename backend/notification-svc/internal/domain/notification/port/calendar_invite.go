@@ -0,0 +1,28 @@
+package port
+
+import "time"
+
+type InviteMethod string
+
+const (
+	InviteRequest InviteMethod = "REQUEST" // New event, or an update to one the recipient already has
+	InviteCancel  InviteMethod = "CANCEL"
+)
+
+// CalendarInvite describes one reservation or session event; exactly one of ReservationID and SessionID is set
+type CalendarInvite struct {
+	Method        InviteMethod
+	ReservationID string
+	SessionID     string
+	Summary       string
+	Description   string
+	StartTime     time.Time
+	EndTime       time.Time
+	Venue         *VenueDetails
+	Attendee      *Recipient
+	IssuedAt      time.Time
+}
+
+type InviteEncoder interface {
+	EncodeInvite(invite *CalendarInvite) (*Attachment, error)
+}
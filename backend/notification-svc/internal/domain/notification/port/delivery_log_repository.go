@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+)
+
+type DeliveryLogFilter struct {
+	EventID string
+	UserID  string
+	Channel entity.Channel
+	Status  entity.DeliveryStatus
+	Limit   int
+	Offset  int
+}
+
+type DeliveryLogRepository interface {
+	// Claim records a new attempt for the record's key and fills in its ID and Attempts.
+	// It returns false, leaving the log untouched, when the key was already sent or deferred, or another
+	// attempt at it is still in flight.
+	Claim(ctx context.Context, record *entity.DeliveryRecord) (bool, error)
+	// ClaimDeferred is Claim for flushing held-back messages: a deferred key may be taken back
+	ClaimDeferred(ctx context.Context, record *entity.DeliveryRecord) (bool, error)
+	// Finish stores the outcome of a claimed attempt
+	Finish(ctx context.Context, record *entity.DeliveryRecord) error
+	List(ctx context.Context, filter DeliveryLogFilter) ([]*entity.DeliveryRecord, int64, error)
+}
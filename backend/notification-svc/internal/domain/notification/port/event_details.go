@@ -0,0 +1,76 @@
+package port
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+type VenueDetails struct {
+	VenueName    string
+	ResourceName string
+	Address      string
+	City         string
+	Latitude     float64
+	Longitude    float64
+}
+
+type ReservationDetails struct {
+	ID         string
+	ResourceID string
+	StartTime  time.Time
+	EndTime    time.Time
+	Venue      *VenueDetails
+}
+
+type SessionDetails struct {
+	ID                  string
+	HostID              string
+	SportType           string
+	SkillLevel          string
+	Description         string
+	Status              string
+	PricePerParticipant float64
+	MaxParticipants     int
+	MinParticipants     int
+	CurrentParticipants int
+	StartTime           time.Time
+	EndTime             time.Time
+	ReservationID       string
+	Reservation         *ReservationDetails
+}
+
+// Name is the human-readable label templates use for a session, e.g. "Football (Intermediate)"
+func (s *SessionDetails) Name() string {
+	name := titleCase(s.SportType)
+	if s.SkillLevel != "" {
+		name += " (" + titleCase(s.SkillLevel) + ")"
+	}
+	return name
+}
+
+// IsOpen reports whether the session can still take place, i.e. it is neither cancelled nor already played
+func (s *SessionDetails) IsOpen() bool {
+	return s.Status == "OPEN" || s.Status == "FULL"
+}
+
+func titleCase(value string) string {
+	words := strings.Fields(strings.ToLower(strings.ReplaceAll(value, "_", " ")))
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+type ReservationDirectory interface {
+	GetReservation(ctx context.Context, reservationID string) (*ReservationDetails, error)
+}
+
+type VenueDirectory interface {
+	GetResourceVenue(ctx context.Context, resourceID string) (*VenueDetails, error)
+}
+
+type EventDetailsProvider interface {
+	SessionDetails(ctx context.Context, sessionID string) (*SessionDetails, error)
+	ReservationDetails(ctx context.Context, reservationID string) (*ReservationDetails, error)
+}
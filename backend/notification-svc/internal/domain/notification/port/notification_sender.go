@@ -1,15 +1,31 @@
 package port
 
-import "context"
+import (
+	"context"
 
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+)
+
+// EmailNotification carries a plain-text Body and, optionally, an HTML alternative and attachments
 type EmailNotification struct {
-	To      string
-	Subject string
-	Body    string
-	IsHTML  bool
+	To          string
+	ToName      string
+	Subject     string
+	Body        string
+	HTMLBody    string
+	Attachments []Attachment
 }
 
-type NotificationSender interface {
-	SendEmail(ctx context.Context, notification EmailNotification) error
+// Attachment is a file sent alongside an email, e.g. an .ics invite
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
+// ChannelSender delivers a rendered message to one recipient over a single channel and returns
+// the provider's message ID, or an empty string when the provider does not issue one
+type ChannelSender interface {
+	Channel() entity.Channel
+	Send(ctx context.Context, recipient *Recipient, notificationType string, message *Message) (string, error)
+}
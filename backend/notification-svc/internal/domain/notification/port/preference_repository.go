@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/google/uuid"
+)
+
+type PreferenceRepository interface {
+	// Get returns a NotFound error for users who never saved preferences
+	Get(ctx context.Context, userID uuid.UUID) (*entity.Preferences, error)
+	Save(ctx context.Context, preferences *entity.Preferences) error
+}
+
+type DeferredDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.DeferredDelivery) error
+	// FetchDue locks due rows for the surrounding transaction so replicas flush disjoint batches
+	FetchDue(ctx context.Context, now time.Time, limit int) ([]*entity.DeferredDelivery, error)
+	Delete(ctx context.Context, ids []uuid.UUID) error
+	Reschedule(ctx context.Context, ids []uuid.UUID, deliverAfter time.Time) error
+}
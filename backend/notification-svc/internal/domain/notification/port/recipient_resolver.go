@@ -0,0 +1,25 @@
+package port
+
+import "context"
+
+type Recipient struct {
+	UserID   string
+	FullName string
+	Email    string
+	Phone    string
+	Locale   string
+}
+
+type UserDirectory interface {
+	GetRecipient(ctx context.Context, userID string) (*Recipient, error)
+}
+
+type SessionDirectory interface {
+	GetSession(ctx context.Context, sessionID string) (*SessionDetails, error)
+	ListParticipantIDs(ctx context.Context, sessionID string) ([]string, error)
+}
+
+type RecipientResolver interface {
+	ResolveUser(ctx context.Context, userID string) (*Recipient, error)
+	ResolveSessionParticipants(ctx context.Context, sessionID string) ([]*Recipient, error)
+}
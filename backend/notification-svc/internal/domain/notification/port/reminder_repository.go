@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/google/uuid"
+)
+
+type ReminderRepository interface {
+	// Schedule ignores reminders already scheduled for the same session, kind and lead
+	Schedule(ctx context.Context, reminders []*entity.Reminder) error
+	// ClaimDue counts an attempt on due, unsent reminders and hides them from other claims until
+	// lockedUntil, so replicas send disjoint batches without holding a transaction open
+	ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Reminder, error)
+	MarkSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+	// Reschedule releases the claim and makes the reminder due again at dueAt
+	Reschedule(ctx context.Context, id uuid.UUID, dueAt time.Time) error
+	// CancelBySession drops the session's pending reminders
+	CancelBySession(ctx context.Context, sessionID uuid.UUID) error
+}
@@ -0,0 +1,71 @@
+package port
+
+import "time"
+
+const (
+	TemplateReservationCreated   = "reservation_created"
+	TemplateReservationConfirmed = "reservation_confirmed"
+	TemplateReservationCancelled = "reservation_cancelled"
+	TemplateReservationExpired   = "reservation_expired"
+
+	TemplateSessionCreated   = "session_created"
+	TemplateSessionJoined    = "session_joined"
+	TemplateSessionFull      = "session_full"
+	TemplateSessionCancelled = "session_cancelled"
+	TemplateSessionLeft      = "session_left"
+
+	TemplateSessionReminder    = "session_reminder"
+	TemplateSessionUnderfilled = "session_underfilled"
+
+	TemplatePaymentCreated   = "payment_created"
+	TemplatePaymentSucceeded = "payment_succeeded"
+	TemplatePaymentFailed    = "payment_failed"
+	TemplatePaymentRefunded  = "payment_refunded"
+
+	TemplateAccountEmailVerification = "account_email_verification"
+	TemplateAccountPasswordReset     = "account_password_reset"
+	TemplateAccountPasswordChanged   = "account_password_changed"
+
+	TemplateDigest = "digest"
+)
+
+// Message is one rendering of a template; Short is the one-liner used by push, SMS and the inbox
+type Message struct {
+	Subject     string
+	Short       string
+	Text        string
+	HTML        string
+	Attachments []Attachment // Email only; other channels ignore them
+}
+
+// TemplateData is everything a notification template may reference; handlers fill what their event knows
+type TemplateData struct {
+	Recipient   *Recipient
+	Session     *SessionDetails
+	Reservation *ReservationDetails
+
+	ReservationID string
+	PaymentID     string
+	Amount        float64
+	Currency      string
+	Reason        string
+	RefundID      string
+	Participants  int
+	StartsIn      time.Duration
+	Link          string
+	ExpiresIn     time.Duration
+
+	Digest []DigestItem
+}
+
+// DigestItem is one held-back notification summarised in a digest
+type DigestItem struct {
+	Type      string
+	Subject   string
+	Short     string
+	CreatedAt time.Time
+}
+
+type TemplateRenderer interface {
+	Render(name, locale string, data TemplateData) (*Message, error)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+)
+
+// inviteMethods lists the templates that add, update or remove an event in the recipient's calendar
+var inviteMethods = map[string]port.InviteMethod{
+	port.TemplateReservationConfirmed: port.InviteRequest,
+	port.TemplateReservationCancelled: port.InviteCancel,
+	port.TemplateSessionCreated:       port.InviteRequest,
+	port.TemplateSessionJoined:        port.InviteRequest,
+	port.TemplateSessionLeft:          port.InviteCancel,
+	port.TemplateSessionCancelled:     port.InviteCancel,
+}
+
+// CalendarInviteRenderer attaches an .ics invite to messages about bookings and sessions
+type CalendarInviteRenderer struct {
+	renderer port.TemplateRenderer
+	encoder  port.InviteEncoder
+}
+
+func NewCalendarInviteRenderer(renderer port.TemplateRenderer, encoder port.InviteEncoder) *CalendarInviteRenderer {
+	return &CalendarInviteRenderer{
+		renderer: renderer,
+		encoder:  encoder,
+	}
+}
+
+func (r *CalendarInviteRenderer) Render(name, locale string, data port.TemplateData) (*port.Message, error) {
+	message, err := r.renderer.Render(name, locale, data)
+	if err != nil {
+		return nil, err
+	}
+
+	invite := calendarInvite(name, data, message)
+	if invite == nil {
+		return message, nil
+	}
+
+	// The message is still worth sending without its invite
+	attachment, err := r.encoder.EncodeInvite(invite)
+	if err != nil {
+		log.Printf("Failed to build calendar invite for %s: %v", name, err)
+		return message, nil
+	}
+	message.Attachments = append(message.Attachments, *attachment)
+
+	return message, nil
+}
+
+func calendarInvite(template string, data port.TemplateData, message *port.Message) *port.CalendarInvite {
+	method, ok := inviteMethods[template]
+	if !ok {
+		return nil
+	}
+
+	invite := &port.CalendarInvite{
+		Method:      method,
+		Description: message.Short,
+		Attendee:    data.Recipient,
+		IssuedAt:    time.Now(),
+	}
+
+	switch {
+	case data.Session != nil:
+		invite.SessionID = data.Session.ID
+		invite.Summary = data.Session.Name()
+		invite.StartTime = data.Session.StartTime
+		invite.EndTime = data.Session.EndTime
+		if reservation := data.Session.Reservation; reservation != nil {
+			if invite.StartTime.IsZero() {
+				invite.StartTime, invite.EndTime = reservation.StartTime, reservation.EndTime
+			}
+			invite.Venue = reservation.Venue
+		}
+	case data.Reservation != nil:
+		invite.ReservationID = data.Reservation.ID
+		invite.Summary = "Reservation"
+		invite.StartTime = data.Reservation.StartTime
+		invite.EndTime = data.Reservation.EndTime
+		invite.Venue = data.Reservation.Venue
+		if venue := data.Reservation.Venue; venue != nil {
+			invite.Summary = venue.ResourceName + ", " + venue.VenueName
+		}
+	default:
+		return nil
+	}
+
+	if invite.StartTime.IsZero() {
+		return nil
+	}
+	return invite
+}
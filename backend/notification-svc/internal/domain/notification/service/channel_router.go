@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+)
+
+var defaultChannels = []entity.Channel{entity.ChannelEmail, entity.ChannelInApp}
+
+// DefaultRoutes sends time-sensitive events to phones as well as the inbox
+func DefaultRoutes() map[string][]entity.Channel {
+	return map[string][]entity.Channel{
+		port.TemplateReservationCreated:   {entity.ChannelEmail, entity.ChannelInApp},
+		port.TemplateReservationConfirmed: {entity.ChannelEmail, entity.ChannelInApp},
+		port.TemplateReservationCancelled: {entity.ChannelEmail, entity.ChannelInApp},
+		port.TemplateReservationExpired:   {entity.ChannelEmail, entity.ChannelPush, entity.ChannelInApp},
+
+		port.TemplateSessionCreated:   {entity.ChannelEmail, entity.ChannelInApp},
+		port.TemplateSessionJoined:    {entity.ChannelPush, entity.ChannelInApp},
+		port.TemplateSessionFull:      {entity.ChannelEmail, entity.ChannelPush, entity.ChannelInApp},
+		port.TemplateSessionCancelled: {entity.ChannelEmail, entity.ChannelPush, entity.ChannelSMS, entity.ChannelInApp},
+		port.TemplateSessionLeft:      {entity.ChannelInApp},
+
+		port.TemplateSessionReminder:    {entity.ChannelEmail, entity.ChannelPush, entity.ChannelInApp},
+		port.TemplateSessionUnderfilled: {entity.ChannelEmail, entity.ChannelPush, entity.ChannelInApp},
+
+		port.TemplatePaymentCreated:   {entity.ChannelInApp},
+		port.TemplatePaymentSucceeded: {entity.ChannelEmail, entity.ChannelInApp},
+		port.TemplatePaymentFailed:    {entity.ChannelEmail, entity.ChannelPush, entity.ChannelInApp},
+		port.TemplatePaymentRefunded:  {entity.ChannelEmail, entity.ChannelInApp},
+
+		// Account messages have no preference category, so users cannot switch them off
+		port.TemplateAccountEmailVerification: {entity.ChannelEmail},
+		port.TemplateAccountPasswordReset:     {entity.ChannelEmail},
+		port.TemplateAccountPasswordChanged:   {entity.ChannelEmail, entity.ChannelInApp},
+	}
+}
+
+type ChannelRouter struct {
+	routes map[string][]entity.Channel
+}
+
+func NewChannelRouter(routes map[string][]entity.Channel) *ChannelRouter {
+	return &ChannelRouter{
+		routes: routes,
+	}
+}
+
+func (r *ChannelRouter) Channels(notificationType string) []entity.Channel {
+	if channels, ok := r.routes[notificationType]; ok {
+		return channels
+	}
+	return defaultChannels
+}
+
+// ParseRoutes applies overrides like "session_joined=push,in_app;payment_created=email" on top of routes
+func ParseRoutes(routes map[string][]entity.Channel, spec string) (map[string][]entity.Channel, error) {
+	merged := make(map[string][]entity.Channel, len(routes))
+	for notificationType, channels := range routes {
+		merged[notificationType] = channels
+	}
+
+	for _, rule := range strings.Split(spec, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		notificationType, list, ok := strings.Cut(rule, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid route %q, expected type=channel,channel", rule)
+		}
+
+		var channels []entity.Channel
+		for _, name := range strings.Split(list, ",") {
+			channel := entity.Channel(strings.TrimSpace(name))
+			if !channel.IsValid() {
+				return nil, fmt.Errorf("unknown channel %q in route %q", name, rule)
+			}
+			channels = append(channels, channel)
+		}
+		merged[strings.TrimSpace(notificationType)] = channels
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import "context"
+
+type eventIDKey struct{}
+
+// WithEventID tags everything sent while handling ctx with the triggering event's ID,
+// which is what makes a redelivered event recognisable in the delivery log
+func WithEventID(ctx context.Context, eventID string) context.Context {
+	return context.WithValue(ctx, eventIDKey{}, eventID)
+}
+
+func EventIDFromContext(ctx context.Context) string {
+	eventID, _ := ctx.Value(eventIDKey{}).(string)
+	return eventID
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+// EventDetailsService enriches thin event payloads with the session, reservation and venue they refer to
+type EventDetailsService struct {
+	sessions     port.SessionDirectory
+	reservations port.ReservationDirectory
+	venues       port.VenueDirectory
+}
+
+func NewEventDetailsService(sessions port.SessionDirectory, reservations port.ReservationDirectory, venues port.VenueDirectory) *EventDetailsService {
+	return &EventDetailsService{
+		sessions:     sessions,
+		reservations: reservations,
+		venues:       venues,
+	}
+}
+
+func (s *EventDetailsService) SessionDetails(ctx context.Context, sessionID string) (*port.SessionDetails, error) {
+	session, err := s.sessions.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.ReservationID != "" {
+		reservation, err := s.ReservationDetails(ctx, session.ReservationID)
+		if err != nil && pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+			return nil, err
+		}
+		session.Reservation = reservation
+	}
+
+	return session, nil
+}
+
+func (s *EventDetailsService) ReservationDetails(ctx context.Context, reservationID string) (*port.ReservationDetails, error) {
+	reservation, err := s.reservations.GetReservation(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	venue, err := s.venues.GetResourceVenue(ctx, reservation.ResourceID)
+	if err != nil {
+		// Templates render without the venue block rather than holding the notification back
+		if pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+			return nil, err
+		}
+		log.Printf("No venue found for resource %s: %v", reservation.ResourceID, err)
+	}
+	reservation.Venue = venue
+
+	return reservation, nil
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type NotificationService struct {
+	senders     map[entity.Channel]port.ChannelSender
+	recipients  port.RecipientResolver
+	renderer    port.TemplateRenderer
+	router      *ChannelRouter
+	preferences *PreferenceService
+	deferred    port.DeferredDeliveryRepository
+	deliveries  port.DeliveryLogRepository
+	currency    string
+}
+
+func NewNotificationService(
+	senders []port.ChannelSender,
+	recipients port.RecipientResolver,
+	renderer port.TemplateRenderer,
+	router *ChannelRouter,
+	preferences *PreferenceService,
+	deferred port.DeferredDeliveryRepository,
+	deliveries port.DeliveryLogRepository,
+	currency string,
+) *NotificationService {
+	byChannel := make(map[entity.Channel]port.ChannelSender, len(senders))
+	for _, sender := range senders {
+		byChannel[sender.Channel()] = sender
+	}
+
+	return &NotificationService{
+		senders:     byChannel,
+		recipients:  recipients,
+		renderer:    renderer,
+		router:      router,
+		preferences: preferences,
+		deferred:    deferred,
+		deliveries:  deliveries,
+		currency:    currency,
+	}
+}
+
+func (s *NotificationService) NotifyUser(ctx context.Context, userID, template string, data port.TemplateData) error {
+	recipient, err := s.recipients.ResolveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, recipient, template, data)
+}
+
+// NotifySessionParticipants notifies every active participant, attempting all of them before reporting failures
+func (s *NotificationService) NotifySessionParticipants(ctx context.Context, sessionID, template string, data port.TemplateData) (int, error) {
+	recipients, err := s.recipients.ResolveSessionParticipants(ctx, sessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	sent := 0
+	for _, recipient := range recipients {
+		if err := s.send(ctx, recipient, template, data); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", recipient.UserID, err))
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (s *NotificationService) send(ctx context.Context, recipient *port.Recipient, template string, data port.TemplateData) error {
+	data.Recipient = recipient
+	if data.Currency == "" {
+		data.Currency = s.currency
+	}
+
+	message, err := s.renderer.Render(template, recipient.Locale, data)
+	if err != nil {
+		return err
+	}
+
+	preferences, err := s.preferences.forRecipient(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	eventID := EventIDFromContext(ctx)
+	if eventID == "" {
+		eventID = uuid.NewString() // Nothing to deduplicate against, but the attempt is still logged
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, channel := range s.router.Channels(template) {
+		sender, ok := s.senders[channel]
+		if !ok || !reachable(recipient, channel) {
+			continue
+		}
+
+		action, deliverAfter := decide(preferences, template, channel, now)
+		if action == decisionSkip {
+			continue
+		}
+
+		record := entity.NewDeliveryRecord(eventID, recipient.UserID, channel, template)
+		claimed, err := s.deliveries.Claim(ctx, record)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel, err))
+			continue
+		}
+		if !claimed {
+			log.Printf("Skipping duplicate %s for user %s over %s (event %s)", template, recipient.UserID, channel, eventID)
+			continue
+		}
+
+		switch action {
+		case decisionDefer, decisionDigest:
+			err = s.hold(ctx, eventID, preferences.UserID, channel, template, message, action == decisionDigest, deliverAfter)
+			if err == nil {
+				record.MarkDeferred()
+			}
+		default:
+			var providerMessageID string
+			providerMessageID, err = sender.Send(ctx, recipient, template, message)
+			if err == nil {
+				record.MarkSent(providerMessageID)
+			} else {
+				log.Printf("Failed to deliver %s to user %s over %s: %v", template, recipient.UserID, channel, err)
+			}
+		}
+		if err != nil {
+			record.MarkFailed(err)
+			errs = append(errs, fmt.Errorf("%s: %w", channel, err))
+		}
+		s.finish(ctx, record)
+	}
+
+	return errors.Join(errs...)
+}
+
+// finish records an outcome; a failure here is only logged, since the message itself has already gone out
+// and the pending entry is retried like any interrupted attempt
+func (s *NotificationService) finish(ctx context.Context, record *entity.DeliveryRecord) {
+	if err := s.deliveries.Finish(ctx, record); err != nil {
+		log.Printf("Failed to record delivery %s for user %s over %s: %v", record.EventID, record.UserID, record.Channel, err)
+	}
+}
+
+func reachable(recipient *port.Recipient, channel entity.Channel) bool {
+	switch channel {
+	case entity.ChannelEmail:
+		return recipient.Email != ""
+	case entity.ChannelSMS:
+		return recipient.Phone != ""
+	}
+	return true
+}
+
+func (s *NotificationService) hold(ctx context.Context, eventID string, userID uuid.UUID, channel entity.Channel, template string, message *port.Message, digest bool, deliverAfter time.Time) error {
+	delivery := entity.NewDeferredDelivery(eventID, userID, channel, template, digest, deliverAfter)
+	delivery.Subject = message.Subject
+	delivery.Short = message.Short
+	delivery.Text = message.Text
+	delivery.HTML = message.HTML
+	return s.deferred.Create(ctx, delivery)
+}
+
+type deferredKey struct {
+	userID  uuid.UUID
+	channel entity.Channel
+}
+
+// DeliverDeferred sends held-back messages, folding digest entries into one message per user and channel.
+// It reports which deliveries are finished (sent or undeliverable) and which should be retried later.
+func (s *NotificationService) DeliverDeferred(ctx context.Context, deliveries []*entity.DeferredDelivery) (done, retry []uuid.UUID) {
+	groups := make(map[deferredKey][]*entity.DeferredDelivery)
+	var order []deferredKey
+	for _, delivery := range deliveries {
+		key := deferredKey{userID: delivery.UserID, channel: delivery.Channel}
+		if !delivery.Digest {
+			key = deferredKey{userID: delivery.ID, channel: delivery.Channel} // One group per non-digest entry
+		}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], delivery)
+	}
+
+	for _, key := range order {
+		group := groups[key]
+		ids := make([]uuid.UUID, 0, len(group))
+		for _, delivery := range group {
+			ids = append(ids, delivery.ID)
+		}
+
+		if err := s.deliverGroup(ctx, group); err != nil {
+			if isPermanent(err) {
+				log.Printf("Dropping %d deferred %s notifications for user %s: %v", len(group), group[0].Channel, group[0].UserID, err)
+				done = append(done, ids...)
+				continue
+			}
+			log.Printf("Failed to deliver %d deferred %s notifications to user %s: %v", len(group), group[0].Channel, group[0].UserID, err)
+			retry = append(retry, ids...)
+			continue
+		}
+		done = append(done, ids...)
+	}
+
+	return done, retry
+}
+
+// deliverGroup claims the group's delivery log entries, sends whatever was claimed as one message
+// and records the outcome against each entry
+func (s *NotificationService) deliverGroup(ctx context.Context, group []*entity.DeferredDelivery) error {
+	claimed := make([]*entity.DeferredDelivery, 0, len(group))
+	records := make([]*entity.DeliveryRecord, 0, len(group))
+	for _, delivery := range group {
+		eventID := delivery.EventID
+		if eventID == "" {
+			eventID = delivery.ID.String() // Held before the delivery log existed
+		}
+
+		record := entity.NewDeliveryRecord(eventID, delivery.UserID.String(), delivery.Channel, delivery.Type)
+		ok, err := s.deliveries.ClaimDeferred(ctx, record)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			log.Printf("Skipping deferred %s for user %s over %s: already delivered", delivery.Type, delivery.UserID, delivery.Channel)
+			continue
+		}
+		claimed = append(claimed, delivery)
+		records = append(records, record)
+	}
+	if len(claimed) == 0 {
+		return nil
+	}
+
+	providerMessageID, err := s.sendGroup(ctx, claimed)
+	for _, record := range records {
+		if err != nil {
+			record.MarkFailed(err)
+		} else {
+			record.MarkSent(providerMessageID)
+		}
+		s.finish(ctx, record)
+	}
+
+	return err
+}
+
+func (s *NotificationService) sendGroup(ctx context.Context, group []*entity.DeferredDelivery) (string, error) {
+	first := group[0]
+	sender, ok := s.senders[first.Channel]
+	if !ok {
+		return "", pkgerrors.NewInvalidArgumentError(fmt.Sprintf("channel %s is not configured", first.Channel))
+	}
+
+	recipient, err := s.recipients.ResolveUser(ctx, first.UserID.String())
+	if err != nil {
+		return "", err
+	}
+	if !reachable(recipient, first.Channel) {
+		return "", pkgerrors.NewInvalidArgumentError(fmt.Sprintf("user has no address for %s", first.Channel))
+	}
+
+	if !first.Digest {
+		return sender.Send(ctx, recipient, first.Type, &port.Message{
+			Subject: first.Subject,
+			Short:   first.Short,
+			Text:    first.Text,
+			HTML:    first.HTML,
+		})
+	}
+
+	items := make([]port.DigestItem, 0, len(group))
+	for _, delivery := range group {
+		items = append(items, port.DigestItem{
+			Type:      delivery.Type,
+			Subject:   delivery.Subject,
+			Short:     delivery.Short,
+			CreatedAt: delivery.CreatedAt,
+		})
+	}
+
+	message, err := s.renderer.Render(port.TemplateDigest, recipient.Locale, port.TemplateData{
+		Recipient: recipient,
+		Currency:  s.currency,
+		Digest:    items,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return sender.Send(ctx, recipient, port.TemplateDigest, message)
+}
+
+func isPermanent(err error) bool {
+	switch pkgerrors.GetErrorCode(err) {
+	case pkgerrors.CodeNotFound, pkgerrors.CodeInvalidArgument:
+		return true
+	}
+	return false
+}
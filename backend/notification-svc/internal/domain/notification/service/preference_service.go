@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/diploma/clock"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type PreferenceService struct {
+	preferences     port.PreferenceRepository
+	defaultTimezone string
+}
+
+func NewPreferenceService(preferences port.PreferenceRepository, defaultTimezone string) *PreferenceService {
+	return &PreferenceService{
+		preferences:     preferences,
+		defaultTimezone: defaultTimezone,
+	}
+}
+
+// GetPreferences falls back to the defaults for users who never saved any
+func (s *PreferenceService) GetPreferences(ctx context.Context, userID uuid.UUID) (*entity.Preferences, error) {
+	preferences, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		if pkgerrors.GetErrorCode(err) == pkgerrors.CodeNotFound {
+			return entity.DefaultPreferences(userID, s.defaultTimezone), nil
+		}
+		return nil, err
+	}
+	return preferences, nil
+}
+
+func (s *PreferenceService) UpdatePreferences(ctx context.Context, preferences *entity.Preferences) error {
+	if preferences.Timezone == "" {
+		preferences.Timezone = s.defaultTimezone
+	}
+	if err := validatePreferences(preferences); err != nil {
+		return err
+	}
+
+	preferences.UpdatedAt = time.Now()
+	return s.preferences.Save(ctx, preferences)
+}
+
+func (s *PreferenceService) forRecipient(ctx context.Context, recipient *port.Recipient) (*entity.Preferences, error) {
+	userID, err := uuid.Parse(recipient.UserID)
+	if err != nil {
+		// Only auth-svc users can store preferences; anything else gets the defaults
+		return entity.DefaultPreferences(uuid.Nil, s.defaultTimezone), nil
+	}
+	return s.GetPreferences(ctx, userID)
+}
+
+func validatePreferences(preferences *entity.Preferences) error {
+	if _, err := time.LoadLocation(preferences.Timezone); err != nil {
+		return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("unknown timezone %q", preferences.Timezone))
+	}
+	if (preferences.QuietHoursStart == "") != (preferences.QuietHoursEnd == "") {
+		return pkgerrors.NewInvalidArgumentError("quiet hours need both a start and an end")
+	}
+	if preferences.QuietHoursStart != "" {
+		if _, err := clock.Parse(preferences.QuietHoursStart); err != nil {
+			return pkgerrors.NewInvalidArgumentError(err.Error())
+		}
+		if _, err := clock.Parse(preferences.QuietHoursEnd); err != nil {
+			return pkgerrors.NewInvalidArgumentError(err.Error())
+		}
+	}
+	if preferences.DigestHour < 0 || preferences.DigestHour > 23 {
+		return pkgerrors.NewInvalidArgumentError("digest_hour must be between 0 and 23")
+	}
+
+	seen := make(map[entity.ChannelPreference]bool, len(preferences.Channels))
+	for _, rule := range preferences.Channels {
+		if !rule.Category.IsValid() {
+			return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("unknown category %q", rule.Category))
+		}
+		if !rule.Channel.IsValid() {
+			return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("unknown channel %q", rule.Channel))
+		}
+		if !rule.Delivery.IsValid() {
+			return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("unknown delivery mode %q", rule.Delivery))
+		}
+		if rule.Delivery == entity.DeliveryDigest && rule.Channel != entity.ChannelEmail {
+			return pkgerrors.NewInvalidArgumentError("digest delivery is only available for email")
+		}
+
+		key := entity.ChannelPreference{Category: rule.Category, Channel: rule.Channel}
+		if seen[key] {
+			return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("duplicate rule for %s over %s", rule.Category, rule.Channel))
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+type decision int
+
+const (
+	decisionDeliver decision = iota
+	decisionSkip
+	decisionDefer
+	decisionDigest
+)
+
+// decide applies a user's preferences to one channel; quiet hours only hold back channels that buzz a phone
+func decide(preferences *entity.Preferences, notificationType string, channel entity.Channel, now time.Time) (decision, time.Time) {
+	rule := preferences.Rule(entity.CategoryOf(notificationType), channel)
+	if !rule.Enabled {
+		return decisionSkip, time.Time{}
+	}
+	if rule.Delivery == entity.DeliveryDigest {
+		return decisionDigest, preferences.NextDigestAt(now)
+	}
+	if channel == entity.ChannelPush || channel == entity.ChannelSMS {
+		if until, quiet := preferences.QuietUntil(now); quiet {
+			return decisionDefer, until
+		}
+	}
+	return decisionDeliver, time.Time{}
+}
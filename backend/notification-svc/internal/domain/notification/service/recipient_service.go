@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+type RecipientService struct {
+	users    port.UserDirectory
+	sessions port.SessionDirectory
+}
+
+func NewRecipientService(users port.UserDirectory, sessions port.SessionDirectory) *RecipientService {
+	return &RecipientService{
+		users:    users,
+		sessions: sessions,
+	}
+}
+
+func (s *RecipientService) ResolveUser(ctx context.Context, userID string) (*port.Recipient, error) {
+	if userID == "" {
+		return nil, pkgerrors.NewInvalidArgumentError("user_id is required")
+	}
+
+	return s.users.GetRecipient(ctx, userID)
+}
+
+func (s *RecipientService) ResolveSessionParticipants(ctx context.Context, sessionID string) ([]*port.Recipient, error) {
+	if sessionID == "" {
+		return nil, pkgerrors.NewInvalidArgumentError("session_id is required")
+	}
+
+	userIDs, err := s.sessions.ListParticipantIDs(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]*port.Recipient, 0, len(userIDs))
+	for _, userID := range userIDs {
+		recipient, err := s.ResolveUser(ctx, userID)
+		if err != nil {
+			// A participant whose account is gone should not block everyone else's notification
+			if pkgerrors.GetErrorCode(err) == pkgerrors.CodeNotFound {
+				log.Printf("Skipping participant %s of session %s: %v", userID, sessionID, err)
+				continue
+			}
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// ReminderService schedules time-based reminders when a session is created and sends them once due
+type ReminderService struct {
+	reminders        port.ReminderRepository
+	notifications    *NotificationService
+	details          *EventDetailsService
+	participantLeads []time.Duration
+	hostWarningLead  time.Duration
+}
+
+func NewReminderService(
+	reminders port.ReminderRepository,
+	notifications *NotificationService,
+	details *EventDetailsService,
+	participantLeads []time.Duration,
+	hostWarningLead time.Duration,
+) *ReminderService {
+	return &ReminderService{
+		reminders:        reminders,
+		notifications:    notifications,
+		details:          details,
+		participantLeads: participantLeads,
+		hostWarningLead:  hostWarningLead,
+	}
+}
+
+// ScheduleSession stores the session's reminders, skipping any that would already be overdue
+func (s *ReminderService) ScheduleSession(ctx context.Context, sessionID, hostID string, start time.Time) error {
+	sessionUUID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return pkgerrors.NewInvalidArgumentError("invalid session id")
+	}
+	hostUUID, err := uuid.Parse(hostID)
+	if err != nil {
+		return pkgerrors.NewInvalidArgumentError("invalid host id")
+	}
+	if start.IsZero() {
+		return pkgerrors.NewInvalidArgumentError("session start time is required")
+	}
+
+	now := time.Now()
+	var reminders []*entity.Reminder
+	for _, lead := range s.participantLeads {
+		reminder := entity.NewReminder(sessionUUID, hostUUID, entity.ReminderKindParticipants, lead, start)
+		if reminder.DueAt.After(now) {
+			reminders = append(reminders, reminder)
+		}
+	}
+	if s.hostWarningLead > 0 {
+		reminder := entity.NewReminder(sessionUUID, hostUUID, entity.ReminderKindHostUnderfilled, s.hostWarningLead, start)
+		if reminder.DueAt.After(now) {
+			reminders = append(reminders, reminder)
+		}
+	}
+
+	if len(reminders) == 0 {
+		return nil
+	}
+	return s.reminders.Schedule(ctx, reminders)
+}
+
+func (s *ReminderService) CancelSession(ctx context.Context, sessionID string) error {
+	sessionUUID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return nil // Nothing can have been scheduled for it
+	}
+	return s.reminders.CancelBySession(ctx, sessionUUID)
+}
+
+// Send delivers one reminder and reports whether it is finished (sent, moot or undeliverable);
+// false means it should be retried
+func (s *ReminderService) Send(ctx context.Context, reminder *entity.Reminder, now time.Time) bool {
+	if err := s.send(ctx, reminder, now); err != nil {
+		if isPermanent(err) {
+			log.Printf("Dropping %s reminder %s for session %s: %v", reminder.Kind, reminder.ID, reminder.SessionID, err)
+			return true
+		}
+		log.Printf("Failed to send %s reminder %s for session %s: %v", reminder.Kind, reminder.ID, reminder.SessionID, err)
+		return false
+	}
+
+	return true
+}
+
+func (s *ReminderService) send(ctx context.Context, reminder *entity.Reminder, now time.Time) error {
+	startsIn := reminder.SessionStart.Sub(now)
+	if startsIn <= 0 {
+		log.Printf("Skipping %s reminder for session %s: it started at %s", reminder.Kind, reminder.SessionID, reminder.SessionStart)
+		return nil
+	}
+	if startsIn > reminder.Lead {
+		startsIn = reminder.Lead
+	}
+	ctx = WithEventID(ctx, "reminder:"+reminder.ID.String())
+
+	session, err := s.details.SessionDetails(ctx, reminder.SessionID.String())
+	if err != nil {
+		return err
+	}
+	if !session.IsOpen() {
+		return nil
+	}
+
+	data := port.TemplateData{
+		Session:  session,
+		StartsIn: startsIn,
+	}
+
+	switch reminder.Kind {
+	case entity.ReminderKindParticipants:
+		sent, err := s.notifications.NotifySessionParticipants(ctx, session.ID, port.TemplateSessionReminder, data)
+		if err != nil {
+			return err
+		}
+		log.Printf("Sent %s reminder to %d participants of session %s", reminder.Lead, sent, session.ID)
+		return nil
+	case entity.ReminderKindHostUnderfilled:
+		if session.CurrentParticipants >= session.MinParticipants {
+			return nil
+		}
+		data.Participants = session.CurrentParticipants
+		if err := s.notifications.NotifyUser(ctx, reminder.HostID.String(), port.TemplateSessionUnderfilled, data); err != nil {
+			return err
+		}
+		log.Printf("Warned host %s that session %s has %d of %d required players", reminder.HostID, session.ID, session.CurrentParticipants, session.MinParticipants)
+		return nil
+	default:
+		return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("unknown reminder kind %q", reminder.Kind))
+	}
+}
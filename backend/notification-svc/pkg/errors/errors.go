@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 type DomainError struct {
 	Code    string
@@ -20,11 +23,16 @@ func (e *DomainError) Unwrap() error {
 }
 
 const (
+	CodeNotFound        = "NOT_FOUND"
 	CodeInvalidArgument = "INVALID_ARGUMENT"
 	CodeInternal        = "INTERNAL"
 	CodeExternalAPI     = "EXTERNAL_API"
 )
 
+func NewNotFoundError(message string) error {
+	return &DomainError{Code: CodeNotFound, Message: message}
+}
+
 func NewInvalidArgumentError(message string) error {
 	return &DomainError{Code: CodeInvalidArgument, Message: message}
 }
@@ -37,3 +45,10 @@ func NewExternalAPIError(message string, err error) error {
 	return &DomainError{Code: CodeExternalAPI, Message: message, Err: err}
 }
 
+func GetErrorCode(err error) string {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+	return CodeInternal
+}
@@ -0,0 +1,19 @@
+-- In-app inbox: one row per notification delivered over the in_app channel
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created
+    ON notifications(user_id, created_at DESC);
+
+-- Keeps unread badge counts cheap
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread
+    ON notifications(user_id)
+    WHERE read_at IS NULL;
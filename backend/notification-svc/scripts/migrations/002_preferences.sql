@@ -0,0 +1,39 @@
+-- Per-user notification preferences; users without a row get the defaults
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    quiet_hours_start VARCHAR(5),
+    quiet_hours_end VARCHAR(5),
+    digest_hour INT NOT NULL DEFAULT 8 CHECK (digest_hour BETWEEN 0 AND 23),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Overrides of the default (enabled, immediate) rule for one category on one channel
+CREATE TABLE IF NOT EXISTS notification_channel_preferences (
+    user_id UUID NOT NULL REFERENCES notification_preferences(user_id) ON DELETE CASCADE,
+    category VARCHAR(32) NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    delivery VARCHAR(16) NOT NULL DEFAULT 'immediate',
+    PRIMARY KEY (user_id, category, channel)
+);
+
+-- Rendered messages held back by quiet hours or waiting for the next digest
+CREATE TABLE IF NOT EXISTS deferred_deliveries (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    short TEXT NOT NULL,
+    text TEXT NOT NULL,
+    html TEXT NOT NULL,
+    digest BOOLEAN NOT NULL DEFAULT FALSE,
+    deliver_after TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_deferred_deliveries_due
+    ON deferred_deliveries(deliver_after);
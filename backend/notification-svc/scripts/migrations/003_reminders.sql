@@ -0,0 +1,18 @@
+-- Reminders scheduled relative to a session's start; sent rows are kept so redelivered events cannot re-arm them
+
+CREATE TABLE IF NOT EXISTS scheduled_reminders (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL,
+    host_id UUID NOT NULL,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('participants', 'host_underfilled')),
+    lead_seconds BIGINT NOT NULL CHECK (lead_seconds > 0),
+    session_start TIMESTAMPTZ NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (session_id, kind, lead_seconds)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_reminders_due
+    ON scheduled_reminders(due_at) WHERE sent_at IS NULL;
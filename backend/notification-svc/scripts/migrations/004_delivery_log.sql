@@ -0,0 +1,23 @@
+-- One row per (event, recipient, channel): the dedup key for redelivered events and the answer to "did the user get it?"
+
+CREATE TABLE IF NOT EXISTS delivery_log (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(128) NOT NULL,
+    user_id VARCHAR(64) NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('pending', 'sent', 'failed', 'deferred')),
+    attempts INT NOT NULL DEFAULT 0,
+    provider_message_id VARCHAR(255) NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, user_id, channel)
+);
+
+CREATE INDEX IF NOT EXISTS idx_delivery_log_user
+    ON delivery_log(user_id, created_at DESC);
+
+-- Deferred messages remember the event that produced them so the flush is logged under the same key
+ALTER TABLE deferred_deliveries ADD COLUMN IF NOT EXISTS event_id VARCHAR(128) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+-- Schedulers claim due reminders with a lease instead of holding row locks while they send
+
+ALTER TABLE scheduled_reminders ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
@@ -0,0 +1,6 @@
+-- One event can send the same recipient several notification types over one channel, so the type joins the dedup key
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_delivery_log_key
+    ON delivery_log(event_id, user_id, channel, type);
+
+ALTER TABLE delivery_log DROP CONSTRAINT IF EXISTS delivery_log_event_id_user_id_channel_key;
@@ -0,0 +1,56 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diploma/authz"
+	notificationv1 "github.com/diploma/notification-svc/api/v1"
+	"github.com/diploma/notification-svc/internal/adapters/inbound/grpc/handler"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessPolicy_CoversEveryMethod(t *testing.T) {
+	for _, desc := range []struct {
+		name    string
+		methods []string
+	}{
+		{notificationv1.NotificationService_ServiceDesc.ServiceName, methodNames(notificationv1.NotificationService_ServiceDesc.Methods)},
+		{notificationv1.NotificationAdminService_ServiceDesc.ServiceName, methodNames(notificationv1.NotificationAdminService_ServiceDesc.Methods)},
+	} {
+		for _, method := range desc.methods {
+			fullMethod := "/" + desc.name + "/" + method
+			if _, ok := handler.AccessPolicy[fullMethod]; !ok {
+				t.Errorf("Expected an access rule for %s", fullMethod)
+			}
+		}
+	}
+
+	// Dead letters hold other users' messages
+	if authz.RolePlayer.Can(authz.PermManageNotifications) || authz.RoleVenueOwner.Can(authz.PermManageNotifications) {
+		t.Error("Expected only admins to manage notifications")
+	}
+	if !authz.RoleAdmin.Can(authz.PermManageNotifications) {
+		t.Error("Expected admins to manage notifications")
+	}
+}
+
+func TestListNotifications_RejectsOtherUsers(t *testing.T) {
+	h := handler.NewNotificationGRPCHandler(nil, nil, nil, nil)
+	ctx := authz.NewContext(context.Background(), authz.Principal{UserID: "user-1", Role: authz.RolePlayer})
+
+	_, err := h.ListNotifications(ctx, &notificationv1.ListNotificationsRequest{UserId: "user-2"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+}
+
+func methodNames(methods []grpc.MethodDesc) []string {
+	names := make([]string, 0, len(methods))
+	for _, method := range methods {
+		names = append(names, method.MethodName)
+	}
+	return names
+}
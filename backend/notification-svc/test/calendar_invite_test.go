@@ -0,0 +1,119 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/diploma/notification-svc/internal/adapters/outbound/calendar"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+)
+
+type failingInviteEncoder struct{}
+
+func (failingInviteEncoder) EncodeInvite(*port.CalendarInvite) (*port.Attachment, error) {
+	return nil, errors.New("encoder unavailable")
+}
+
+var inviteRecipient = &port.Recipient{UserID: "user-1", FullName: "Aigerim Sadykova", Email: "aigerim@sportsapp.test", Locale: "en"}
+
+func newInviteRenderer(t *testing.T) *service.CalendarInviteRenderer {
+	t.Helper()
+	return service.NewCalendarInviteRenderer(newTestRenderer(t, ""), calendar.NewICalEncoder("SportsApp", "notifications@sportsapp.test"))
+}
+
+func renderInvite(t *testing.T, renderer *service.CalendarInviteRenderer, template string, data port.TemplateData) (port.Attachment, string) {
+	t.Helper()
+	data.Recipient = inviteRecipient
+	message, err := renderer.Render(template, "en", data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(message.Attachments) != 1 {
+		t.Fatalf("Expected one invite attached to %s, got %d attachments", template, len(message.Attachments))
+	}
+
+	attachment := message.Attachments[0]
+	return attachment, strings.ReplaceAll(string(attachment.Data), "\r\n ", "")
+}
+
+func TestCalendarInvite_ReservationConfirmedRequestsEvent(t *testing.T) {
+	reservation := *sampleReservation
+	venue := *sampleReservation.Venue
+	venue.Latitude, venue.Longitude = 43.238949, 76.889709
+	reservation.Venue = &venue
+
+	attachment, ics := renderInvite(t, newInviteRenderer(t), port.TemplateReservationConfirmed, port.TemplateData{Reservation: &reservation})
+
+	if attachment.Filename != "invite.ics" || attachment.ContentType != "text/calendar; charset=UTF-8; method=REQUEST" {
+		t.Errorf("Unexpected attachment %q (%s)", attachment.Filename, attachment.ContentType)
+	}
+	for _, want := range []string{
+		"METHOD:REQUEST\r\n",
+		"UID:reservation-" + reservation.ID + "@sportsapp\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"DTSTART:20260314T180000Z\r\n",
+		"DTEND:20260314T193000Z\r\n",
+		"SUMMARY:Court 1\\, Central Sports Park\r\n",
+		"LOCATION:Central Sports Park\\, 12 Abay Ave\\, Almaty\r\n",
+		"GEO:43.238949;76.889709\r\n",
+		"ORGANIZER;CN=SportsApp:mailto:notifications@sportsapp.test\r\n",
+		"ATTENDEE;CN=Aigerim Sadykova;",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("Expected invite to contain %q, got:\n%s", want, ics)
+		}
+	}
+}
+
+func TestCalendarInvite_SessionLeftCancelsEvent(t *testing.T) {
+	attachment, ics := renderInvite(t, newInviteRenderer(t), port.TemplateSessionLeft, port.TemplateData{Session: sampleSession})
+
+	if attachment.ContentType != "text/calendar; charset=UTF-8; method=CANCEL" {
+		t.Errorf("Unexpected content type %s", attachment.ContentType)
+	}
+	for _, want := range []string{
+		"METHOD:CANCEL\r\n",
+		"UID:session-" + sampleSession.ID + "@sportsapp\r\n",
+		"STATUS:CANCELLED\r\n",
+		"SUMMARY:Football (Intermediate)\r\n",
+		"DTSTART:20260314T180000Z\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("Expected invite to contain %q, got:\n%s", want, ics)
+		}
+	}
+	if strings.Contains(ics, "GEO:") {
+		t.Errorf("Expected no GEO for a venue without coordinates, got:\n%s", ics)
+	}
+}
+
+func TestCalendarInvite_OtherTemplatesHaveNoInvite(t *testing.T) {
+	renderer := newInviteRenderer(t)
+
+	for _, template := range []string{port.TemplateReservationCreated, port.TemplateSessionFull, port.TemplatePaymentSucceeded} {
+		data := templateSamples[template]
+		data.Recipient = inviteRecipient
+
+		message, err := renderer.Render(template, "en", data)
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got %v", template, err)
+		}
+		if len(message.Attachments) != 0 {
+			t.Errorf("Expected no invite on %s, got %d attachments", template, len(message.Attachments))
+		}
+	}
+}
+
+func TestCalendarInvite_EncoderFailureStillRendersMessage(t *testing.T) {
+	renderer := service.NewCalendarInviteRenderer(newTestRenderer(t, ""), failingInviteEncoder{})
+
+	message, err := renderer.Render(port.TemplateSessionJoined, "en", port.TemplateData{Recipient: inviteRecipient, Session: sampleSession, Participants: 3})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if message.Subject == "" || len(message.Attachments) != 0 {
+		t.Errorf("Expected the message without an invite, got %+v", message)
+	}
+}
@@ -0,0 +1,391 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/adapters/outbound/fake"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/inapp"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/push"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/sms"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/templates"
+	"github.com/diploma/notification-svc/internal/application/inbox/dto"
+	"github.com/diploma/notification-svc/internal/application/inbox/usecase"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type MockNotificationRepository struct {
+	mu            sync.Mutex
+	notifications []*entity.Notification
+}
+
+func (r *MockNotificationRepository) Create(ctx context.Context, notification *entity.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifications = append(r.notifications, notification)
+	return nil
+}
+
+func (r *MockNotificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*entity.Notification, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []*entity.Notification
+	for _, n := range r.notifications {
+		if n.UserID == userID && (!unreadOnly || !n.IsRead()) {
+			matched = append(matched, n)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].CreatedAt.After(matched[j].CreatedAt) })
+
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	matched = matched[offset:]
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, total, nil
+}
+
+func (r *MockNotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var count int64
+	for _, n := range r.notifications {
+		if n.UserID == userID && !n.IsRead() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *MockNotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, readAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wanted := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var updated int64
+	for _, n := range r.notifications {
+		if n.UserID == userID && wanted[n.ID] && !n.IsRead() {
+			n.ReadAt = &readAt
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func (r *MockNotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var updated int64
+	for _, n := range r.notifications {
+		if n.UserID == userID && !n.IsRead() {
+			n.ReadAt = &readAt
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// StubRecipientResolver returns a fixed recipient so channel reachability can be controlled per test
+type StubRecipientResolver struct {
+	recipient port.Recipient
+}
+
+func (r *StubRecipientResolver) ResolveUser(ctx context.Context, userID string) (*port.Recipient, error) {
+	recipient := r.recipient
+	return &recipient, nil
+}
+
+func (r *StubRecipientResolver) ResolveSessionParticipants(ctx context.Context, sessionID string) ([]*port.Recipient, error) {
+	recipient := r.recipient
+	return []*port.Recipient{&recipient}, nil
+}
+
+var _ port.NotificationRepository = (*MockNotificationRepository)(nil)
+var _ port.ChannelSender = (*fake.FakeSender)(nil)
+var _ port.ChannelSender = (*inapp.InAppSender)(nil)
+var _ port.ChannelSender = (*push.HTTPPushSender)(nil)
+var _ port.ChannelSender = (*sms.HTTPSMSGateway)(nil)
+
+type channelFixture struct {
+	service     *service.NotificationService
+	email       *fake.FakeSender
+	push        *fake.FakeSender
+	sms         *fake.FakeSender
+	inbox       *MockNotificationRepository
+	preferences *MockPreferenceRepository
+	deferred    *MockDeferredDeliveryRepository
+	deliveries  *MockDeliveryLogRepository
+}
+
+func newChannelFixture(t *testing.T, recipient port.Recipient, routes map[string][]entity.Channel) *channelFixture {
+	t.Helper()
+
+	renderer, err := templates.NewRenderer("", "en", time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to load templates: %v", err)
+	}
+
+	f := &channelFixture{
+		email:       fake.NewFakeSender(entity.ChannelEmail),
+		push:        fake.NewFakeSender(entity.ChannelPush),
+		sms:         fake.NewFakeSender(entity.ChannelSMS),
+		inbox:       &MockNotificationRepository{},
+		preferences: NewMockPreferenceRepository(),
+		deferred:    &MockDeferredDeliveryRepository{},
+		deliveries:  NewMockDeliveryLogRepository(),
+	}
+	f.service = service.NewNotificationService(
+		[]port.ChannelSender{f.email, f.push, f.sms, inapp.NewInAppSender(f.inbox)},
+		&StubRecipientResolver{recipient: recipient},
+		renderer,
+		service.NewChannelRouter(routes),
+		service.NewPreferenceService(f.preferences, "UTC"),
+		f.deferred,
+		f.deliveries,
+		"USD",
+	)
+	return f
+}
+
+func sessionCancelledData() port.TemplateData {
+	return port.TemplateData{
+		Session: &port.SessionDetails{ID: "session-1", SportType: "football", SkillLevel: "intermediate"},
+	}
+}
+
+func TestChannelRouter_DefaultsAndOverrides(t *testing.T) {
+	routes, err := service.ParseRoutes(service.DefaultRoutes(), "session_joined=email; custom_event=sms,push")
+	if err != nil {
+		t.Fatalf("Expected routes to parse, got %v", err)
+	}
+	router := service.NewChannelRouter(routes)
+
+	if got := router.Channels(port.TemplateSessionJoined); len(got) != 1 || got[0] != entity.ChannelEmail {
+		t.Errorf("Expected override for session_joined, got %v", got)
+	}
+	if got := router.Channels("custom_event"); len(got) != 2 || got[0] != entity.ChannelSMS {
+		t.Errorf("Expected new route for custom_event, got %v", got)
+	}
+	if got := router.Channels("unrouted"); len(got) != 2 || got[0] != entity.ChannelEmail || got[1] != entity.ChannelInApp {
+		t.Errorf("Expected email and in-app fallback, got %v", got)
+	}
+	if got := router.Channels(port.TemplateSessionCancelled); len(got) != 4 {
+		t.Errorf("Expected defaults to be kept, got %v", got)
+	}
+
+	if _, err := service.ParseRoutes(service.DefaultRoutes(), "session_full=pigeon"); err == nil {
+		t.Error("Expected unknown channel to be rejected")
+	}
+	if _, err := service.ParseRoutes(service.DefaultRoutes(), "session_full"); err == nil {
+		t.Error("Expected route without channels to be rejected")
+	}
+}
+
+func TestNotificationService_FansOutToRoutedChannels(t *testing.T) {
+	userID := uuid.New()
+	f := newChannelFixture(t, port.Recipient{UserID: userID.String(), Email: "alice@sportsapp.test", Phone: "+77010000000"}, service.DefaultRoutes())
+
+	if _, err := f.service.NotifySessionParticipants(context.Background(), "session-1", port.TemplateSessionCancelled, sessionCancelledData()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, sender := range []*fake.FakeSender{f.email, f.push, f.sms} {
+		if got := len(sender.Deliveries()); got != 1 {
+			t.Errorf("Expected 1 %s delivery, got %d", sender.Channel(), got)
+		}
+	}
+
+	smsDelivery := f.sms.Deliveries()[0]
+	if smsDelivery.Message.Short == "" || smsDelivery.Recipient.Phone != "+77010000000" {
+		t.Errorf("Expected SMS to carry the short text to the phone, got %+v", smsDelivery)
+	}
+
+	if len(f.inbox.notifications) != 1 {
+		t.Fatalf("Expected 1 inbox entry, got %d", len(f.inbox.notifications))
+	}
+	stored := f.inbox.notifications[0]
+	if stored.UserID != userID || stored.Type != port.TemplateSessionCancelled || stored.Title != smsDelivery.Message.Subject || stored.IsRead() {
+		t.Errorf("Unexpected inbox entry: %+v", stored)
+	}
+}
+
+func TestNotificationService_SkipsUnroutedAndUnreachableChannels(t *testing.T) {
+	f := newChannelFixture(t, port.Recipient{UserID: uuid.NewString(), Email: "alice@sportsapp.test"}, service.DefaultRoutes())
+
+	if _, err := f.service.NotifySessionParticipants(context.Background(), "session-1", port.TemplateSessionCancelled, sessionCancelledData()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := len(f.sms.Deliveries()); got != 0 {
+		t.Errorf("Expected SMS to be skipped without a phone number, got %d", got)
+	}
+
+	if err := f.service.NotifyUser(context.Background(), "player-1", port.TemplateSessionLeft, sessionCancelledData()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := len(f.email.Deliveries()); got != 1 {
+		t.Errorf("Expected session_left to stay out of email, got %d emails", got)
+	}
+	if len(f.inbox.notifications) != 2 {
+		t.Errorf("Expected both events in the inbox, got %d", len(f.inbox.notifications))
+	}
+}
+
+func TestNotificationService_ChannelFailureDoesNotBlockOthers(t *testing.T) {
+	f := newChannelFixture(t, port.Recipient{UserID: uuid.NewString(), Email: "alice@sportsapp.test"}, service.DefaultRoutes())
+	f.push.FailWith(errors.New("push provider down"))
+
+	err := f.service.NotifyUser(context.Background(), "player-1", port.TemplateSessionFull, sessionCancelledData())
+	if err == nil {
+		t.Fatal("Expected push failure to be reported")
+	}
+	if len(f.email.Deliveries()) != 1 || len(f.inbox.notifications) != 1 {
+		t.Errorf("Expected email and in-app to be delivered despite push failure")
+	}
+}
+
+func TestHTTPPushSender_PostsTopicMessage(t *testing.T) {
+	var body map[string]map[string]any
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"name":"projects/sportsapp/messages/0:1"}`))
+	}))
+	defer server.Close()
+
+	sender := push.NewHTTPPushSender(server.URL, "push-key", "user-", time.Second)
+	messageID, err := sender.Send(context.Background(), &port.Recipient{UserID: "42"}, port.TemplateSessionFull, &port.Message{Subject: "Full", Short: "Session is full"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if messageID != "projects/sportsapp/messages/0:1" {
+		t.Errorf("Expected the FCM message name as provider ID, got %q", messageID)
+	}
+
+	if auth != "Bearer push-key" {
+		t.Errorf("Expected bearer auth, got %q", auth)
+	}
+	message := body["message"]
+	notification, _ := message["notification"].(map[string]any)
+	if message["topic"] != "user-42" || notification["title"] != "Full" || notification["body"] != "Session is full" {
+		t.Errorf("Unexpected push payload: %v", body)
+	}
+}
+
+func TestHTTPPushSender_MapsProviderErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "quota exceeded", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := push.NewHTTPPushSender(server.URL, "", "user-", time.Second).
+		Send(context.Background(), &port.Recipient{UserID: "42"}, port.TemplateSessionFull, &port.Message{})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeExternalAPI {
+		t.Errorf("Expected external API error, got %v", err)
+	}
+}
+
+func TestHTTPSMSGateway_SendsShortText(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"message_id":"sms-7"}`))
+	}))
+	defer server.Close()
+
+	gateway := sms.NewHTTPSMSGateway(server.URL, "sms-key", "SportsApp", time.Second)
+	messageID, err := gateway.Send(context.Background(), &port.Recipient{Phone: "+77010000000"}, port.TemplateSessionCancelled, &port.Message{Text: "long", Short: "short"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if messageID != "sms-7" {
+		t.Errorf("Expected the gateway message ID, got %q", messageID)
+	}
+	if body["to"] != "+77010000000" || body["from"] != "SportsApp" || body["text"] != "short" {
+		t.Errorf("Unexpected SMS payload: %v", body)
+	}
+}
+
+func TestInbox_ListAndMarkRead(t *testing.T) {
+	repo := &MockNotificationRepository{}
+	alice, bob := uuid.New(), uuid.New()
+	base := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		n := entity.NewNotification(alice, port.TemplateSessionJoined, "Joined", "Someone joined")
+		n.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		_ = repo.Create(context.Background(), n)
+	}
+	bobs := entity.NewNotification(bob, port.TemplateSessionFull, "Full", "Session is full")
+	_ = repo.Create(context.Background(), bobs)
+
+	list := usecase.NewListNotificationsUseCase(repo)
+	markRead := usecase.NewMarkReadUseCase(repo)
+
+	output, err := list.Execute(context.Background(), dto.ListNotificationsInput{UserID: alice.String(), Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(output.Notifications) != 2 || output.Total != 3 || output.UnreadCount != 3 {
+		t.Fatalf("Unexpected page: %+v", output)
+	}
+	if !output.Notifications[0].CreatedAt.After(output.Notifications[1].CreatedAt) {
+		t.Error("Expected newest notifications first")
+	}
+
+	marked, err := markRead.Execute(context.Background(), dto.MarkReadInput{
+		UserID:          alice.String(),
+		NotificationIDs: []string{output.Notifications[0].ID, bobs.ID.String()},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if marked.Updated != 1 || marked.UnreadCount != 2 {
+		t.Errorf("Expected only alice's notification to be marked, got %+v", marked)
+	}
+	if bobs.IsRead() {
+		t.Error("Expected another user's notification to stay unread")
+	}
+
+	marked, err = markRead.Execute(context.Background(), dto.MarkReadInput{UserID: alice.String(), All: true})
+	if err != nil || marked.Updated != 2 || marked.UnreadCount != 0 {
+		t.Errorf("Expected mark-all to clear the inbox, got %+v, %v", marked, err)
+	}
+
+	unread, _ := list.Execute(context.Background(), dto.ListNotificationsInput{UserID: alice.String(), UnreadOnly: true})
+	if len(unread.Notifications) != 0 {
+		t.Errorf("Expected no unread notifications, got %d", len(unread.Notifications))
+	}
+}
+
+func TestInbox_RejectsInvalidInput(t *testing.T) {
+	repo := &MockNotificationRepository{}
+
+	if _, err := usecase.NewListNotificationsUseCase(repo).Execute(context.Background(), dto.ListNotificationsInput{UserID: "not-a-uuid"}); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected invalid argument for bad user id, got %v", err)
+	}
+	if _, err := usecase.NewMarkReadUseCase(repo).Execute(context.Background(), dto.MarkReadInput{UserID: uuid.NewString()}); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected invalid argument without ids, got %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+package test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/adapters/outbound/templates"
+	deliverydto "github.com/diploma/notification-svc/internal/application/delivery/dto"
+	deliveryusecase "github.com/diploma/notification-svc/internal/application/delivery/usecase"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type deliveryKey struct {
+	eventID string
+	userID  string
+	channel entity.Channel
+	kind    string
+}
+
+type MockDeliveryLogRepository struct {
+	mu      sync.Mutex
+	records map[deliveryKey]*entity.DeliveryRecord
+}
+
+func NewMockDeliveryLogRepository() *MockDeliveryLogRepository {
+	return &MockDeliveryLogRepository{records: make(map[deliveryKey]*entity.DeliveryRecord)}
+}
+
+func (r *MockDeliveryLogRepository) Claim(ctx context.Context, record *entity.DeliveryRecord) (bool, error) {
+	return r.claim(record, entity.DeliveryStatusFailed)
+}
+
+func (r *MockDeliveryLogRepository) ClaimDeferred(ctx context.Context, record *entity.DeliveryRecord) (bool, error) {
+	return r.claim(record, entity.DeliveryStatusFailed, entity.DeliveryStatusDeferred)
+}
+
+func (r *MockDeliveryLogRepository) claim(record *entity.DeliveryRecord, reclaimable ...entity.DeliveryStatus) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := deliveryKey{eventID: record.EventID, userID: record.UserID, channel: record.Channel, kind: record.Type}
+	existing, ok := r.records[key]
+	if !ok {
+		record.Attempts = 1
+		stored := *record
+		r.records[key] = &stored
+		return true, nil
+	}
+
+	for _, status := range reclaimable {
+		if existing.Status == status {
+			existing.Status = entity.DeliveryStatusPending
+			existing.Attempts++
+			record.ID = existing.ID
+			record.Attempts = existing.Attempts
+			record.CreatedAt = existing.CreatedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *MockDeliveryLogRepository) Finish(ctx context.Context, record *entity.DeliveryRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *record
+	r.records[deliveryKey{eventID: record.EventID, userID: record.UserID, channel: record.Channel, kind: record.Type}] = &stored
+	return nil
+}
+
+func (r *MockDeliveryLogRepository) List(ctx context.Context, filter port.DeliveryLogFilter) ([]*entity.DeliveryRecord, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []*entity.DeliveryRecord
+	for _, record := range r.records {
+		if (filter.EventID == "" || record.EventID == filter.EventID) &&
+			(filter.UserID == "" || record.UserID == filter.UserID) &&
+			(filter.Channel == "" || record.Channel == filter.Channel) &&
+			(filter.Status == "" || record.Status == filter.Status) {
+			stored := *record
+			matched = append(matched, &stored)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].UserID < matched[j].UserID })
+
+	total := int64(len(matched))
+	if filter.Offset >= len(matched) {
+		return nil, total, nil
+	}
+	matched = matched[filter.Offset:]
+	if len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+	return matched, total, nil
+}
+
+func (r *MockDeliveryLogRepository) find(eventID, userID string, channel entity.Channel, kind string) *entity.DeliveryRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.records[deliveryKey{eventID: eventID, userID: userID, channel: channel, kind: kind}]
+}
+
+var _ port.DeliveryLogRepository = (*MockDeliveryLogRepository)(nil)
+
+// newDeliveryLogFixture is newNotificationFixture with the delivery log exposed
+func newDeliveryLogFixture(t *testing.T, sender port.ChannelSender) (*service.NotificationService, *MockDeliveryLogRepository) {
+	t.Helper()
+
+	renderer, err := templates.NewRenderer("", "en", time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to load templates: %v", err)
+	}
+
+	_, recipients := newRecipientFixture()
+	deliveries := NewMockDeliveryLogRepository()
+	return service.NewNotificationService(
+		[]port.ChannelSender{sender},
+		recipients,
+		renderer,
+		service.NewChannelRouter(service.DefaultRoutes()),
+		service.NewPreferenceService(NewMockPreferenceRepository(), "UTC"),
+		&MockDeferredDeliveryRepository{},
+		deliveries,
+		"USD",
+	), deliveries
+}
+
+func TestDeliveryLog_RedeliveredEventIsNotResent(t *testing.T) {
+	sender := &StubEmailSender{}
+	subscriber := newTestSubscriber(t, sender, NewMockDeadLetterQueue())
+
+	first := paymentFailedDelivery(t, 1)
+	subscriber.Process(context.Background(), first)
+	redelivered := &FakeDelivery{subject: first.subject, data: first.data, delivered: 2}
+	subscriber.Process(context.Background(), redelivered)
+
+	if !first.acked || !redelivered.acked {
+		t.Errorf("Expected both deliveries to be acked, got %+v and %+v", first, redelivered)
+	}
+	if sender.sent != 1 {
+		t.Errorf("Expected the redelivered event to be deduplicated, got %d emails", sender.sent)
+	}
+}
+
+func TestDeliveryLog_RedeliveryOnlyRetriesFailedRecipients(t *testing.T) {
+	sender := &RecordingEmailSender{failTo: "alice@sportsapp.test"}
+	notifications, deliveries := newDeliveryLogFixture(t, sender)
+	ctx := service.WithEventID(context.Background(), "evt-full-1")
+	data := port.TemplateData{Session: newStubSessionDirectory().sessions["session-1"]}
+
+	if _, err := notifications.NotifySessionParticipants(ctx, "session-1", port.TemplateSessionFull, data); err == nil {
+		t.Fatal("Expected the failed recipient to be reported")
+	}
+	if len(sender.sent) != 2 {
+		t.Fatalf("Expected 2 emails on the first attempt, got %d", len(sender.sent))
+	}
+	failed := deliveries.find("evt-full-1", "player-1", entity.ChannelEmail, port.TemplateSessionFull)
+	if failed == nil || failed.Status != entity.DeliveryStatusFailed || failed.LastError != "mailbox unavailable" {
+		t.Fatalf("Expected a failed record for alice, got %+v", failed)
+	}
+
+	sender.failTo = ""
+	sent, err := notifications.NotifySessionParticipants(ctx, "session-1", port.TemplateSessionFull, data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sent != 3 || len(sender.sent) != 3 || sender.sent[2].To != "alice@sportsapp.test" {
+		t.Fatalf("Expected only alice to be emailed again, got %d emails: %+v", len(sender.sent), sender.sent)
+	}
+
+	retried := deliveries.find("evt-full-1", "player-1", entity.ChannelEmail, port.TemplateSessionFull)
+	if retried.Status != entity.DeliveryStatusSent || retried.Attempts != 2 || retried.ProviderMessageID != "<3@sportsapp.test>" || retried.SentAt == nil {
+		t.Errorf("Expected alice's record to be sent on the second attempt, got %+v", retried)
+	}
+	host := deliveries.find("evt-full-1", "host-1", entity.ChannelEmail, port.TemplateSessionFull)
+	if host.Status != entity.DeliveryStatusSent || host.Attempts != 1 || host.ProviderMessageID != "<1@sportsapp.test>" {
+		t.Errorf("Expected the host's record untouched by the redelivery, got %+v", host)
+	}
+}
+
+func TestDeliveryLog_OneEventCanSendSeveralTypes(t *testing.T) {
+	sender := &RecordingEmailSender{}
+	notifications, deliveries := newDeliveryLogFixture(t, sender)
+	ctx := service.WithEventID(context.Background(), "evt-shared-1")
+	data := port.TemplateData{Session: newStubSessionDirectory().sessions["session-1"]}
+
+	for _, template := range []string{port.TemplateSessionFull, port.TemplateSessionCancelled} {
+		if _, err := notifications.NotifySessionParticipants(ctx, "session-1", template, data); err != nil {
+			t.Fatalf("Expected no error for %s, got %v", template, err)
+		}
+	}
+	if len(sender.sent) != 6 {
+		t.Fatalf("Expected each participant to get both emails, got %d", len(sender.sent))
+	}
+
+	for _, template := range []string{port.TemplateSessionFull, port.TemplateSessionCancelled} {
+		record := deliveries.find("evt-shared-1", "player-1", entity.ChannelEmail, template)
+		if record == nil || record.Status != entity.DeliveryStatusSent || record.Attempts != 1 {
+			t.Errorf("Expected a sent %s record for alice, got %+v", template, record)
+		}
+	}
+}
+
+func TestDeliveryLog_DeferredDeliveryIsClaimedOnFlush(t *testing.T) {
+	userID := uuid.New()
+	start, end := quietWindowAroundNow()
+	f := newChannelFixture(t, port.Recipient{UserID: userID.String(), Phone: "+77010000000"}, service.DefaultRoutes())
+	_ = f.preferences.Save(context.Background(), &entity.Preferences{UserID: userID, Timezone: "UTC", QuietHoursStart: start, QuietHoursEnd: end})
+	ctx := service.WithEventID(context.Background(), "evt-cancelled-1")
+
+	for i := 0; i < 2; i++ { // Redelivered during quiet hours
+		if err := f.service.NotifyUser(ctx, userID.String(), port.TemplateSessionCancelled, sessionCancelledData()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if len(f.deferred.deliveries) != 2 {
+		t.Fatalf("Expected push and SMS to be held once, got %d held deliveries", len(f.deferred.deliveries))
+	}
+	held := f.deliveries.find("evt-cancelled-1", userID.String(), entity.ChannelSMS, port.TemplateSessionCancelled)
+	if held == nil || held.Status != entity.DeliveryStatusDeferred {
+		t.Fatalf("Expected a deferred record for SMS, got %+v", held)
+	}
+
+	for _, delivery := range f.deferred.deliveries {
+		delivery.DeliverAfter = time.Now().Add(-time.Minute)
+	}
+	done, retry := f.service.DeliverDeferred(context.Background(), f.deferred.deliveries)
+	if len(done) != 2 || len(retry) != 0 {
+		t.Fatalf("Expected both held deliveries to be flushed, got done=%d retry=%d", len(done), len(retry))
+	}
+
+	sent := f.deliveries.find("evt-cancelled-1", userID.String(), entity.ChannelSMS, port.TemplateSessionCancelled)
+	if sent.Status != entity.DeliveryStatusSent || sent.ProviderMessageID != "fake-sms-1" || sent.Attempts != 2 {
+		t.Errorf("Expected the SMS record to be sent by the flush, got %+v", sent)
+	}
+
+	// A flush that re-runs after the outcome was recorded sends nothing
+	done, _ = f.service.DeliverDeferred(context.Background(), f.deferred.deliveries)
+	if len(done) != 2 || len(f.sms.Deliveries()) != 1 {
+		t.Errorf("Expected the repeated flush to be a no-op, got %d SMS", len(f.sms.Deliveries()))
+	}
+}
+
+func TestListDeliveries_FiltersAndValidation(t *testing.T) {
+	sender := &RecordingEmailSender{failTo: "bob@sportsapp.test"}
+	notifications, deliveries := newDeliveryLogFixture(t, sender)
+	ctx := service.WithEventID(context.Background(), "evt-full-2")
+	data := port.TemplateData{Session: newStubSessionDirectory().sessions["session-1"]}
+	_, _ = notifications.NotifySessionParticipants(ctx, "session-1", port.TemplateSessionFull, data)
+
+	list := deliveryusecase.NewListDeliveriesUseCase(deliveries)
+
+	output, err := list.Execute(context.Background(), deliverydto.ListDeliveriesInput{EventID: "evt-full-2"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if output.Total != 3 || len(output.Deliveries) != 3 {
+		t.Fatalf("Expected 3 deliveries for the event, got %+v", output)
+	}
+
+	output, err = list.Execute(context.Background(), deliverydto.ListDeliveriesInput{UserID: "player-2", Status: "failed"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(output.Deliveries) != 1 || output.Deliveries[0].LastError != "mailbox unavailable" || output.Deliveries[0].Type != port.TemplateSessionFull {
+		t.Errorf("Expected bob's failed delivery, got %+v", output.Deliveries)
+	}
+
+	invalid := []deliverydto.ListDeliveriesInput{
+		{},
+		{UserID: "player-1", Channel: "pigeon"},
+		{UserID: "player-1", Status: "lost"},
+		{UserID: "player-1", Offset: -1},
+	}
+	for _, input := range invalid {
+		if _, err := list.Execute(context.Background(), input); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+			t.Errorf("Expected invalid argument for %+v, got %v", input, err)
+		}
+	}
+}
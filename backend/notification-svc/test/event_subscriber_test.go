@@ -0,0 +1,50 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	natsadapter "github.com/diploma/notification-svc/internal/adapters/inbound/nats"
+)
+
+func TestHandleMessage_DecodesSharedEnvelope(t *testing.T) {
+	codec := sharedevents.NewCodec("reservation-svc")
+	startTime := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+
+	data, err := codec.Encode(context.Background(), sharedevents.SubjectReservationCreated, sharedevents.ReservationCreated{
+		ReservationID: "res-1",
+		UserID:        "user-1",
+		StartTime:     startTime,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var received sharedevents.ReservationCreated
+	err = natsadapter.HandleMessage(context.Background(), sharedevents.SubjectReservationCreated, data, func(ctx context.Context, envelope *sharedevents.Envelope) error {
+		return envelope.UnmarshalPayload(&received)
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if received.ReservationID != "res-1" || !received.StartTime.Equal(startTime) {
+		t.Errorf("Unexpected payload: %+v", received)
+	}
+}
+
+func TestHandleMessage_RejectsLegacyPayload(t *testing.T) {
+	legacy := []byte(`{"reservation_id":"res-1","user_id":"user-1"}`)
+
+	called := false
+	err := natsadapter.HandleMessage(context.Background(), sharedevents.SubjectReservationCreated, legacy, func(ctx context.Context, envelope *sharedevents.Envelope) error {
+		called = true
+		return nil
+	})
+
+	if err == nil || called {
+		t.Errorf("Expected un-enveloped payload to be rejected, got err=%v called=%v", err, called)
+	}
+}
@@ -0,0 +1,326 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	natsadapter "github.com/diploma/notification-svc/internal/adapters/inbound/nats"
+	"github.com/diploma/notification-svc/internal/application/deadletter/dto"
+	"github.com/diploma/notification-svc/internal/application/deadletter/usecase"
+	"github.com/diploma/notification-svc/internal/application/event/handler"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/entity"
+	"github.com/diploma/notification-svc/internal/domain/deadletter/port"
+	notificationentity "github.com/diploma/notification-svc/internal/domain/notification/entity"
+	notificationport "github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type StubEmailSender struct {
+	err  error
+	sent int
+}
+
+func (s *StubEmailSender) Channel() notificationentity.Channel {
+	return notificationentity.ChannelEmail
+}
+
+func (s *StubEmailSender) Send(ctx context.Context, recipient *notificationport.Recipient, notificationType string, message *notificationport.Message) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	s.sent++
+	return fmt.Sprintf("stub-%d", s.sent), nil
+}
+
+type MockDeadLetterQueue struct {
+	letters map[uint64]*entity.DeadLetter
+	nextSeq uint64
+	addErr  error
+}
+
+func NewMockDeadLetterQueue() *MockDeadLetterQueue {
+	return &MockDeadLetterQueue{letters: make(map[uint64]*entity.DeadLetter)}
+}
+
+func (q *MockDeadLetterQueue) Add(ctx context.Context, letter *entity.DeadLetter) error {
+	if q.addErr != nil {
+		return q.addErr
+	}
+	q.nextSeq++
+	letter.Sequence = q.nextSeq
+	q.letters[letter.Sequence] = letter
+	return nil
+}
+
+func (q *MockDeadLetterQueue) List(ctx context.Context, subject string, afterSequence uint64, limit int) ([]*entity.DeadLetter, error) {
+	var letters []*entity.DeadLetter
+	for seq := afterSequence + 1; seq <= q.nextSeq && len(letters) < limit; seq++ {
+		if letter, ok := q.letters[seq]; ok && (subject == "" || letter.Subject == subject) {
+			letters = append(letters, letter)
+		}
+	}
+	return letters, nil
+}
+
+func (q *MockDeadLetterQueue) Get(ctx context.Context, sequence uint64) (*entity.DeadLetter, error) {
+	letter, ok := q.letters[sequence]
+	if !ok {
+		return nil, pkgerrors.NewNotFoundError("dead letter not found")
+	}
+	return letter, nil
+}
+
+func (q *MockDeadLetterQueue) Delete(ctx context.Context, sequence uint64) error {
+	if _, ok := q.letters[sequence]; !ok {
+		return pkgerrors.NewNotFoundError("dead letter not found")
+	}
+	delete(q.letters, sequence)
+	return nil
+}
+
+type RecordingRepublisher struct {
+	subjects []string
+}
+
+func (r *RecordingRepublisher) Republish(ctx context.Context, subject string, data []byte) error {
+	r.subjects = append(r.subjects, subject)
+	return nil
+}
+
+var _ port.DeadLetterQueue = (*MockDeadLetterQueue)(nil)
+var _ port.EventRepublisher = (*RecordingRepublisher)(nil)
+
+// FakeDelivery records how the subscriber settles a JetStream message
+type FakeDelivery struct {
+	subject   string
+	data      []byte
+	delivered uint64
+
+	acked  bool
+	termed bool
+	naked  bool
+	delay  time.Duration
+}
+
+func (d *FakeDelivery) Subject() string { return d.subject }
+func (d *FakeDelivery) Data() []byte    { return d.data }
+
+func (d *FakeDelivery) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{
+		Sequence:     jetstream.SequencePair{Stream: 42, Consumer: d.delivered},
+		NumDelivered: d.delivered,
+		Stream:       sharedevents.StreamPayments,
+	}, nil
+}
+
+func (d *FakeDelivery) Ack() error {
+	d.acked = true
+	return nil
+}
+
+func (d *FakeDelivery) NakWithDelay(delay time.Duration) error {
+	d.naked = true
+	d.delay = delay
+	return nil
+}
+
+func (d *FakeDelivery) Term() error {
+	d.termed = true
+	return nil
+}
+
+var retryPolicy = natsadapter.RetryPolicy{
+	MaxDeliver: 4,
+	AckWait:    30 * time.Second,
+	BaseDelay:  2 * time.Second,
+	MaxDelay:   10 * time.Second,
+}
+
+func newTestSubscriber(t *testing.T, sender *StubEmailSender, queue *MockDeadLetterQueue) *natsadapter.EventSubscriber {
+	notifications, details := newNotificationFixture(t, sender)
+	reminders := service.NewReminderService(&MockReminderRepository{}, notifications, details, reminderLeads, hostWarningLead)
+	return natsadapter.NewEventSubscriber(
+		nil,
+		natsadapter.SubscriberOptions{Durable: "notification-svc", Retry: retryPolicy},
+		queue,
+		handler.NewReservationEventHandler(notifications, details),
+		handler.NewSessionEventHandler(notifications, details, reminders),
+		handler.NewPaymentEventHandler(notifications, details),
+		handler.NewUserEventHandler(notifications, newTestSealer(t), "https://app.example.com"),
+	)
+}
+
+func paymentFailedDelivery(t *testing.T, delivered uint64) *FakeDelivery {
+	t.Helper()
+
+	data, err := sharedevents.NewCodec("payment-svc").Encode(context.Background(), sharedevents.SubjectPaymentFailed, sharedevents.PaymentFailed{
+		PaymentID: "pay-1",
+		SessionID: "session-1",
+		UserID:    "player-1",
+		Reason:    "Your card was declined.",
+	})
+	if err != nil {
+		t.Fatalf("Failed to encode event: %v", err)
+	}
+
+	return &FakeDelivery{subject: sharedevents.SubjectPaymentFailed, data: data, delivered: delivered}
+}
+
+func TestRetryPolicy_ExponentialBackoff(t *testing.T) {
+	expected := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, want := range expected {
+		if got := retryPolicy.Delay(i + 1); got != want {
+			t.Errorf("Delivery %d: expected %v, got %v", i+1, want, got)
+		}
+	}
+}
+
+func TestProcess_AcksHandledEvent(t *testing.T) {
+	sender := &StubEmailSender{}
+	queue := NewMockDeadLetterQueue()
+	msg := paymentFailedDelivery(t, 1)
+
+	newTestSubscriber(t, sender, queue).Process(context.Background(), msg)
+
+	if !msg.acked || msg.naked || msg.termed {
+		t.Errorf("Expected ack only, got %+v", msg)
+	}
+	if sender.sent != 1 {
+		t.Errorf("Expected 1 email, got %d", sender.sent)
+	}
+}
+
+func TestProcess_NaksTransientFailureWithBackoff(t *testing.T) {
+	sender := &StubEmailSender{err: errors.New("smtp unavailable")}
+	queue := NewMockDeadLetterQueue()
+	msg := paymentFailedDelivery(t, 2)
+
+	newTestSubscriber(t, sender, queue).Process(context.Background(), msg)
+
+	if !msg.naked || msg.acked || msg.termed {
+		t.Fatalf("Expected nak only, got %+v", msg)
+	}
+	if msg.delay != 4*time.Second {
+		t.Errorf("Expected 4s redelivery delay, got %v", msg.delay)
+	}
+	if len(queue.letters) != 0 {
+		t.Error("Expected nothing to be dead-lettered before max deliveries")
+	}
+}
+
+func TestProcess_DeadLettersAfterMaxDeliveries(t *testing.T) {
+	sender := &StubEmailSender{err: errors.New("smtp unavailable")}
+	queue := NewMockDeadLetterQueue()
+	msg := paymentFailedDelivery(t, uint64(retryPolicy.MaxDeliver))
+
+	newTestSubscriber(t, sender, queue).Process(context.Background(), msg)
+
+	if !msg.termed || msg.acked || msg.naked {
+		t.Fatalf("Expected term only, got %+v", msg)
+	}
+
+	letter, ok := queue.letters[1]
+	if !ok {
+		t.Fatal("Expected message to be dead-lettered")
+	}
+	if letter.Subject != sharedevents.SubjectPaymentFailed || letter.Deliveries != retryPolicy.MaxDeliver || letter.StreamSequence != 42 {
+		t.Errorf("Unexpected dead letter: %+v", letter)
+	}
+	if letter.Error != "email: smtp unavailable" || string(letter.Data) != string(msg.data) {
+		t.Errorf("Expected original payload and error to be kept, got %+v", letter)
+	}
+}
+
+func TestProcess_DeadLettersMalformedEventImmediately(t *testing.T) {
+	queue := NewMockDeadLetterQueue()
+	msg := &FakeDelivery{subject: sharedevents.SubjectPaymentFailed, data: []byte(`{"payment_id":`), delivered: 1}
+
+	newTestSubscriber(t, &StubEmailSender{}, queue).Process(context.Background(), msg)
+
+	if !msg.termed || len(queue.letters) != 1 {
+		t.Errorf("Expected malformed event to be dead-lettered on first delivery, got %+v", msg)
+	}
+}
+
+func TestProcess_RetriesWhenDeadLetterQueueUnavailable(t *testing.T) {
+	queue := NewMockDeadLetterQueue()
+	queue.addErr = errors.New("stream unavailable")
+	msg := paymentFailedDelivery(t, uint64(retryPolicy.MaxDeliver))
+
+	newTestSubscriber(t, &StubEmailSender{err: errors.New("smtp unavailable")}, queue).Process(context.Background(), msg)
+
+	if !msg.naked || msg.termed {
+		t.Errorf("Expected message to stay in its stream, got %+v", msg)
+	}
+}
+
+func TestProcess_DeadLettersUnknownRecipientImmediately(t *testing.T) {
+	queue := NewMockDeadLetterQueue()
+	data, _ := sharedevents.NewCodec("session-svc").Encode(context.Background(), sharedevents.SubjectSessionLeft, sharedevents.SessionLeft{
+		SessionID: "session-1",
+		UserID:    "deleted-user",
+	})
+	msg := &FakeDelivery{subject: sharedevents.SubjectSessionLeft, data: data, delivered: 1}
+
+	newTestSubscriber(t, &StubEmailSender{}, queue).Process(context.Background(), msg)
+
+	if !msg.termed || len(queue.letters) != 1 {
+		t.Errorf("Expected unknown recipient to be dead-lettered without retries, got %+v", msg)
+	}
+}
+
+func TestReplayDeadLetter_RepublishesAndRemoves(t *testing.T) {
+	queue := NewMockDeadLetterQueue()
+	_ = queue.Add(context.Background(), &entity.DeadLetter{Subject: sharedevents.SubjectSessionFull, Data: []byte(`{}`)})
+	republisher := &RecordingRepublisher{}
+
+	output, err := usecase.NewReplayDeadLetterUseCase(queue, republisher).Execute(context.Background(), dto.ReplayDeadLetterInput{Sequence: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !output.Success || len(republisher.subjects) != 1 || republisher.subjects[0] != sharedevents.SubjectSessionFull {
+		t.Errorf("Expected event to be republished to its original subject, got %v", republisher.subjects)
+	}
+	if len(queue.letters) != 0 {
+		t.Error("Expected dead letter to be removed after replay")
+	}
+
+	_, err = usecase.NewReplayDeadLetterUseCase(queue, republisher).Execute(context.Background(), dto.ReplayDeadLetterInput{Sequence: 1})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+		t.Errorf("Expected NotFound for replayed letter, got %v", err)
+	}
+}
+
+func TestProcess_DeadLettersAccountTokenEventsWithoutPayload(t *testing.T) {
+	queue := NewMockDeadLetterQueue()
+	other, _ := sharedevents.NewSealer("another-secret")
+	sealed, _ := other.Seal(sharedevents.SubjectUserPasswordResetRequested, "reset-token")
+	data, _ := sharedevents.NewCodec("auth-svc").Encode(context.Background(), sharedevents.SubjectUserPasswordResetRequested, sharedevents.UserPasswordResetRequested{
+		UserID:      "player-1",
+		SealedToken: sealed,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	msg := &FakeDelivery{subject: sharedevents.SubjectUserPasswordResetRequested, data: data, delivered: 1}
+
+	newTestSubscriber(t, &StubEmailSender{}, queue).Process(context.Background(), msg)
+
+	letter, ok := queue.letters[1]
+	if !msg.termed || !ok {
+		t.Fatalf("Expected unsealable token to be dead-lettered, got %+v", msg)
+	}
+	if len(letter.Data) != 0 {
+		t.Errorf("Expected the payload not to be kept, got %s", letter.Data)
+	}
+
+	_, err := usecase.NewReplayDeadLetterUseCase(queue, &RecordingRepublisher{}).Execute(context.Background(), dto.ReplayDeadLetterInput{Sequence: 1})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected InvalidArgument when replaying a letter without payload, got %v", err)
+	}
+}
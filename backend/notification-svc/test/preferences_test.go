@@ -0,0 +1,340 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	deliverydto "github.com/diploma/notification-svc/internal/application/delivery/dto"
+	deliveryusecase "github.com/diploma/notification-svc/internal/application/delivery/usecase"
+	"github.com/diploma/notification-svc/internal/application/preference/dto"
+	"github.com/diploma/notification-svc/internal/application/preference/usecase"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+type MockPreferenceRepository struct {
+	mu          sync.Mutex
+	preferences map[uuid.UUID]*entity.Preferences
+}
+
+func NewMockPreferenceRepository() *MockPreferenceRepository {
+	return &MockPreferenceRepository{preferences: make(map[uuid.UUID]*entity.Preferences)}
+}
+
+func (r *MockPreferenceRepository) Get(ctx context.Context, userID uuid.UUID) (*entity.Preferences, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	preferences, ok := r.preferences[userID]
+	if !ok {
+		return nil, pkgerrors.NewNotFoundError("preferences not found")
+	}
+	copied := *preferences
+	return &copied, nil
+}
+
+func (r *MockPreferenceRepository) Save(ctx context.Context, preferences *entity.Preferences) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *preferences
+	r.preferences[preferences.UserID] = &copied
+	return nil
+}
+
+type MockDeferredDeliveryRepository struct {
+	mu         sync.Mutex
+	deliveries []*entity.DeferredDelivery
+}
+
+func (r *MockDeferredDeliveryRepository) Create(ctx context.Context, delivery *entity.DeferredDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+func (r *MockDeferredDeliveryRepository) FetchDue(ctx context.Context, now time.Time, limit int) ([]*entity.DeferredDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*entity.DeferredDelivery
+	for _, delivery := range r.deliveries {
+		if !delivery.DeliverAfter.After(now) && len(due) < limit {
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *MockDeferredDeliveryRepository) Delete(ctx context.Context, ids []uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	remove := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		remove[id] = true
+	}
+	kept := r.deliveries[:0]
+	for _, delivery := range r.deliveries {
+		if !remove[delivery.ID] {
+			kept = append(kept, delivery)
+		}
+	}
+	r.deliveries = kept
+	return nil
+}
+
+func (r *MockDeferredDeliveryRepository) Reschedule(ctx context.Context, ids []uuid.UUID, deliverAfter time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, delivery := range r.deliveries {
+		for _, id := range ids {
+			if delivery.ID == id {
+				delivery.Attempts++
+				delivery.DeliverAfter = deliverAfter
+			}
+		}
+	}
+	return nil
+}
+
+type MockTransactionManager struct{}
+
+func (m *MockTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+var _ port.PreferenceRepository = (*MockPreferenceRepository)(nil)
+var _ port.DeferredDeliveryRepository = (*MockDeferredDeliveryRepository)(nil)
+
+// quietWindowAroundNow returns a quiet-hours window in UTC that contains the current time
+func quietWindowAroundNow() (string, string) {
+	now := time.Now().UTC()
+	return now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04")
+}
+
+func TestPreferences_QuietHoursWrapMidnight(t *testing.T) {
+	preferences := &entity.Preferences{Timezone: "Asia/Almaty", QuietHoursStart: "22:00", QuietHoursEnd: "07:00"}
+	almaty, _ := time.LoadLocation("Asia/Almaty")
+
+	until, quiet := preferences.QuietUntil(time.Date(2026, 3, 14, 23, 30, 0, 0, almaty))
+	if !quiet || !until.Equal(time.Date(2026, 3, 15, 7, 0, 0, 0, almaty)) {
+		t.Errorf("Expected quiet until next morning, got %v %v", quiet, until)
+	}
+
+	until, quiet = preferences.QuietUntil(time.Date(2026, 3, 15, 3, 0, 0, 0, almaty))
+	if !quiet || !until.Equal(time.Date(2026, 3, 15, 7, 0, 0, 0, almaty)) {
+		t.Errorf("Expected quiet until the same morning, got %v %v", quiet, until)
+	}
+
+	if _, quiet := preferences.QuietUntil(time.Date(2026, 3, 15, 12, 0, 0, 0, almaty)); quiet {
+		t.Error("Expected midday to be outside quiet hours")
+	}
+}
+
+func TestPreferences_NextDigestAt(t *testing.T) {
+	preferences := &entity.Preferences{Timezone: "Asia/Almaty", DigestHour: 8}
+	almaty, _ := time.LoadLocation("Asia/Almaty")
+
+	if got := preferences.NextDigestAt(time.Date(2026, 3, 14, 6, 0, 0, 0, almaty)); !got.Equal(time.Date(2026, 3, 14, 8, 0, 0, 0, almaty)) {
+		t.Errorf("Expected digest later the same day, got %v", got)
+	}
+	if got := preferences.NextDigestAt(time.Date(2026, 3, 14, 8, 0, 0, 0, almaty)); !got.Equal(time.Date(2026, 3, 15, 8, 0, 0, 0, almaty)) {
+		t.Errorf("Expected digest the next day, got %v", got)
+	}
+}
+
+func TestNotificationService_SkipsDisabledChannels(t *testing.T) {
+	userID := uuid.New()
+	f := newChannelFixture(t, port.Recipient{UserID: userID.String(), Email: "alice@sportsapp.test", Phone: "+77010000000"}, service.DefaultRoutes())
+	_ = f.preferences.Save(context.Background(), &entity.Preferences{
+		UserID:   userID,
+		Timezone: "UTC",
+		Channels: []entity.ChannelPreference{
+			{Category: entity.CategorySession, Channel: entity.ChannelSMS, Enabled: false, Delivery: entity.DeliveryImmediate},
+			{Category: entity.CategorySession, Channel: entity.ChannelPush, Enabled: false, Delivery: entity.DeliveryImmediate},
+		},
+	})
+
+	if err := f.service.NotifyUser(context.Background(), userID.String(), port.TemplateSessionCancelled, sessionCancelledData()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(f.sms.Deliveries()) != 0 || len(f.push.Deliveries()) != 0 {
+		t.Error("Expected opted-out channels to be skipped")
+	}
+	if len(f.email.Deliveries()) != 1 || len(f.inbox.notifications) != 1 {
+		t.Error("Expected other channels to keep delivering")
+	}
+
+	if err := f.service.NotifyUser(context.Background(), userID.String(), port.TemplatePaymentFailed, sessionCancelledData()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(f.push.Deliveries()) != 1 {
+		t.Error("Expected opt-out to be limited to the session category")
+	}
+}
+
+func TestNotificationService_QuietHoursHoldBackPhoneChannels(t *testing.T) {
+	userID := uuid.New()
+	start, end := quietWindowAroundNow()
+	f := newChannelFixture(t, port.Recipient{UserID: userID.String(), Email: "alice@sportsapp.test", Phone: "+77010000000"}, service.DefaultRoutes())
+	_ = f.preferences.Save(context.Background(), &entity.Preferences{UserID: userID, Timezone: "UTC", QuietHoursStart: start, QuietHoursEnd: end})
+
+	if err := f.service.NotifyUser(context.Background(), userID.String(), port.TemplateSessionCancelled, sessionCancelledData()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(f.push.Deliveries()) != 0 || len(f.sms.Deliveries()) != 0 {
+		t.Error("Expected push and SMS to wait for quiet hours to end")
+	}
+	if len(f.email.Deliveries()) != 1 || len(f.inbox.notifications) != 1 {
+		t.Error("Expected email and in-app to be delivered during quiet hours")
+	}
+	if len(f.deferred.deliveries) != 2 {
+		t.Fatalf("Expected 2 held deliveries, got %d", len(f.deferred.deliveries))
+	}
+	for _, held := range f.deferred.deliveries {
+		if held.Digest || !held.DeliverAfter.After(time.Now()) || held.Short == "" {
+			t.Errorf("Unexpected held delivery: %+v", held)
+		}
+	}
+
+	// Once the window has passed, the flusher sends the stored message unchanged
+	for _, held := range f.deferred.deliveries {
+		held.DeliverAfter = time.Now().Add(-time.Minute)
+	}
+	flush := deliveryusecase.NewFlushDeferredUseCase(f.service, f.deferred, &MockTransactionManager{}, time.Minute, 3)
+	output, err := flush.Execute(context.Background(), deliverydto.FlushDeferredInput{BatchSize: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if output.Settled != 2 || len(f.deferred.deliveries) != 0 {
+		t.Errorf("Expected both held deliveries to be flushed, got %+v", output)
+	}
+	if len(f.sms.Deliveries()) != 1 || f.sms.Deliveries()[0].Type != port.TemplateSessionCancelled {
+		t.Error("Expected the held SMS to be sent after quiet hours")
+	}
+}
+
+func TestNotificationService_DigestCollectsEmails(t *testing.T) {
+	userID := uuid.New()
+	f := newChannelFixture(t, port.Recipient{UserID: userID.String(), Email: "alice@sportsapp.test"}, service.DefaultRoutes())
+	_ = f.preferences.Save(context.Background(), &entity.Preferences{
+		UserID:     userID,
+		Timezone:   "UTC",
+		DigestHour: 8,
+		Channels: []entity.ChannelPreference{
+			{Category: entity.CategorySession, Channel: entity.ChannelEmail, Enabled: true, Delivery: entity.DeliveryDigest},
+		},
+	})
+
+	for _, template := range []string{port.TemplateSessionFull, port.TemplateSessionCancelled} {
+		if err := f.service.NotifyUser(context.Background(), userID.String(), template, sessionCancelledData()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if len(f.email.Deliveries()) != 0 || len(f.deferred.deliveries) != 2 {
+		t.Fatalf("Expected emails to be collected for the digest, got %d sent and %d held", len(f.email.Deliveries()), len(f.deferred.deliveries))
+	}
+
+	for _, held := range f.deferred.deliveries {
+		if !held.Digest || held.DeliverAfter.UTC().Hour() != 8 {
+			t.Errorf("Expected digest entry due at 08:00, got %+v", held)
+		}
+		held.DeliverAfter = time.Now().Add(-time.Minute)
+	}
+
+	done, retry := f.service.DeliverDeferred(context.Background(), f.deferred.deliveries)
+	if len(done) != 2 || len(retry) != 0 {
+		t.Fatalf("Expected digest to settle both entries, got done=%d retry=%d", len(done), len(retry))
+	}
+
+	emails := f.email.Deliveries()
+	if len(emails) != 1 || emails[0].Type != port.TemplateDigest {
+		t.Fatalf("Expected one digest email, got %+v", emails)
+	}
+	if emails[0].Message.Subject != "Your SportsApp digest: 2 updates" {
+		t.Errorf("Unexpected digest subject %q", emails[0].Message.Subject)
+	}
+}
+
+func TestFlushDeferred_RetriesThenGivesUp(t *testing.T) {
+	userID := uuid.New()
+	f := newChannelFixture(t, port.Recipient{UserID: userID.String(), Phone: "+77010000000"}, service.DefaultRoutes())
+	f.sms.FailWith(pkgerrors.NewExternalAPIError("gateway down", nil))
+
+	held := entity.NewDeferredDelivery("evt-1", userID, entity.ChannelSMS, port.TemplateSessionCancelled, false, time.Now().Add(-time.Minute))
+	_ = f.deferred.Create(context.Background(), held)
+
+	flush := deliveryusecase.NewFlushDeferredUseCase(f.service, f.deferred, &MockTransactionManager{}, -time.Second, 2)
+
+	output, err := flush.Execute(context.Background(), deliverydto.FlushDeferredInput{BatchSize: 10})
+	if err != nil || output.Retried != 1 || held.Attempts != 1 {
+		t.Fatalf("Expected a retry on first failure, got %+v, %v", output, err)
+	}
+
+	output, err = flush.Execute(context.Background(), deliverydto.FlushDeferredInput{BatchSize: 10})
+	if err != nil || output.Settled != 1 || len(f.deferred.deliveries) != 0 {
+		t.Errorf("Expected delivery to be dropped after max attempts, got %+v, %v", output, err)
+	}
+}
+
+func TestPreferencesUseCases_DefaultsAndValidation(t *testing.T) {
+	preferenceService := service.NewPreferenceService(NewMockPreferenceRepository(), "Asia/Almaty")
+	get := usecase.NewGetPreferencesUseCase(preferenceService)
+	update := usecase.NewUpdatePreferencesUseCase(preferenceService)
+	userID := uuid.NewString()
+
+	defaults, err := get.Execute(context.Background(), dto.GetPreferencesInput{UserID: userID})
+	if err != nil {
+		t.Fatalf("Expected defaults, got %v", err)
+	}
+	if defaults.Timezone != "Asia/Almaty" || len(defaults.Channels) != len(entity.Categories)*len(entity.Channels) {
+		t.Errorf("Unexpected defaults: %+v", defaults)
+	}
+	for _, rule := range defaults.Channels {
+		if !rule.Enabled || rule.Delivery != string(entity.DeliveryImmediate) {
+			t.Errorf("Expected every channel enabled and immediate by default, got %+v", rule)
+		}
+	}
+
+	invalid := []dto.UpdatePreferencesInput{
+		{UserID: userID, Timezone: "Mars/Olympus"},
+		{UserID: userID, QuietHoursStart: "22:00"},
+		{UserID: userID, QuietHoursStart: "25:00", QuietHoursEnd: "07:00"},
+		{UserID: userID, DigestHour: 24},
+		{UserID: userID, Channels: []dto.ChannelPreference{{Category: "session", Channel: "push", Enabled: true, Delivery: "digest"}}},
+		{UserID: userID, Channels: []dto.ChannelPreference{{Category: "weather", Channel: "email", Enabled: true}}},
+		{UserID: userID, Channels: []dto.ChannelPreference{{Category: "session", Channel: "email"}, {Category: "session", Channel: "email"}}},
+	}
+	for _, input := range invalid {
+		if _, err := update.Execute(context.Background(), input); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+			t.Errorf("Expected %+v to be rejected, got %v", input, err)
+		}
+	}
+
+	_, err = update.Execute(context.Background(), dto.UpdatePreferencesInput{
+		UserID:          userID,
+		QuietHoursStart: "22:00",
+		QuietHoursEnd:   "07:00",
+		DigestHour:      9,
+		Channels:        []dto.ChannelPreference{{Category: "payment", Channel: "sms", Enabled: false}},
+	})
+	if err != nil {
+		t.Fatalf("Expected update to succeed, got %v", err)
+	}
+
+	saved, _ := get.Execute(context.Background(), dto.GetPreferencesInput{UserID: userID})
+	if saved.QuietHoursStart != "22:00" || saved.DigestHour != 9 || saved.Timezone != "Asia/Almaty" || saved.UpdatedAt.IsZero() {
+		t.Errorf("Unexpected saved preferences: %+v", saved)
+	}
+	for _, rule := range saved.Channels {
+		disabled := rule.Category == "payment" && rule.Channel == "sms"
+		if rule.Enabled == disabled {
+			t.Errorf("Unexpected rule %+v", rule)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/auth"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/templates"
+	"github.com/diploma/notification-svc/internal/application/event/handler"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+type StubUserDirectory struct {
+	emails map[string]string
+	calls  int
+}
+
+func NewStubUserDirectory(emails map[string]string) *StubUserDirectory {
+	return &StubUserDirectory{emails: emails}
+}
+
+func (d *StubUserDirectory) GetRecipient(ctx context.Context, userID string) (*port.Recipient, error) {
+	d.calls++
+	email, ok := d.emails[userID]
+	if !ok {
+		return nil, pkgerrors.NewNotFoundError("user not found")
+	}
+	return &port.Recipient{UserID: userID, Email: email}, nil
+}
+
+type StubSessionDirectory struct {
+	sessions     map[string]*port.SessionDetails
+	participants map[string][]string
+}
+
+func (d *StubSessionDirectory) GetSession(ctx context.Context, sessionID string) (*port.SessionDetails, error) {
+	session, ok := d.sessions[sessionID]
+	if !ok {
+		return nil, pkgerrors.NewNotFoundError("session not found")
+	}
+	details := *session
+	return &details, nil
+}
+
+func (d *StubSessionDirectory) ListParticipantIDs(ctx context.Context, sessionID string) ([]string, error) {
+	userIDs, ok := d.participants[sessionID]
+	if !ok {
+		return nil, pkgerrors.NewNotFoundError("session not found")
+	}
+	return userIDs, nil
+}
+
+type RecordingEmailSender struct {
+	sent   []port.EmailNotification
+	failTo string
+}
+
+func (s *RecordingEmailSender) Channel() entity.Channel {
+	return entity.ChannelEmail
+}
+
+func (s *RecordingEmailSender) Send(ctx context.Context, recipient *port.Recipient, notificationType string, message *port.Message) (string, error) {
+	if recipient.Email == s.failTo {
+		return "", errors.New("mailbox unavailable")
+	}
+	s.sent = append(s.sent, port.EmailNotification{
+		To:       recipient.Email,
+		Subject:  message.Subject,
+		Body:     message.Text,
+		HTMLBody: message.HTML,
+	})
+	return fmt.Sprintf("<%d@sportsapp.test>", len(s.sent)), nil
+}
+
+var _ port.UserDirectory = (*StubUserDirectory)(nil)
+var _ port.SessionDirectory = (*StubSessionDirectory)(nil)
+var _ port.RecipientResolver = (*service.RecipientService)(nil)
+
+func newRecipientFixture() (*StubUserDirectory, *service.RecipientService) {
+	users := NewStubUserDirectory(map[string]string{
+		"host-1":   "host@sportsapp.test",
+		"player-1": "alice@sportsapp.test",
+		"player-2": "bob@sportsapp.test",
+	})
+	return users, service.NewRecipientService(users, newStubSessionDirectory())
+}
+
+func newStubSessionDirectory() *StubSessionDirectory {
+	return &StubSessionDirectory{
+		sessions: map[string]*port.SessionDetails{
+			"session-1": {
+				ID:                  "session-1",
+				SportType:           "football",
+				SkillLevel:          "intermediate",
+				PricePerParticipant: 12.5,
+				MaxParticipants:     10,
+				CurrentParticipants: 3,
+				ReservationID:       "res-1",
+			},
+		},
+		participants: map[string][]string{
+			"session-1": {"host-1", "player-1", "player-2", "deleted-user"},
+		},
+	}
+}
+
+// newNotificationFixture wires the real services over stub directories, the embedded templates and the default routes
+func newNotificationFixture(t *testing.T, senders ...port.ChannelSender) (*service.NotificationService, *service.EventDetailsService) {
+	t.Helper()
+
+	renderer, err := templates.NewRenderer("", "en", time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to load templates: %v", err)
+	}
+
+	_, recipients := newRecipientFixture()
+	details := service.NewEventDetailsService(newStubSessionDirectory(), &StubReservationDirectory{}, &StubVenueDirectory{})
+	return service.NewNotificationService(
+		senders,
+		recipients,
+		renderer,
+		service.NewChannelRouter(service.DefaultRoutes()),
+		service.NewPreferenceService(NewMockPreferenceRepository(), "UTC"),
+		&MockDeferredDeliveryRepository{},
+		NewMockDeliveryLogRepository(),
+		"USD",
+	), details
+}
+
+type StubReservationDirectory struct{}
+
+func (d *StubReservationDirectory) GetReservation(ctx context.Context, reservationID string) (*port.ReservationDetails, error) {
+	start := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	return &port.ReservationDetails{
+		ID:         reservationID,
+		ResourceID: "court-1",
+		StartTime:  start,
+		EndTime:    start.Add(90 * time.Minute),
+	}, nil
+}
+
+type StubVenueDirectory struct{}
+
+func (d *StubVenueDirectory) GetResourceVenue(ctx context.Context, resourceID string) (*port.VenueDetails, error) {
+	return &port.VenueDetails{
+		VenueName:    "Central Sports Park",
+		ResourceName: "Court 1",
+		Address:      "12 Abay Ave",
+		City:         "Almaty",
+	}, nil
+}
+
+func TestSessionFull_FansOutToEveryParticipant(t *testing.T) {
+	sender := &RecordingEmailSender{}
+
+	err := newSessionEventHandler(t, sender).HandleSessionFull(context.Background(), sharedevents.SessionFull{SessionID: "session-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	got := map[string]bool{}
+	for _, notification := range sender.sent {
+		got[notification.To] = true
+	}
+	for _, want := range []string{"host@sportsapp.test", "alice@sportsapp.test", "bob@sportsapp.test"} {
+		if !got[want] {
+			t.Errorf("Expected email to %s, got %v", want, sender.sent)
+		}
+	}
+	if len(sender.sent) != 3 {
+		t.Errorf("Expected 3 emails, got %d", len(sender.sent))
+	}
+}
+
+func TestSessionCancelled_ReportsPartialFailure(t *testing.T) {
+	sender := &RecordingEmailSender{failTo: "alice@sportsapp.test"}
+
+	err := newSessionEventHandler(t, sender).HandleSessionCancelled(context.Background(), sharedevents.SessionCancelled{SessionID: "session-1"})
+	if err == nil {
+		t.Fatal("Expected failed delivery to be reported")
+	}
+
+	if len(sender.sent) != 2 {
+		t.Errorf("Expected remaining participants to be notified, got %d emails", len(sender.sent))
+	}
+}
+
+func TestPaymentSucceeded_UsesProfileEmail(t *testing.T) {
+	sender := &RecordingEmailSender{}
+
+	err := handler.NewPaymentEventHandler(newNotificationFixture(t, sender)).HandlePaymentSucceeded(context.Background(), sharedevents.PaymentSucceeded{
+		PaymentID: "pay-1",
+		SessionID: "session-1",
+		UserID:    "player-2",
+		Amount:    12.5,
+		Currency:  "EUR",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(sender.sent) != 1 || sender.sent[0].To != "bob@sportsapp.test" {
+		t.Fatalf("Expected email to bob@sportsapp.test, got %v", sender.sent)
+	}
+	if !strings.Contains(sender.sent[0].Subject, "12.50 EUR") {
+		t.Errorf("Expected the event's currency in the subject, got %q", sender.sent[0].Subject)
+	}
+}
+
+func TestPasswordResetRequested_EmailsEscapedLink(t *testing.T) {
+	sender := &RecordingEmailSender{}
+	notifications, _ := newNotificationFixture(t, sender)
+	sealer := newTestSealer(t)
+	sealed, _ := sealer.Seal(sharedevents.SubjectUserPasswordResetRequested, "a+b/c=")
+
+	err := handler.NewUserEventHandler(notifications, sealer, "https://app.example.com").HandlePasswordResetRequested(context.Background(), sharedevents.UserPasswordResetRequested{
+		UserID:      "player-1",
+		Email:       "alice@sportsapp.test",
+		SealedToken: sealed,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(sender.sent) != 1 || sender.sent[0].To != "alice@sportsapp.test" {
+		t.Fatalf("Expected one email to alice@sportsapp.test, got %v", sender.sent)
+	}
+	if link := "https://app.example.com/reset-password?token=a%2Bb%2Fc%3D"; !strings.Contains(sender.sent[0].Body, link) {
+		t.Errorf("Expected the body to contain %s, got %q", link, sender.sent[0].Body)
+	}
+}
+
+func TestPasswordResetRequested_UnsealableTokenIsPermanent(t *testing.T) {
+	sender := &RecordingEmailSender{}
+	notifications, _ := newNotificationFixture(t, sender)
+	other, _ := sharedevents.NewSealer("another-secret")
+	sealed, _ := other.Seal(sharedevents.SubjectUserPasswordResetRequested, "reset-token")
+
+	err := handler.NewUserEventHandler(notifications, newTestSealer(t), "https://app.example.com").HandlePasswordResetRequested(context.Background(), sharedevents.UserPasswordResetRequested{
+		UserID:      "player-1",
+		SealedToken: sealed,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+	if len(sender.sent) != 0 {
+		t.Errorf("Expected no email, got %v", sender.sent)
+	}
+}
+
+func newTestSealer(t *testing.T) *sharedevents.Sealer {
+	t.Helper()
+	sealer, err := sharedevents.NewSealer("test-seal-secret")
+	if err != nil {
+		t.Fatalf("Failed to create sealer: %v", err)
+	}
+	return sealer
+}
+
+func TestResolveUser_UnknownUserIsNotFound(t *testing.T) {
+	_, recipients := newRecipientFixture()
+
+	_, err := recipients.ResolveUser(context.Background(), "deleted-user")
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
+
+func TestCachedUserDirectory_ServesRepeatLookupsFromCache(t *testing.T) {
+	users := NewStubUserDirectory(map[string]string{"player-1": "alice@sportsapp.test"})
+	cached := auth.NewCachedUserDirectory(users, time.Minute, 10)
+
+	for i := 0; i < 3; i++ {
+		recipient, err := cached.GetRecipient(context.Background(), "player-1")
+		if err != nil || recipient.Email != "alice@sportsapp.test" {
+			t.Fatalf("Unexpected lookup result: %+v, %v", recipient, err)
+		}
+	}
+
+	if users.calls != 1 {
+		t.Errorf("Expected 1 auth-svc lookup, got %d", users.calls)
+	}
+
+	if _, err := cached.GetRecipient(context.Background(), "missing"); err == nil {
+		t.Error("Expected lookup error to be returned")
+	}
+	if _, err := cached.GetRecipient(context.Background(), "missing"); err == nil || users.calls != 3 {
+		t.Errorf("Expected failed lookups not to be cached, got %d calls", users.calls)
+	}
+}
+
+func TestCachedUserDirectory_ExpiresEntries(t *testing.T) {
+	users := NewStubUserDirectory(map[string]string{"player-1": "alice@sportsapp.test"})
+	cached := auth.NewCachedUserDirectory(users, time.Millisecond, 10)
+
+	_, _ = cached.GetRecipient(context.Background(), "player-1")
+	time.Sleep(5 * time.Millisecond)
+	_, _ = cached.GetRecipient(context.Background(), "player-1")
+
+	if users.calls != 2 {
+		t.Errorf("Expected expired entry to be refreshed, got %d calls", users.calls)
+	}
+}
@@ -0,0 +1,373 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/notification-svc/internal/adapters/outbound/templates"
+	"github.com/diploma/notification-svc/internal/application/event/handler"
+	reminderdto "github.com/diploma/notification-svc/internal/application/reminder/dto"
+	reminderusecase "github.com/diploma/notification-svc/internal/application/reminder/usecase"
+	"github.com/diploma/notification-svc/internal/domain/notification/entity"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	"github.com/diploma/notification-svc/internal/domain/notification/service"
+	"github.com/google/uuid"
+)
+
+type MockReminderRepository struct {
+	mu        sync.Mutex
+	reminders []*entity.Reminder
+	claims    map[uuid.UUID]time.Time
+}
+
+func (r *MockReminderRepository) Schedule(ctx context.Context, reminders []*entity.Reminder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, reminder := range reminders {
+		if r.find(reminder.SessionID, reminder.Kind, reminder.Lead) == nil {
+			r.reminders = append(r.reminders, reminder)
+		}
+	}
+	return nil
+}
+
+func (r *MockReminderRepository) ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.Reminder, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.claims == nil {
+		r.claims = make(map[uuid.UUID]time.Time)
+	}
+	var due []*entity.Reminder
+	for _, reminder := range r.reminders {
+		if claim, ok := r.claims[reminder.ID]; ok && claim.After(now) {
+			continue
+		}
+		if reminder.SentAt == nil && !reminder.DueAt.After(now) && len(due) < limit {
+			reminder.Attempts++
+			r.claims[reminder.ID] = lockedUntil
+			due = append(due, reminder)
+		}
+	}
+	return due, nil
+}
+
+func (r *MockReminderRepository) MarkSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, reminder := range r.reminders {
+		if reminder.ID == id {
+			reminder.SentAt = &sentAt
+			delete(r.claims, id)
+		}
+	}
+	return nil
+}
+
+func (r *MockReminderRepository) Reschedule(ctx context.Context, id uuid.UUID, dueAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, reminder := range r.reminders {
+		if reminder.ID == id {
+			reminder.DueAt = dueAt
+			delete(r.claims, id)
+		}
+	}
+	return nil
+}
+
+func (r *MockReminderRepository) CancelBySession(ctx context.Context, sessionID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.reminders[:0]
+	for _, reminder := range r.reminders {
+		if reminder.SessionID != sessionID || reminder.SentAt != nil {
+			kept = append(kept, reminder)
+		}
+	}
+	r.reminders = kept
+	return nil
+}
+
+func (r *MockReminderRepository) find(sessionID uuid.UUID, kind entity.ReminderKind, lead time.Duration) *entity.Reminder {
+	for _, reminder := range r.reminders {
+		if reminder.SessionID == sessionID && reminder.Kind == kind && reminder.Lead == lead {
+			return reminder
+		}
+	}
+	return nil
+}
+
+// makeDue pulls every pending reminder's due time into the past without touching the session start
+func (r *MockReminderRepository) makeDue() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, reminder := range r.reminders {
+		reminder.DueAt = time.Now().Add(-time.Minute)
+	}
+}
+
+var _ port.ReminderRepository = (*MockReminderRepository)(nil)
+
+var reminderLeads = []time.Duration{24 * time.Hour, time.Hour}
+
+const hostWarningLead = 6 * time.Hour
+
+// newSessionEventHandler wires the session handler over the shared notification fixture
+func newSessionEventHandler(t *testing.T, senders ...port.ChannelSender) *handler.SessionEventHandler {
+	t.Helper()
+	notifications, details := newNotificationFixture(t, senders...)
+	reminders := service.NewReminderService(&MockReminderRepository{}, notifications, details, reminderLeads, hostWarningLead)
+	return handler.NewSessionEventHandler(notifications, details, reminders)
+}
+
+type reminderFixture struct {
+	sessionID string
+	hostID    string
+	sessions  *StubSessionDirectory
+	repo      *MockReminderRepository
+	handler   *handler.SessionEventHandler
+	useCase   *reminderusecase.SendDueRemindersUseCase
+}
+
+func newReminderFixture(t *testing.T, sender port.ChannelSender, start time.Time) *reminderFixture {
+	t.Helper()
+
+	renderer, err := templates.NewRenderer("", "en", time.UTC)
+	if err != nil {
+		t.Fatalf("Failed to load templates: %v", err)
+	}
+
+	sessionID := uuid.New().String()
+	hostID := uuid.New().String()
+	sessions := &StubSessionDirectory{
+		sessions: map[string]*port.SessionDetails{
+			sessionID: {
+				ID:                  sessionID,
+				HostID:              hostID,
+				SportType:           "football",
+				Status:              "OPEN",
+				MaxParticipants:     10,
+				MinParticipants:     6,
+				CurrentParticipants: 3,
+				StartTime:           start,
+			},
+		},
+		participants: map[string][]string{
+			sessionID: {hostID, "player-1", "player-2"},
+		},
+	}
+	users := NewStubUserDirectory(map[string]string{
+		hostID:     "host@sportsapp.test",
+		"player-1": "alice@sportsapp.test",
+		"player-2": "bob@sportsapp.test",
+	})
+
+	notifications := service.NewNotificationService(
+		[]port.ChannelSender{sender},
+		service.NewRecipientService(users, sessions),
+		renderer,
+		service.NewChannelRouter(service.DefaultRoutes()),
+		service.NewPreferenceService(NewMockPreferenceRepository(), "UTC"),
+		&MockDeferredDeliveryRepository{},
+		NewMockDeliveryLogRepository(),
+		"USD",
+	)
+	details := service.NewEventDetailsService(sessions, &StubReservationDirectory{}, &StubVenueDirectory{})
+	repo := &MockReminderRepository{}
+	reminders := service.NewReminderService(repo, notifications, details, reminderLeads, hostWarningLead)
+
+	return &reminderFixture{
+		sessionID: sessionID,
+		hostID:    hostID,
+		sessions:  sessions,
+		repo:      repo,
+		handler:   handler.NewSessionEventHandler(notifications, details, reminders),
+		useCase:   reminderusecase.NewSendDueRemindersUseCase(reminders, repo, time.Minute, time.Minute, 3),
+	}
+}
+
+func (f *reminderFixture) created(t *testing.T, start time.Time) {
+	t.Helper()
+	err := f.handler.HandleSessionCreated(context.Background(), sharedevents.SessionCreated{
+		SessionID: f.sessionID,
+		HostID:    f.hostID,
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func (f *reminderFixture) sendDue(t *testing.T) *reminderdto.SendDueRemindersOutput {
+	t.Helper()
+	output, err := f.useCase.Execute(context.Background(), reminderdto.SendDueRemindersInput{BatchSize: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return output
+}
+
+func TestReminders_ScheduledOnSessionCreated(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	fixture := newReminderFixture(t, &RecordingEmailSender{}, start)
+
+	fixture.created(t, start)
+	fixture.created(t, start) // Redelivery
+
+	if len(fixture.repo.reminders) != 3 {
+		t.Fatalf("Expected 2 participant reminders and a host warning, got %d", len(fixture.repo.reminders))
+	}
+	for _, lead := range reminderLeads {
+		reminder := fixture.repo.find(uuid.MustParse(fixture.sessionID), entity.ReminderKindParticipants, lead)
+		if reminder == nil {
+			t.Fatalf("Expected a %s participant reminder", lead)
+		}
+		if !reminder.DueAt.Equal(start.Add(-lead)) {
+			t.Errorf("Expected %s reminder due at %s, got %s", lead, start.Add(-lead), reminder.DueAt)
+		}
+	}
+	if fixture.repo.find(uuid.MustParse(fixture.sessionID), entity.ReminderKindHostUnderfilled, hostWarningLead) == nil {
+		t.Error("Expected a host warning")
+	}
+}
+
+func TestReminders_SkipsLeadsAlreadyPassed(t *testing.T) {
+	start := time.Now().Add(3 * time.Hour)
+	fixture := newReminderFixture(t, &RecordingEmailSender{}, start)
+
+	fixture.created(t, start)
+
+	if len(fixture.repo.reminders) != 1 || fixture.repo.reminders[0].Lead != time.Hour {
+		t.Fatalf("Expected only the 1h reminder, got %d reminders", len(fixture.repo.reminders))
+	}
+}
+
+func TestReminders_CancelledSessionDropsPending(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	sender := &RecordingEmailSender{}
+	fixture := newReminderFixture(t, sender, start)
+	fixture.created(t, start)
+
+	_ = fixture.handler.HandleSessionCancelled(context.Background(), sharedevents.SessionCancelled{SessionID: fixture.sessionID})
+
+	if len(fixture.repo.reminders) != 0 {
+		t.Errorf("Expected pending reminders to be cancelled, got %d", len(fixture.repo.reminders))
+	}
+}
+
+func TestReminders_SendDueNotifiesParticipantsAndWarnsHost(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	sender := &RecordingEmailSender{}
+	fixture := newReminderFixture(t, sender, start)
+	fixture.created(t, start)
+	sender.sent = nil
+	fixture.repo.makeDue()
+
+	output := fixture.sendDue(t)
+	if output.Fetched != 3 || output.Settled != 3 || output.Retried != 0 {
+		t.Fatalf("Expected 3 settled reminders, got %+v", output)
+	}
+
+	reminders, warnings := 0, 0
+	for _, email := range sender.sent {
+		switch {
+		case strings.HasPrefix(email.Subject, "Reminder: Football starts in"):
+			reminders++
+		case email.Subject == "Football still needs players":
+			warnings++
+			if email.To != "host@sportsapp.test" {
+				t.Errorf("Expected the warning to go to the host, got %s", email.To)
+			}
+		}
+	}
+	if reminders != 6 {
+		t.Errorf("Expected both reminders to reach all 3 participants, got %d", reminders)
+	}
+	if warnings != 1 {
+		t.Errorf("Expected one host warning, got %d", warnings)
+	}
+
+	if again := fixture.sendDue(t); again.Fetched != 0 {
+		t.Errorf("Expected sent reminders to stay sent, got %+v", again)
+	}
+}
+
+func TestReminders_MootRemindersAreSettledSilently(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	sender := &RecordingEmailSender{}
+	fixture := newReminderFixture(t, sender, start)
+	fixture.created(t, start)
+	fixture.repo.makeDue()
+
+	session := fixture.sessions.sessions[fixture.sessionID]
+	session.CurrentParticipants = session.MinParticipants
+	session.Status = "IN_PROGRESS"
+	sender.sent = nil
+
+	output := fixture.sendDue(t)
+	if output.Settled != 3 {
+		t.Fatalf("Expected all reminders settled, got %+v", output)
+	}
+	if len(sender.sent) != 0 {
+		t.Errorf("Expected nothing to be sent for a session that is no longer open, got %d emails", len(sender.sent))
+	}
+}
+
+func TestReminders_FailedSendIsRetriedThenDropped(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	sender := &RecordingEmailSender{failTo: "alice@sportsapp.test"}
+	fixture := newReminderFixture(t, sender, start)
+	fixture.created(t, start)
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		fixture.repo.makeDue()
+		output := fixture.sendDue(t)
+		if attempt < 3 && output.Retried != 2 {
+			t.Fatalf("Attempt %d: expected both participant reminders to be retried, got %+v", attempt, output)
+		}
+		if attempt == 3 && (output.Settled != 2 || output.Retried != 0) {
+			t.Fatalf("Expected reminders to be given up after 3 attempts, got %+v", output)
+		}
+	}
+}
+
+func TestReminders_ClaimedByAnotherSchedulerAreNotResent(t *testing.T) {
+	start := time.Now().Add(48 * time.Hour)
+	sender := &RecordingEmailSender{}
+	fixture := newReminderFixture(t, sender, start)
+	fixture.created(t, start)
+	fixture.repo.makeDue()
+	sender.sent = nil
+
+	// Another replica claimed the batch and died before settling it
+	now := time.Now()
+	claimed, err := fixture.repo.ClaimDue(context.Background(), now, now.Add(time.Minute), 10)
+	if err != nil || len(claimed) != 3 {
+		t.Fatalf("Expected 3 claimed reminders, got %d (%v)", len(claimed), err)
+	}
+
+	if output := fixture.sendDue(t); output.Fetched != 0 || len(sender.sent) != 0 {
+		t.Fatalf("Expected claimed reminders to be left alone, got %+v and %d emails", output, len(sender.sent))
+	}
+
+	fixture.repo.mu.Lock()
+	for id := range fixture.repo.claims {
+		fixture.repo.claims[id] = now
+	}
+	fixture.repo.mu.Unlock()
+
+	output := fixture.sendDue(t)
+	if output.Fetched != 3 || output.Settled != 3 {
+		t.Fatalf("Expected the lapsed claims to be picked up, got %+v", output)
+	}
+	for _, reminder := range claimed {
+		if reminder.Attempts != 2 {
+			t.Errorf("Expected the lapsed claim to count as an attempt, got %d", reminder.Attempts)
+		}
+	}
+}
@@ -0,0 +1,516 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/adapters/outbound/email"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+	pkgerrors "github.com/diploma/notification-svc/pkg/errors"
+)
+
+type receivedEmail struct {
+	From string
+	To   []string
+	Data []byte
+	TLS  bool
+	Auth string
+}
+
+// TestSMTPServer speaks just enough ESMTP (EHLO, STARTTLS, AUTH PLAIN, MAIL, RCPT, DATA, RSET, NOOP, QUIT)
+// to exercise the sender over a real socket
+type TestSMTPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config // Offered via STARTTLS when set
+
+	username     string
+	password     string
+	rejectRcpt   string // Answered with 550
+	stallData    bool   // Never answer the end of DATA
+	dropAfterOne bool   // Close the connection after each accepted message
+
+	mu          sync.Mutex
+	emails      []receivedEmail
+	connections int
+	done        chan struct{}
+}
+
+func newTestSMTPServer(t *testing.T, configure func(*TestSMTPServer)) *TestSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	s := &TestSMTPServer{listener: listener, done: make(chan struct{})}
+	if configure != nil {
+		configure(s)
+	}
+
+	go s.serve()
+	t.Cleanup(func() {
+		close(s.done)
+		_ = s.listener.Close()
+	})
+	return s
+}
+
+func (s *TestSMTPServer) port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *TestSMTPServer) received() []receivedEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedEmail(nil), s.emails...)
+}
+
+func (s *TestSMTPServer) connectionCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connections
+}
+
+func (s *TestSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.connections++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *TestSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	_, secure := conn.(*tls.Conn)
+	text := textproto.NewConn(conn)
+	var current receivedEmail
+	var auth string
+
+	_ = text.PrintfLine("220 test.local ESMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			lines := []string{"test.local"}
+			if s.tlsConfig != nil && !secure {
+				lines = append(lines, "STARTTLS")
+			}
+			lines = append(lines, "AUTH PLAIN", "8BITMIME")
+			for i, l := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				_ = text.PrintfLine("250%s%s", sep, l)
+			}
+		case "STARTTLS":
+			_ = text.PrintfLine("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, secure = tlsConn, true
+			text = textproto.NewConn(conn)
+		case "AUTH":
+			_, encoded, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(encoded)
+			if string(decoded) != "\x00"+s.username+"\x00"+s.password {
+				_ = text.PrintfLine("535 5.7.8 Authentication failed")
+				continue
+			}
+			auth = s.username
+			_ = text.PrintfLine("235 2.7.0 Authenticated")
+		case "MAIL":
+			current = receivedEmail{From: addressArg(arg), TLS: secure, Auth: auth}
+			_ = text.PrintfLine("250 2.1.0 OK")
+		case "RCPT":
+			to := addressArg(arg)
+			if to == s.rejectRcpt {
+				_ = text.PrintfLine("550 5.1.1 No such user")
+				continue
+			}
+			current.To = append(current.To, to)
+			_ = text.PrintfLine("250 2.1.5 OK")
+		case "DATA":
+			_ = text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			if s.stallData {
+				<-s.done
+				return
+			}
+			current.Data = data
+			s.mu.Lock()
+			s.emails = append(s.emails, current)
+			s.mu.Unlock()
+			_ = text.PrintfLine("250 2.0.0 Queued")
+			if s.dropAfterOne {
+				return
+			}
+		case "RSET", "NOOP":
+			current = receivedEmail{}
+			_ = text.PrintfLine("250 2.0.0 OK")
+		case "QUIT":
+			_ = text.PrintfLine("221 2.0.0 Bye")
+			return
+		default:
+			_ = text.PrintfLine("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+func addressArg(arg string) string {
+	start, end := strings.Index(arg, "<"), strings.Index(arg, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
+
+// selfSignedTLS returns a server config for 127.0.0.1 and a client config that trusts it
+func selfSignedTLS(t *testing.T) (*tls.Config, *tls.Config) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test.local"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	server := &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	return server, &tls.Config{RootCAs: roots}
+}
+
+func newTestSMTPSender(t *testing.T, server *TestSMTPServer, configure func(*email.SMTPOptions)) *email.SMTPSender {
+	t.Helper()
+
+	opts := email.SMTPOptions{
+		Host:        "127.0.0.1",
+		Port:        server.port(),
+		From:        "SportsApp <notifications@sportsapp.test>",
+		TLSMode:     email.TLSModeNone,
+		Timeout:     2 * time.Second,
+		PoolSize:    2,
+		IdleTimeout: time.Minute,
+	}
+	if configure != nil {
+		configure(&opts)
+	}
+
+	sender, err := email.NewSMTPSender(opts)
+	if err != nil {
+		t.Fatalf("Failed to create sender: %v", err)
+	}
+	t.Cleanup(sender.Close)
+	return sender
+}
+
+func plainEmail(to string) port.EmailNotification {
+	return port.EmailNotification{To: to, Subject: "Session cancelled", Body: "Your session was cancelled."}
+}
+
+func TestBuildMessage_MultipartWithAttachment(t *testing.T) {
+	invite := []byte(strings.Repeat("BEGIN:VCALENDAR\r\n", 10))
+	data, err := email.BuildMessage(email.Envelope{
+		From:      &mail.Address{Name: "SportsApp", Address: "notifications@sportsapp.test"},
+		MessageID: "<id-1@sportsapp.test>",
+		Date:      time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC),
+	}, port.EmailNotification{
+		To:          "alice@sportsapp.test",
+		ToName:      "Алиса Иванова",
+		Subject:     "Матч отменён: футбол в субботу вечером на стадионе Центральный",
+		Body:        "Матч отменён.\nДеньги вернутся в течение 3 дней.",
+		HTMLBody:    "<p>Матч <b>отменён</b>.</p>",
+		Attachments: []port.Attachment{{Filename: "invite.ics", ContentType: "text/calendar; method=REQUEST; charset=UTF-8", Data: invite}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, line := range strings.Split(string(data), "\r\n") {
+		if len(line) > 998 {
+			t.Errorf("Expected lines within the RFC 5322 limit, got %d characters: %q", len(line), line)
+		}
+		for _, r := range line {
+			if r > 127 {
+				t.Fatalf("Expected a 7-bit message, got %q", line)
+			}
+		}
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	}
+	decoder := new(mime.WordDecoder)
+	subject, _ := decoder.DecodeHeader(msg.Header.Get("Subject"))
+	if subject != "Матч отменён: футбол в субботу вечером на стадионе Центральный" {
+		t.Errorf("Unexpected subject %q", subject)
+	}
+	to, err := msg.Header.AddressList("To")
+	if err != nil || to[0].Name != "Алиса Иванова" || to[0].Address != "alice@sportsapp.test" {
+		t.Errorf("Unexpected recipient %v, %v", to, err)
+	}
+	if msg.Header.Get("Message-ID") != "<id-1@sportsapp.test>" || msg.Header.Get("MIME-Version") != "1.0" {
+		t.Errorf("Missing headers: %v", msg.Header)
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if mediaType != "multipart/mixed" {
+		t.Fatalf("Expected multipart/mixed, got %s", mediaType)
+	}
+	mixed := multipart.NewReader(msg.Body, params["boundary"])
+
+	body, err := mixed.NextPart()
+	if err != nil {
+		t.Fatalf("Expected a body part, got %v", err)
+	}
+	mediaType, params, _ = mime.ParseMediaType(body.Header.Get("Content-Type"))
+	if mediaType != "multipart/alternative" {
+		t.Fatalf("Expected multipart/alternative body, got %s", mediaType)
+	}
+	alternative := multipart.NewReader(body, params["boundary"])
+	var texts []string
+	for {
+		part, err := alternative.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read alternative part: %v", err)
+		}
+		content, _ := io.ReadAll(part) // Quoted-printable is decoded by the reader
+		texts = append(texts, part.Header.Get("Content-Type")+"|"+string(content))
+	}
+	if len(texts) != 2 ||
+		texts[0] != "text/plain; charset=UTF-8|Матч отменён.\r\nДеньги вернутся в течение 3 дней." ||
+		texts[1] != "text/html; charset=UTF-8|<p>Матч <b>отменён</b>.</p>" {
+		t.Errorf("Unexpected alternatives %q", texts)
+	}
+
+	attachment, err := mixed.NextPart()
+	if err != nil {
+		t.Fatalf("Expected an attachment, got %v", err)
+	}
+	if attachment.FileName() != "invite.ics" || !strings.HasPrefix(attachment.Header.Get("Content-Type"), "text/calendar") {
+		t.Errorf("Unexpected attachment headers %v", attachment.Header)
+	}
+	content, _ := io.ReadAll(base64.NewDecoder(base64.StdEncoding, attachment))
+	if !bytes.Equal(content, invite) {
+		t.Errorf("Attachment did not round-trip: %q", content)
+	}
+}
+
+func TestBuildMessage_RejectsHeaderInjection(t *testing.T) {
+	envelope := email.Envelope{From: &mail.Address{Address: "notifications@sportsapp.test"}, MessageID: "<id@sportsapp.test>", Date: time.Now()}
+	cases := []port.EmailNotification{
+		{To: "alice@sportsapp.test", Subject: "Hi\r\nBcc: victim@example.com"},
+		{To: "alice@sportsapp.test\r\nBcc: victim@example.com", Subject: "Hi"},
+		{To: "not an address", Subject: "Hi"},
+	}
+	for _, notification := range cases {
+		if _, err := email.BuildMessage(envelope, notification); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+			t.Errorf("Expected invalid argument for %+v, got %v", notification, err)
+		}
+	}
+}
+
+func TestSMTPSender_ReusesPooledConnection(t *testing.T) {
+	server := newTestSMTPServer(t, nil)
+	sender := newTestSMTPSender(t, server, nil)
+
+	var ids []string
+	for _, to := range []string{"alice@sportsapp.test", "bob@sportsapp.test", "carol@sportsapp.test"} {
+		id, err := sender.SendEmail(context.Background(), plainEmail(to))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, id)
+	}
+
+	emails := server.received()
+	if len(emails) != 3 || server.connectionCount() != 1 {
+		t.Fatalf("Expected 3 emails over 1 connection, got %d over %d", len(emails), server.connectionCount())
+	}
+	msg, _ := mail.ReadMessage(bytes.NewReader(emails[0].Data))
+	if emails[0].From != "notifications@sportsapp.test" || emails[0].To[0] != "alice@sportsapp.test" {
+		t.Errorf("Unexpected envelope %+v", emails[0])
+	}
+	if msg.Header.Get("Message-ID") != ids[0] || !strings.HasSuffix(ids[0], "@sportsapp.test>") {
+		t.Errorf("Expected the returned ID %q in the Message-ID header, got %q", ids[0], msg.Header.Get("Message-ID"))
+	}
+}
+
+func TestSMTPSender_STARTTLSWithAuth(t *testing.T) {
+	serverTLS, clientTLS := selfSignedTLS(t)
+	server := newTestSMTPServer(t, func(s *TestSMTPServer) {
+		s.tlsConfig = serverTLS
+		s.username, s.password = "mailer", "secret"
+	})
+	sender := newTestSMTPSender(t, server, func(opts *email.SMTPOptions) {
+		opts.TLSMode = email.TLSModeSTARTTLS
+		opts.TLSConfig = clientTLS
+		opts.Username, opts.Password = "mailer", "secret"
+	})
+
+	if _, err := sender.SendEmail(context.Background(), plainEmail("alice@sportsapp.test")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	emails := server.received()
+	if len(emails) != 1 || !emails[0].TLS || emails[0].Auth != "mailer" {
+		t.Errorf("Expected an authenticated email over TLS, got %+v", emails)
+	}
+}
+
+func TestSMTPSender_STARTTLSIsRequired(t *testing.T) {
+	server := newTestSMTPServer(t, nil)
+	sender := newTestSMTPSender(t, server, func(opts *email.SMTPOptions) {
+		opts.TLSMode = email.TLSModeSTARTTLS
+	})
+
+	_, err := sender.SendEmail(context.Background(), plainEmail("alice@sportsapp.test"))
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeExternalAPI || len(server.received()) != 0 {
+		t.Errorf("Expected the sender to refuse a plaintext session, got %v", err)
+	}
+}
+
+func TestSMTPSender_ImplicitTLS(t *testing.T) {
+	serverTLS, clientTLS := selfSignedTLS(t)
+	server := newTestSMTPServer(t, func(s *TestSMTPServer) {
+		s.listener = tls.NewListener(s.listener, serverTLS)
+	})
+	sender := newTestSMTPSender(t, server, func(opts *email.SMTPOptions) {
+		opts.TLSMode = email.TLSModeImplicit
+		opts.TLSConfig = clientTLS
+	})
+
+	if _, err := sender.SendEmail(context.Background(), plainEmail("alice@sportsapp.test")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if emails := server.received(); len(emails) != 1 || !emails[0].TLS {
+		t.Errorf("Expected the email over implicit TLS, got %+v", emails)
+	}
+}
+
+func TestSMTPSender_RejectedRecipientIsPermanent(t *testing.T) {
+	server := newTestSMTPServer(t, func(s *TestSMTPServer) { s.rejectRcpt = "ghost@sportsapp.test" })
+	sender := newTestSMTPSender(t, server, nil)
+
+	_, err := sender.SendEmail(context.Background(), plainEmail("ghost@sportsapp.test"))
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Fatalf("Expected a permanent error for a 550, got %v", err)
+	}
+
+	if _, err := sender.SendEmail(context.Background(), plainEmail("alice@sportsapp.test")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if server.connectionCount() != 1 {
+		t.Errorf("Expected the connection to be reset and reused, got %d connections", server.connectionCount())
+	}
+}
+
+func TestSMTPSender_ReconnectsWhenIdleConnectionWasDropped(t *testing.T) {
+	server := newTestSMTPServer(t, func(s *TestSMTPServer) { s.dropAfterOne = true })
+	sender := newTestSMTPSender(t, server, nil)
+
+	for _, to := range []string{"alice@sportsapp.test", "bob@sportsapp.test"} {
+		if _, err := sender.SendEmail(context.Background(), plainEmail(to)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if len(server.received()) != 2 || server.connectionCount() != 2 {
+		t.Errorf("Expected a fresh connection for the second email, got %d emails over %d connections", len(server.received()), server.connectionCount())
+	}
+}
+
+func TestSMTPSender_HonoursContextDeadline(t *testing.T) {
+	server := newTestSMTPServer(t, func(s *TestSMTPServer) { s.stallData = true })
+	sender := newTestSMTPSender(t, server, func(opts *email.SMTPOptions) { opts.Timeout = time.Minute })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	_, err := sender.SendEmail(ctx, plainEmail("alice@sportsapp.test"))
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeExternalAPI {
+		t.Fatalf("Expected a retryable error, got %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Errorf("Expected the send to give up at the deadline, took %s", elapsed)
+	}
+}
+
+func TestSMTPSender_RateLimited(t *testing.T) {
+	server := newTestSMTPServer(t, nil)
+	sender := newTestSMTPSender(t, server, func(opts *email.SMTPOptions) {
+		opts.RatePerSecond = 20
+		opts.RateBurst = 1
+	})
+
+	started := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := sender.SendEmail(context.Background(), plainEmail("alice@sportsapp.test")); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if elapsed := time.Since(started); elapsed < 140*time.Millisecond {
+		t.Errorf("Expected 4 emails at 20/s to take at least 150ms, took %s", elapsed)
+	}
+}
+
+func TestSMTPSender_StubDoesNotConnect(t *testing.T) {
+	sender, err := email.NewSMTPSender(email.SMTPOptions{Host: "stub", From: "notifications@sportsapp.test"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := sender.SendEmail(context.Background(), plainEmail("alice@sportsapp.test")); err != nil {
+		t.Errorf("Expected the stub to accept the email, got %v", err)
+	}
+}
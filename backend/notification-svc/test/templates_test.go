@@ -0,0 +1,246 @@
+package test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diploma/notification-svc/internal/adapters/outbound/templates"
+	"github.com/diploma/notification-svc/internal/domain/notification/port"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden template files")
+
+var sampleStart = time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+
+var sampleReservation = &port.ReservationDetails{
+	ID:         "9f0c7a52-7e0a-4f0e-9a57-0a3c1b0b6d11",
+	ResourceID: "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+	StartTime:  sampleStart,
+	EndTime:    sampleStart.Add(90 * time.Minute),
+	Venue: &port.VenueDetails{
+		VenueName:    "Central Sports Park",
+		ResourceName: "Court 1",
+		Address:      "12 Abay Ave",
+		City:         "Almaty",
+	},
+}
+
+var sampleSession = &port.SessionDetails{
+	ID:                  "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a",
+	SportType:           "football",
+	SkillLevel:          "intermediate",
+	PricePerParticipant: 12.5,
+	MaxParticipants:     10,
+	MinParticipants:     6,
+	CurrentParticipants: 10,
+	ReservationID:       sampleReservation.ID,
+	Reservation:         sampleReservation,
+}
+
+// templateSamples pins one rendering input per event type; the golden files are the reviewed output
+var templateSamples = map[string]port.TemplateData{
+	port.TemplateReservationCreated:   {Reservation: sampleReservation, ReservationID: sampleReservation.ID},
+	port.TemplateReservationConfirmed: {Reservation: sampleReservation, ReservationID: sampleReservation.ID},
+	port.TemplateReservationCancelled: {Reservation: sampleReservation, ReservationID: sampleReservation.ID},
+	port.TemplateReservationExpired:   {Reservation: sampleReservation, ReservationID: sampleReservation.ID},
+	port.TemplateSessionCreated:       {Session: sampleSession, Currency: "USD"},
+	port.TemplateSessionJoined:        {Session: sampleSession, Participants: 3},
+	port.TemplateSessionFull:          {Session: sampleSession},
+	port.TemplateSessionCancelled:     {Session: sampleSession},
+	port.TemplateSessionLeft:          {Session: sampleSession},
+	port.TemplateSessionReminder:      {Session: sampleSession, StartsIn: 24 * time.Hour},
+	port.TemplateSessionUnderfilled:   {Session: sampleSession, Participants: 3, StartsIn: 6 * time.Hour},
+	port.TemplatePaymentCreated:       {Session: sampleSession, PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b", Amount: 4500, Currency: "KZT"},
+	port.TemplatePaymentSucceeded:     {Session: sampleSession, PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b", Amount: 4500, Currency: "KZT"},
+	port.TemplatePaymentFailed:        {Session: sampleSession, PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b", Reason: "Your card was declined."},
+	port.TemplatePaymentRefunded:      {Session: sampleSession, PaymentID: "5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b", RefundID: "re_3NqG2a2eZvKYlo2C"},
+
+	port.TemplateAccountEmailVerification: {Link: "https://app.example.com/verify-email?token=q8Vd2n", ExpiresIn: 24 * time.Hour},
+	port.TemplateAccountPasswordReset:     {Link: "https://app.example.com/reset-password?token=Zk41xw", ExpiresIn: time.Hour},
+	port.TemplateAccountPasswordChanged:   {},
+
+	port.TemplateDigest: {Digest: []port.DigestItem{
+		{Type: port.TemplateSessionJoined, Subject: "A player joined Football (Intermediate)", Short: "3 of 10 spots are taken."},
+		{Type: port.TemplatePaymentSucceeded, Subject: "Payment received", Short: "We received 12.50 USD for Football (Intermediate)."},
+	}},
+}
+
+func newTestRenderer(t *testing.T, overrideDir string) *templates.Renderer {
+	t.Helper()
+	renderer, err := templates.NewRenderer(overrideDir, "en", time.FixedZone("ALMT", 5*60*60))
+	if err != nil {
+		t.Fatalf("Failed to load templates: %v", err)
+	}
+	return renderer
+}
+
+func TestTemplates_EveryEventTypeHasASample(t *testing.T) {
+	renderer := newTestRenderer(t, "")
+
+	names := renderer.Names()
+	for _, name := range names {
+		if _, ok := templateSamples[name]; !ok {
+			t.Errorf("%s has no template sample", name)
+		}
+	}
+
+	if len(names) != len(templateSamples) {
+		t.Errorf("Expected %d templates, got %d", len(templateSamples), len(names))
+	}
+}
+
+func TestTemplates_MatchGoldenFiles(t *testing.T) {
+	renderer := newTestRenderer(t, "")
+
+	for _, locale := range renderer.Locales() {
+		for name, data := range templateSamples {
+			t.Run(locale+"/"+name, func(t *testing.T) {
+				data.Recipient = &port.Recipient{UserID: "user-1", FullName: "Aigerim Sadykova", Email: "aigerim@sportsapp.test", Locale: locale}
+
+				message, err := renderer.Render(name, locale, data)
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+
+				got := []byte("Subject: " + message.Subject + "\nShort: " + message.Short + "\n\n" + message.Text + "\n" + message.HTML)
+				path := filepath.Join("testdata", "templates", locale, name+".golden")
+
+				if *updateGolden {
+					if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+						t.Fatalf("Failed to create golden dir: %v", err)
+					}
+					if err := os.WriteFile(path, got, 0o644); err != nil {
+						t.Fatalf("Failed to write golden file: %v", err)
+					}
+				}
+
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("Failed to read golden file: %v", err)
+				}
+
+				if !bytes.Equal(got, want) {
+					t.Errorf("Rendering drifted from %s\ngot:\n%s\nwant:\n%s", path, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestTemplates_LocaleFallback(t *testing.T) {
+	renderer := newTestRenderer(t, "")
+	data := templateSamples[port.TemplateSessionFull]
+
+	russian, err := renderer.Render(port.TemplateSessionFull, "ru_RU", data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(russian.Subject, "укомплектована") {
+		t.Errorf("Expected ru_RU to fall back to ru, got %q", russian.Subject)
+	}
+
+	english, err := renderer.Render(port.TemplateSessionFull, "de", data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if english.Subject != "Football (Intermediate) is full" {
+		t.Errorf("Expected unknown locale to fall back to en, got %q", english.Subject)
+	}
+}
+
+func TestTemplates_DurationPlurals(t *testing.T) {
+	renderer := newTestRenderer(t, "")
+
+	cases := []struct {
+		locale   string
+		startsIn time.Duration
+		want     string
+	}{
+		{"en", time.Hour, "in 1 hour"},
+		{"en", 24 * time.Hour, "in 24 hours"},
+		{"en", 45 * time.Minute, "in 45 minutes"},
+		{"ru", time.Hour, "через 1 час"},
+		{"ru", 3 * time.Hour, "через 3 часа"},
+		{"ru", 12 * time.Hour, "через 12 часов"},
+		{"ru", 21 * time.Hour, "через 21 час"},
+		{"ru", 59*time.Minute + 40*time.Second, "через 1 час"},
+		{"ru", 22 * time.Minute, "через 22 минуты"},
+	}
+
+	for _, tc := range cases {
+		message, err := renderer.Render(port.TemplateSessionReminder, tc.locale, port.TemplateData{Session: sampleSession, StartsIn: tc.startsIn})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(message.Subject, tc.want) {
+			t.Errorf("Expected %s subject for %s to contain %q, got %q", tc.locale, tc.startsIn, tc.want, message.Subject)
+		}
+	}
+}
+
+func TestTemplates_HTMLIsEscaped(t *testing.T) {
+	renderer := newTestRenderer(t, "")
+	venue := *sampleReservation.Venue
+	venue.VenueName = `<script>alert("x")</script>`
+	reservation := *sampleReservation
+	reservation.Venue = &venue
+
+	message, err := renderer.Render(port.TemplateReservationConfirmed, "en", port.TemplateData{Reservation: &reservation})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if strings.Contains(message.HTML, "<script>") {
+		t.Error("Expected venue name to be escaped in HTML")
+	}
+	if !strings.Contains(message.Text, "<script>") {
+		t.Error("Expected plain text to keep the venue name verbatim")
+	}
+}
+
+func TestTemplates_OverrideDirectoryShadowsDefaults(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en"), 0o755); err != nil {
+		t.Fatalf("Failed to create override dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "en", "session_full.subject.txt"), []byte("Game on: {{.Session.Name}}\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write override: %v", err)
+	}
+
+	renderer := newTestRenderer(t, dir)
+	data := templateSamples[port.TemplateSessionFull]
+
+	overridden, err := renderer.Render(port.TemplateSessionFull, "en", data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if overridden.Subject != "Game on: Football (Intermediate)" {
+		t.Errorf("Expected override subject, got %q", overridden.Subject)
+	}
+	if !strings.Contains(overridden.Text, "The session is full") {
+		t.Error("Expected body to keep using the embedded default")
+	}
+
+	if len(renderer.Names()) != len(templateSamples) {
+		t.Errorf("Expected override to keep every embedded template, got %v", renderer.Names())
+	}
+}
+
+func TestTemplates_BrokenOverrideFailsAtStartup(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en"), 0o755); err != nil {
+		t.Fatalf("Failed to create override dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "en", "payment_failed.txt"), []byte("{{.Reason"), 0o644); err != nil {
+		t.Fatalf("Failed to write override: %v", err)
+	}
+
+	if _, err := templates.NewRenderer(dir, "en", time.UTC); err == nil {
+		t.Error("Expected broken override to be rejected")
+	}
+}
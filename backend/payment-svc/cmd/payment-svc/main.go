@@ -1,21 +1,38 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/diploma/authz"
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
 	paymentv1 "github.com/diploma/payment-svc/api/v1"
 	"github.com/diploma/payment-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/payment-svc/internal/adapters/inbound/http/webhook"
+	"github.com/diploma/payment-svc/internal/adapters/outbound/database/repository"
+	"github.com/diploma/payment-svc/internal/adapters/outbound/external/events"
+	"github.com/diploma/payment-svc/internal/adapters/outbound/fake"
+	"github.com/diploma/payment-svc/internal/adapters/outbound/session"
 	"github.com/diploma/payment-svc/internal/adapters/outbound/stripe"
 	"github.com/diploma/payment-svc/internal/application/payment/usecase"
 	"github.com/diploma/payment-svc/internal/config"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
 	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -24,16 +41,84 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	stripeClient := stripe.NewStripeClient(cfg.StripeConfig.APIKey)
+	db, err := gorm.Open(postgres.Open(cfg.DBConfig.ConnectionString()), &gorm.Config{TranslateError: true})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database instance: %v", err)
+	}
+	defer sqlDB.Close()
+
+	if err := sqlDB.Ping(); err != nil {
+		log.Fatalf("Failed to ping database: %v", err)
+	}
+
+	natsConn, err := nats.Connect(cfg.NATSConfig.URL, nats.Timeout(cfg.NATSConfig.Timeout))
+	if err != nil {
+		log.Fatalf("Failed to connect to NATS: %v", err)
+	}
+	defer natsConn.Close()
 
-	paymentService := service.NewPaymentService(nil)
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		log.Fatalf("Failed to create JetStream context: %v", err)
+	}
+	if err := sharedevents.EnsureStream(context.Background(), js, sharedevents.StreamPayments, cfg.NATSConfig.StreamMaxAge); err != nil {
+		log.Fatalf("Failed to ensure event stream: %v", err)
+	}
 
-	startPaymentUseCase := usecase.NewStartPaymentForSessionUseCase(paymentService, stripeClient, nil)
-	handleWebhookUseCase := usecase.NewHandleStripeWebhookUseCase(paymentService, nil)
+	sessionClient, err := session.NewSessionClient(cfg.SessionServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create session client: %v", err)
+	}
+	defer sessionClient.Close()
+
+	paymentRepo := repository.NewPaymentRepository(db)
+	processedEventRepo := repository.NewProcessedEventRepository(db)
+	outboxRepo := outbox.NewRepository(db, "payment_outbox")
+	txManager := outbox.NewTransactionManager(db)
+	paymentService := service.NewPaymentService(paymentRepo)
 
-	paymentHandler := handler.NewPaymentGRPCHandler(startPaymentUseCase, handleWebhookUseCase)
+	eventPublisher := events.NewOutboxEventPublisher(outboxRepo, sharedevents.NewCodec("payment-svc"))
 
-	grpcServer := grpc.NewServer()
+	handleWebhookUseCase := usecase.NewHandleStripeWebhookUseCase(paymentService, processedEventRepo, txManager, eventPublisher)
+
+	var paymentProvider port.PaymentProvider
+	switch cfg.ProviderConfig.Name {
+	case "fake":
+		fakeProvider := fake.NewFakeProvider(fake.Options{
+			Latency:      cfg.FakeConfig.Latency,
+			ConfirmDelay: cfg.FakeConfig.ConfirmDelay,
+			FailureRate:  cfg.FakeConfig.FailureRate,
+		}, handleWebhookUseCase.HandleProviderEvent)
+		defer fakeProvider.Close()
+		paymentProvider = fakeProvider
+	default:
+		paymentProvider = stripe.NewStripeProvider(cfg.StripeConfig.APIKey)
+	}
+	log.Printf("Using %s payment provider", paymentProvider.Name())
+
+	startPaymentUseCase := usecase.NewStartPaymentForSessionUseCase(paymentService, sessionClient, paymentProvider, txManager, eventPublisher)
+	getPaymentUseCase := usecase.NewGetPaymentUseCase(paymentService)
+	listPaymentsBySessionUseCase := usecase.NewListPaymentsBySessionUseCase(paymentService)
+	listPaymentsByUserUseCase := usecase.NewListPaymentsByUserUseCase(paymentService)
+	refundPaymentUseCase := usecase.NewRefundPaymentUseCase(paymentService, paymentProvider, txManager, eventPublisher)
+
+	paymentHandler := handler.NewPaymentGRPCHandler(
+		startPaymentUseCase,
+		getPaymentUseCase,
+		listPaymentsBySessionUseCase,
+		listPaymentsByUserUseCase,
+		refundPaymentUseCase,
+	)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authz.UnaryServerInterceptor(
+		authz.NewJWKSVerifier(authz.NewHTTPKeySource(cfg.JWT.JWKSURL), cfg.JWT.Issuer, cfg.JWT.KeyCacheTTL),
+		handler.AccessPolicy,
+	)))
 
 	paymentv1.RegisterPaymentServiceServer(grpcServer, paymentHandler)
 
@@ -53,11 +138,51 @@ func main() {
 		}
 	}()
 
+	if cfg.StripeConfig.WebhookSecret == "" {
+		log.Println("STRIPE_WEBHOOK_SECRET is not set, all webhook deliveries will be rejected")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks/stripe", webhook.NewStripeHandler(handleWebhookUseCase, cfg.StripeConfig.WebhookSecret))
+
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%s", cfg.HTTPPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Printf("Starting webhook HTTP server on %s", httpServer.Addr)
+
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to serve webhooks: %v", err)
+		}
+	}()
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	outboxRelay := outbox.NewRelay(outboxRepo, js, outbox.RelayOptions{
+		PollInterval: cfg.OutboxConfig.PollInterval,
+		BatchSize:    cfg.OutboxConfig.BatchSize,
+		MaxBackoff:   cfg.OutboxConfig.MaxBackoff,
+		Retention:    cfg.OutboxConfig.Retention,
+		AckTimeout:   cfg.NATSConfig.Timeout,
+		ClaimTimeout: cfg.OutboxConfig.ClaimTimeout,
+	})
+	go outboxRelay.Run(relayCtx)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Webhook server shutdown error: %v", err)
+	}
+
 	grpcServer.GracefulStop()
+	stopRelay()
 	log.Println("Server stopped")
 }
@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"github.com/diploma/authz"
+	paymentv1 "github.com/diploma/payment-svc/api/v1"
+)
+
+// AccessPolicy gates each RPC; handlers check the caller against the payment's user
+var AccessPolicy = authz.Policy{
+	paymentv1.PaymentService_StartPaymentForSession_FullMethodName: authz.Authenticated().WithVerifiedEmail(),
+	paymentv1.PaymentService_GetPayment_FullMethodName:             authz.Authenticated(),
+	paymentv1.PaymentService_GetPaymentsBySession_FullMethodName:   authz.Authenticated(),
+	paymentv1.PaymentService_GetPaymentsByUser_FullMethodName:      authz.Authenticated(),
+	paymentv1.PaymentService_RefundPayment_FullMethodName:          authz.Require(authz.PermPaymentRefund),
+}
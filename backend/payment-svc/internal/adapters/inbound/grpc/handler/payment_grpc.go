@@ -2,46 +2,188 @@ package handler
 
 import (
 	"context"
+	"time"
 
+	"github.com/diploma/authz"
 	paymentv1 "github.com/diploma/payment-svc/api/v1"
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
 	"github.com/diploma/payment-svc/internal/application/payment/usecase"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type PaymentGRPCHandler struct {
 	paymentv1.UnimplementedPaymentServiceServer
-	startPaymentUseCase  *usecase.StartPaymentForSessionUseCase
-	handleWebhookUseCase *usecase.HandleStripeWebhookUseCase
+	startPaymentUseCase          *usecase.StartPaymentForSessionUseCase
+	getPaymentUseCase            *usecase.GetPaymentUseCase
+	listPaymentsBySessionUseCase *usecase.ListPaymentsBySessionUseCase
+	listPaymentsByUserUseCase    *usecase.ListPaymentsByUserUseCase
+	refundPaymentUseCase         *usecase.RefundPaymentUseCase
 }
 
 func NewPaymentGRPCHandler(
 	startPaymentUseCase *usecase.StartPaymentForSessionUseCase,
-	handleWebhookUseCase *usecase.HandleStripeWebhookUseCase,
+	getPaymentUseCase *usecase.GetPaymentUseCase,
+	listPaymentsBySessionUseCase *usecase.ListPaymentsBySessionUseCase,
+	listPaymentsByUserUseCase *usecase.ListPaymentsByUserUseCase,
+	refundPaymentUseCase *usecase.RefundPaymentUseCase,
 ) *PaymentGRPCHandler {
 	return &PaymentGRPCHandler{
-		startPaymentUseCase:  startPaymentUseCase,
-		handleWebhookUseCase: handleWebhookUseCase,
+		startPaymentUseCase:          startPaymentUseCase,
+		getPaymentUseCase:            getPaymentUseCase,
+		listPaymentsBySessionUseCase: listPaymentsBySessionUseCase,
+		listPaymentsByUserUseCase:    listPaymentsByUserUseCase,
+		refundPaymentUseCase:         refundPaymentUseCase,
 	}
 }
 
 func (h *PaymentGRPCHandler) StartPaymentForSession(ctx context.Context, req *paymentv1.StartPaymentForSessionRequest) (*paymentv1.StartPaymentForSessionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
-}
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session_id")
+	}
 
-func (h *PaymentGRPCHandler) GetPaymentsBySession(ctx context.Context, req *paymentv1.GetPaymentsBySessionRequest) (*paymentv1.GetPaymentsBySessionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.startPaymentUseCase.Execute(ctx, dto.StartPaymentForSessionInput{
+		SessionID: sessionID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &paymentv1.StartPaymentForSessionResponse{
+		PaymentId:    output.PaymentID.String(),
+		ClientSecret: output.ClientSecret,
+		Amount:       output.Amount,
+		Currency:     output.Currency,
+	}, nil
 }
 
 func (h *PaymentGRPCHandler) GetPayment(ctx context.Context, req *paymentv1.GetPaymentRequest) (*paymentv1.GetPaymentResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	paymentID, err := uuid.Parse(req.PaymentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment_id")
+	}
+
+	output, err := h.getPaymentUseCase.Execute(ctx, dto.GetPaymentInput{PaymentID: paymentID})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+	if err := authz.AuthorizeUser(ctx, output.UserID.String()); err != nil {
+		return nil, err
+	}
+
+	return toGetPaymentResponse(*output), nil
+}
+
+func (h *PaymentGRPCHandler) GetPaymentsBySession(ctx context.Context, req *paymentv1.GetPaymentsBySessionRequest) (*paymentv1.GetPaymentsBySessionResponse, error) {
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session_id")
+	}
+
+	output, err := h.listPaymentsBySessionUseCase.Execute(ctx, dto.ListPaymentsBySessionInput{SessionID: sessionID})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	// Other players' payments in the session are only visible to callers who may act for them
+	payments := make([]dto.GetPaymentOutput, 0, len(output.Payments))
+	for _, payment := range output.Payments {
+		if authz.AuthorizeUser(ctx, payment.UserID.String()) == nil {
+			payments = append(payments, payment)
+		}
+	}
+
+	return &paymentv1.GetPaymentsBySessionResponse{
+		Payments: toGetPaymentResponses(payments),
+	}, nil
 }
 
 func (h *PaymentGRPCHandler) GetPaymentsByUser(ctx context.Context, req *paymentv1.GetPaymentsByUserRequest) (*paymentv1.GetPaymentsByUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.listPaymentsByUserUseCase.Execute(ctx, dto.ListPaymentsByUserInput{UserID: userID})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &paymentv1.GetPaymentsByUserResponse{
+		Payments: toGetPaymentResponses(output.Payments),
+	}, nil
 }
 
 func (h *PaymentGRPCHandler) RefundPayment(ctx context.Context, req *paymentv1.RefundPaymentRequest) (*paymentv1.RefundPaymentResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	paymentID, err := uuid.Parse(req.PaymentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment_id")
+	}
+
+	output, err := h.refundPaymentUseCase.Execute(ctx, dto.RefundPaymentInput{
+		PaymentID: paymentID,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return &paymentv1.RefundPaymentResponse{
+		Success:  output.Success,
+		RefundId: output.RefundID,
+	}, nil
 }
 
+func toGetPaymentResponse(payment dto.GetPaymentOutput) *paymentv1.GetPaymentResponse {
+	return &paymentv1.GetPaymentResponse{
+		Id:                    payment.ID.String(),
+		SessionId:             payment.SessionID.String(),
+		UserId:                payment.UserID.String(),
+		Amount:                payment.Amount,
+		Currency:              payment.Currency,
+		StripePaymentIntentId: payment.StripePaymentIntentID,
+		Status:                paymentv1.PaymentStatus(paymentv1.PaymentStatus_value["PAYMENT_STATUS_"+string(payment.Status)]),
+		CreatedAt:             payment.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:             payment.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toGetPaymentResponses(payments []dto.GetPaymentOutput) []*paymentv1.GetPaymentResponse {
+	responses := make([]*paymentv1.GetPaymentResponse, 0, len(payments))
+	for _, payment := range payments {
+		responses = append(responses, toGetPaymentResponse(payment))
+	}
+	return responses
+}
+
+func mapErrorToGRPCStatus(err error) error {
+	switch pkgerrors.GetErrorCode(err) {
+	case pkgerrors.CodeNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case pkgerrors.CodeAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case pkgerrors.CodeInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case pkgerrors.CodeFailedPrecondition:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case pkgerrors.CodeExternalAPI:
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/application/payment/usecase"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/stripe/stripe-go/v76"
+	stripewebhook "github.com/stripe/stripe-go/v76/webhook"
+)
+
+const maxBodyBytes = 65536
+
+type StripeHandler struct {
+	webhookUseCase *usecase.HandleStripeWebhookUseCase
+	secret         string
+}
+
+func NewStripeHandler(webhookUseCase *usecase.HandleStripeWebhookUseCase, secret string) *StripeHandler {
+	return &StripeHandler{
+		webhookUseCase: webhookUseCase,
+		secret:         secret,
+	}
+}
+
+func (h *StripeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, `{"error":"method not allowed"}`, http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, `{"error":"failed to read request body"}`, http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	event, err := stripewebhook.ConstructEventWithOptions(
+		payload,
+		r.Header.Get("Stripe-Signature"),
+		h.secret,
+		stripewebhook.ConstructEventOptions{IgnoreAPIVersionMismatch: true},
+	)
+	if err != nil {
+		http.Error(w, `{"error":"invalid signature"}`, http.StatusBadRequest)
+		return
+	}
+
+	input, err := toEventInput(event)
+	if err != nil {
+		http.Error(w, `{"error":"invalid event payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.webhookUseCase.ProcessEvent(r.Context(), input)
+	if err != nil {
+		log.Printf("Failed to process stripe event %s (%s): %v", event.ID, event.Type, err)
+		writeError(w, err)
+		return
+	}
+
+	if output.Duplicate {
+		log.Printf("Skipping already processed stripe event %s", event.ID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"received":true}`))
+}
+
+func toEventInput(event stripe.Event) (dto.StripeWebhookEventInput, error) {
+	input := dto.StripeWebhookEventInput{
+		EventID:   event.ID,
+		EventType: string(event.Type),
+	}
+
+	if event.Data == nil {
+		return input, nil
+	}
+
+	switch {
+	case strings.HasPrefix(input.EventType, "payment_intent."):
+		var intent stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Raw, &intent); err != nil {
+			return input, err
+		}
+		input.PaymentIntentID = intent.ID
+		if intent.LastPaymentError != nil {
+			input.FailureReason = intent.LastPaymentError.Msg
+		} else if intent.CancellationReason != "" {
+			input.FailureReason = "canceled: " + string(intent.CancellationReason)
+		}
+	case strings.HasPrefix(input.EventType, "charge."):
+		var charge stripe.Charge
+		if err := json.Unmarshal(event.Data.Raw, &charge); err != nil {
+			return input, err
+		}
+		if charge.PaymentIntent != nil {
+			input.PaymentIntentID = charge.PaymentIntent.ID
+		}
+		if charge.Refunds != nil && len(charge.Refunds.Data) > 0 {
+			input.RefundID = charge.Refunds.Data[0].ID
+		}
+	}
+
+	return input, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch pkgerrors.GetErrorCode(err) {
+	case pkgerrors.CodeInvalidArgument:
+		http.Error(w, `{"error":"invalid event"}`, http.StatusBadRequest)
+	case pkgerrors.CodeNotFound:
+		http.Error(w, `{"error":"payment not found"}`, http.StatusNotFound)
+	default:
+		http.Error(w, `{"error":"internal error"}`, http.StatusInternalServerError)
+	}
+}
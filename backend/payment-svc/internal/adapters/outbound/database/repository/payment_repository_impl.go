@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const pgUniqueViolation = "23505"
+
+type PaymentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) port.PaymentRepository {
+	return &PaymentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *PaymentRepositoryImpl) Create(ctx context.Context, payment *entity.Payment) error {
+	now := time.Now()
+	if payment.CreatedAt.IsZero() {
+		payment.CreatedAt = now
+	}
+	if payment.UpdatedAt.IsZero() {
+		payment.UpdatedAt = now
+	}
+
+	var omit []string
+	if payment.StripePaymentIntentID == "" {
+		omit = append(omit, "stripe_payment_intent_id") // Unique column, must stay NULL until an intent exists
+	}
+	if payment.FailureReason == "" {
+		omit = append(omit, "failure_reason")
+	}
+	if payment.RefundID == "" {
+		omit = append(omit, "refund_id")
+	}
+
+	query := dbFromContext(ctx, r.db)
+	if len(omit) > 0 {
+		query = query.Omit(omit...)
+	}
+
+	result := query.Create(payment)
+	if result.Error != nil {
+		// uq_payments_active_session_user settles concurrent starts for the same participant
+		if isUniqueViolation(result.Error) {
+			return pkgerrors.NewAlreadyExistsError("payment for this session already exists")
+		}
+		return pkgerrors.NewInternalError("failed to create payment", result.Error)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Payment, error) {
+	var payment entity.Payment
+	result := dbFromContext(ctx, r.db).Where("id = ?", id).First(&payment)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NewNotFoundError(fmt.Sprintf("payment not found: %s", id))
+		}
+		return nil, pkgerrors.NewInternalError("failed to get payment", result.Error)
+	}
+
+	return &payment, nil
+}
+
+func (r *PaymentRepositoryImpl) GetByStripePaymentIntentID(ctx context.Context, stripeID string) (*entity.Payment, error) {
+	var payment entity.Payment
+	result := dbFromContext(ctx, r.db).Where("stripe_payment_intent_id = ?", stripeID).First(&payment)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NewNotFoundError(fmt.Sprintf("payment not found for intent: %s", stripeID))
+		}
+		return nil, pkgerrors.NewInternalError("failed to get payment by intent", result.Error)
+	}
+
+	return &payment, nil
+}
+
+func (r *PaymentRepositoryImpl) ListBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*entity.Payment, error) {
+	var payments []*entity.Payment
+	result := dbFromContext(ctx, r.db).Where("session_id = ?", sessionID).Order("created_at DESC").Find(&payments)
+
+	if result.Error != nil {
+		return nil, pkgerrors.NewInternalError("failed to list session payments", result.Error)
+	}
+
+	return payments, nil
+}
+
+func (r *PaymentRepositoryImpl) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Payment, error) {
+	var payments []*entity.Payment
+	result := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC").Find(&payments)
+
+	if result.Error != nil {
+		return nil, pkgerrors.NewInternalError("failed to list user payments", result.Error)
+	}
+
+	return payments, nil
+}
+
+func (r *PaymentRepositoryImpl) Update(ctx context.Context, payment *entity.Payment) error {
+	payment.UpdatedAt = time.Now()
+
+	result := dbFromContext(ctx, r.db).Model(&entity.Payment{}).Where("id = ?", payment.ID).Updates(map[string]interface{}{
+		"stripe_payment_intent_id": nullableString(payment.StripePaymentIntentID),
+		"status":                   payment.Status,
+		"failure_reason":           nullableString(payment.FailureReason),
+		"refund_id":                nullableString(payment.RefundID),
+		"updated_at":               payment.UpdatedAt,
+	})
+
+	if result.Error != nil {
+		if isUniqueViolation(result.Error) {
+			return pkgerrors.NewAlreadyExistsError("payment intent is already linked to another payment")
+		}
+		return pkgerrors.NewInternalError("failed to update payment", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgerrors.NewNotFoundError(fmt.Sprintf("payment not found: %s", payment.ID))
+	}
+
+	return nil
+}
+
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.Is(err, gorm.ErrDuplicatedKey) || (errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ProcessedEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewProcessedEventRepository(db *gorm.DB) port.ProcessedEventRepository {
+	return &ProcessedEventRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ProcessedEventRepositoryImpl) IsProcessed(ctx context.Context, eventID string) (bool, error) {
+	var count int64
+	result := dbFromContext(ctx, r.db).
+		Table("stripe_webhook_events").
+		Where("event_id = ?", eventID).
+		Count(&count)
+	if result.Error != nil {
+		return false, pkgerrors.NewInternalError("failed to check webhook event", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (r *ProcessedEventRepositoryImpl) MarkProcessed(ctx context.Context, eventID, eventType string) error {
+	result := dbFromContext(ctx, r.db).Exec(
+		`INSERT INTO stripe_webhook_events (event_id, event_type, processed_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT (event_id) DO NOTHING`,
+		eventID, eventType, time.Now(),
+	)
+	if result.Error != nil {
+		return pkgerrors.NewInternalError("failed to record webhook event", result.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
+	"github.com/google/uuid"
+)
+
+// OutboxEventPublisher stores events in the outbox within the caller's transaction;
+// outbox.Relay delivers them to NATS once the transaction has committed
+type OutboxEventPublisher struct {
+	outbox outbox.Repository
+	codec  *sharedevents.Codec
+}
+
+func NewOutboxEventPublisher(outbox outbox.Repository, codec *sharedevents.Codec) *OutboxEventPublisher {
+	return &OutboxEventPublisher{outbox: outbox, codec: codec}
+}
+
+func (p *OutboxEventPublisher) PublishPaymentCreated(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64, currency string) error {
+	return p.publish(ctx, sharedevents.SubjectPaymentCreated, sharedevents.PaymentCreated{
+		PaymentID: paymentID.String(),
+		SessionID: sessionID.String(),
+		UserID:    userID.String(),
+		Amount:    amount,
+		Currency:  currency,
+	})
+}
+
+func (p *OutboxEventPublisher) PublishPaymentSucceeded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64, currency string) error {
+	return p.publish(ctx, sharedevents.SubjectPaymentSucceeded, sharedevents.PaymentSucceeded{
+		PaymentID: paymentID.String(),
+		SessionID: sessionID.String(),
+		UserID:    userID.String(),
+		Amount:    amount,
+		Currency:  currency,
+	})
+}
+
+func (p *OutboxEventPublisher) PublishPaymentFailed(ctx context.Context, paymentID, sessionID, userID uuid.UUID, reason string) error {
+	return p.publish(ctx, sharedevents.SubjectPaymentFailed, sharedevents.PaymentFailed{
+		PaymentID: paymentID.String(),
+		SessionID: sessionID.String(),
+		UserID:    userID.String(),
+		Reason:    reason,
+	})
+}
+
+func (p *OutboxEventPublisher) PublishPaymentRefunded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, refundID string) error {
+	return p.publish(ctx, sharedevents.SubjectPaymentRefunded, sharedevents.PaymentRefunded{
+		PaymentID: paymentID.String(),
+		SessionID: sessionID.String(),
+		UserID:    userID.String(),
+		RefundID:  refundID,
+	})
+}
+
+func (p *OutboxEventPublisher) publish(ctx context.Context, subject string, payload interface{}) error {
+	envelope, err := p.codec.NewEnvelope(ctx, subject, payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return p.outbox.Add(ctx, outbox.NewMessage(envelope.ID, subject, data))
+}
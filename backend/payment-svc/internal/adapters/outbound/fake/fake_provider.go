@@ -0,0 +1,224 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	deliveryAttempts = 5
+	declineMessage   = "Your card was declined."
+)
+
+type intentStatus string
+
+const (
+	intentProcessing intentStatus = "processing"
+	intentSucceeded  intentStatus = "succeeded"
+	intentFailed     intentStatus = "failed"
+	intentRefunded   intentStatus = "refunded"
+)
+
+type Options struct {
+	Latency      time.Duration
+	ConfirmDelay time.Duration
+	FailureRate  float64
+}
+
+// FakeProvider is an in-process stand-in for Stripe: intents are confirmed
+// asynchronously after ConfirmDelay and outcomes are delivered through onEvent
+type FakeProvider struct {
+	opts    Options
+	onEvent port.ProviderEventHandler
+
+	mu      sync.Mutex
+	intents map[string]intentStatus
+	rnd     *rand.Rand
+
+	wg   sync.WaitGroup
+	done chan struct{}
+	once sync.Once
+}
+
+func NewFakeProvider(opts Options, onEvent port.ProviderEventHandler) *FakeProvider {
+	return &FakeProvider{
+		opts:    opts,
+		onEvent: onEvent,
+		intents: make(map[string]intentStatus),
+		rnd:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		done:    make(chan struct{}),
+	}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) CreatePaymentIntent(ctx context.Context, input port.CreatePaymentIntentInput) (*port.CreatePaymentIntentOutput, error) {
+	if err := p.wait(ctx, p.opts.Latency); err != nil {
+		return nil, pkgerrors.NewExternalAPIError("failed to create payment intent", err)
+	}
+
+	if input.Amount <= 0 {
+		return nil, pkgerrors.NewExternalAPIError("failed to create payment intent", fmt.Errorf("amount must be positive"))
+	}
+
+	intentID := "pi_fake_" + uuid.New().String()
+
+	p.mu.Lock()
+	p.intents[intentID] = intentProcessing
+	p.mu.Unlock()
+
+	p.goAsync(func() { p.confirm(intentID) })
+
+	return &port.CreatePaymentIntentOutput{
+		PaymentIntentID: intentID,
+		ClientSecret:    intentID + "_secret_" + uuid.New().String(),
+	}, nil
+}
+
+func (p *FakeProvider) CreateRefund(ctx context.Context, input port.RefundInput) (*port.RefundOutput, error) {
+	if err := p.wait(ctx, p.opts.Latency); err != nil {
+		return nil, pkgerrors.NewExternalAPIError("failed to create refund", err)
+	}
+
+	p.mu.Lock()
+	status, ok := p.intents[input.PaymentIntentID]
+	if ok && status == intentSucceeded {
+		p.intents[input.PaymentIntentID] = intentRefunded
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		return nil, pkgerrors.NewExternalAPIError("failed to create refund", fmt.Errorf("no such payment intent: %s", input.PaymentIntentID))
+	}
+	if status != intentSucceeded {
+		return nil, pkgerrors.NewExternalAPIError("failed to create refund", fmt.Errorf("payment intent %s is %s", input.PaymentIntentID, status))
+	}
+
+	refundID := "re_fake_" + uuid.New().String()
+
+	p.goAsync(func() {
+		p.emit(port.ProviderEvent{
+			Type:            "charge.refunded",
+			PaymentIntentID: input.PaymentIntentID,
+			RefundID:        refundID,
+		})
+	})
+
+	return &port.RefundOutput{
+		RefundID: refundID,
+	}, nil
+}
+
+func (p *FakeProvider) Close() {
+	p.once.Do(func() { close(p.done) })
+	p.wg.Wait()
+}
+
+func (p *FakeProvider) confirm(intentID string) {
+	if !p.sleep(p.opts.ConfirmDelay) {
+		return
+	}
+
+	p.emit(port.ProviderEvent{
+		Type:            "payment_intent.processing",
+		PaymentIntentID: intentID,
+	})
+
+	p.mu.Lock()
+	failed := p.rnd.Float64() < p.opts.FailureRate
+	if failed {
+		p.intents[intentID] = intentFailed
+	} else {
+		p.intents[intentID] = intentSucceeded
+	}
+	p.mu.Unlock()
+
+	if failed {
+		p.emit(port.ProviderEvent{
+			Type:            "payment_intent.payment_failed",
+			PaymentIntentID: intentID,
+			FailureReason:   declineMessage,
+		})
+		return
+	}
+
+	p.emit(port.ProviderEvent{
+		Type:            "payment_intent.succeeded",
+		PaymentIntentID: intentID,
+	})
+}
+
+// emit retries like Stripe redelivers webhooks, since the intent may not be persisted yet
+func (p *FakeProvider) emit(event port.ProviderEvent) {
+	if p.onEvent == nil {
+		return
+	}
+
+	event.ID = "evt_fake_" + uuid.New().String()
+	backoff := p.opts.ConfirmDelay
+	if backoff <= 0 {
+		backoff = 50 * time.Millisecond
+	}
+
+	for attempt := 1; attempt <= deliveryAttempts; attempt++ {
+		err := p.onEvent(context.Background(), event)
+		if err == nil {
+			return
+		}
+		log.Printf("Fake provider delivery of %s for %s failed (attempt %d): %v", event.Type, event.PaymentIntentID, attempt, err)
+		if !p.sleep(backoff) {
+			return
+		}
+	}
+}
+
+func (p *FakeProvider) goAsync(fn func()) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		fn()
+	}()
+}
+
+func (p *FakeProvider) wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (p *FakeProvider) sleep(d time.Duration) bool {
+	if d <= 0 {
+		select {
+		case <-p.done:
+			return false
+		default:
+			return true
+		}
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-p.done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
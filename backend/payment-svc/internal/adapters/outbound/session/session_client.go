@@ -0,0 +1,78 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	sessionv1 "github.com/diploma/payment-svc/api/proto/session/v1"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type SessionClient struct {
+	client sessionv1.SessionServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewSessionClient(address string) (*SessionClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionClient{
+		client: sessionv1.NewSessionServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *SessionClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *SessionClient) GetSession(ctx context.Context, sessionID uuid.UUID) (*port.SessionInfo, error) {
+	session, err := c.client.GetSession(ctx, &sessionv1.GetSessionRequest{SessionId: sessionID.String()})
+	if err != nil {
+		return nil, mapSessionError(err, "failed to get session")
+	}
+
+	participants, err := c.client.ListSessionParticipants(ctx, &sessionv1.ListSessionParticipantsRequest{SessionId: sessionID.String()})
+	if err != nil {
+		return nil, mapSessionError(err, "failed to list session participants")
+	}
+
+	participantIDs := make([]uuid.UUID, 0, len(participants.Participants))
+	for _, participant := range participants.Participants {
+		if participant.Status != sessionv1.ParticipantStatus_PARTICIPANT_STATUS_JOINED {
+			continue
+		}
+		userID, err := uuid.Parse(participant.UserId)
+		if err != nil {
+			return nil, pkgerrors.NewExternalAPIError("invalid participant user_id", err)
+		}
+		participantIDs = append(participantIDs, userID)
+	}
+
+	return &port.SessionInfo{
+		ID:                  sessionID,
+		PricePerParticipant: session.PricePerParticipant,
+		Status:              strings.TrimPrefix(session.Status.String(), "SESSION_STATUS_"),
+		ParticipantIDs:      participantIDs,
+	}, nil
+}
+
+func mapSessionError(err error, message string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return pkgerrors.NewNotFoundError("session not found")
+	case codes.InvalidArgument:
+		return pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+	default:
+		return pkgerrors.NewExternalAPIError(message, err)
+	}
+}
@@ -10,27 +10,36 @@ import (
 	"github.com/stripe/stripe-go/v76/refund"
 )
 
-type StripeClientImpl struct {
-	apiKey string
+type StripeProvider struct {
+	paymentIntents *paymentintent.Client
+	refunds        *refund.Client
 }
 
-func NewStripeClient(apiKey string) *StripeClientImpl {
-	stripe.Key = apiKey
-	return &StripeClientImpl{apiKey: apiKey}
+func NewStripeProvider(apiKey string) *StripeProvider {
+	backend := stripe.GetBackend(stripe.APIBackend)
+	return &StripeProvider{
+		paymentIntents: &paymentintent.Client{B: backend, Key: apiKey},
+		refunds:        &refund.Client{B: backend, Key: apiKey},
+	}
+}
+
+func (p *StripeProvider) Name() string {
+	return "stripe"
 }
 
-func (c *StripeClientImpl) CreatePaymentIntent(ctx context.Context, input port.CreatePaymentIntentInput) (*port.CreatePaymentIntentOutput, error) {
+func (p *StripeProvider) CreatePaymentIntent(ctx context.Context, input port.CreatePaymentIntentInput) (*port.CreatePaymentIntentOutput, error) {
 	params := &stripe.PaymentIntentParams{
 		Amount:      stripe.Int64(input.Amount),
 		Currency:    stripe.String(input.Currency),
 		Description: stripe.String(input.Description),
 	}
+	params.Context = ctx
 
 	if input.Metadata != nil {
 		params.Metadata = input.Metadata
 	}
 
-	pi, err := paymentintent.New(params)
+	pi, err := p.paymentIntents.New(params)
 	if err != nil {
 		return nil, pkgerrors.NewExternalAPIError("failed to create payment intent", err)
 	}
@@ -41,16 +50,17 @@ func (c *StripeClientImpl) CreatePaymentIntent(ctx context.Context, input port.C
 	}, nil
 }
 
-func (c *StripeClientImpl) CreateRefund(ctx context.Context, input port.RefundInput) (*port.RefundOutput, error) {
+func (p *StripeProvider) CreateRefund(ctx context.Context, input port.RefundInput) (*port.RefundOutput, error) {
 	params := &stripe.RefundParams{
 		PaymentIntent: stripe.String(input.PaymentIntentID),
 	}
+	params.Context = ctx
 
 	if input.Reason != "" {
 		params.Reason = stripe.String(input.Reason)
 	}
 
-	r, err := refund.New(params)
+	r, err := p.refunds.New(params)
 	if err != nil {
 		return nil, pkgerrors.NewExternalAPIError("failed to create refund", err)
 	}
@@ -59,4 +69,3 @@ func (c *StripeClientImpl) CreateRefund(ctx context.Context, input port.RefundIn
 		RefundID: r.ID,
 	}, nil
 }
-
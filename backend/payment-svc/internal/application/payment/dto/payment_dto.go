@@ -10,8 +10,6 @@ import (
 type StartPaymentForSessionInput struct {
 	SessionID uuid.UUID
 	UserID    uuid.UUID
-	Amount    float64
-	Currency  string
 }
 
 type StartPaymentForSessionOutput struct {
@@ -37,6 +35,18 @@ type GetPaymentOutput struct {
 	UpdatedAt             time.Time
 }
 
+type ListPaymentsBySessionInput struct {
+	SessionID uuid.UUID
+}
+
+type ListPaymentsByUserInput struct {
+	UserID uuid.UUID
+}
+
+type ListPaymentsOutput struct {
+	Payments []GetPaymentOutput
+}
+
 type RefundPaymentInput struct {
 	PaymentID uuid.UUID
 	Reason    string
@@ -47,6 +57,19 @@ type RefundPaymentOutput struct {
 	RefundID string
 }
 
+type StripeWebhookEventInput struct {
+	EventID         string
+	EventType       string
+	PaymentIntentID string
+	FailureReason   string
+	RefundID        string
+}
+
+type StripeWebhookEventOutput struct {
+	Duplicate bool
+	Handled   bool
+}
+
 func ToPaymentOutput(payment *entity.Payment) GetPaymentOutput {
 	return GetPaymentOutput{
 		ID:                    payment.ID,
@@ -60,4 +83,3 @@ func ToPaymentOutput(payment *entity.Payment) GetPaymentOutput {
 		UpdatedAt:             payment.UpdatedAt,
 	}
 }
-
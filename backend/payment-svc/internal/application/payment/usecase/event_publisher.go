@@ -7,8 +7,8 @@ import (
 )
 
 type EventPublisher interface {
-	PublishPaymentCreated(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64) error
-	PublishPaymentSucceeded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64) error
+	PublishPaymentCreated(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64, currency string) error
+	PublishPaymentSucceeded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64, currency string) error
 	PublishPaymentFailed(ctx context.Context, paymentID, sessionID, userID uuid.UUID, reason string) error
 	PublishPaymentRefunded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, refundID string) error
 }
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+)
+
+type GetPaymentUseCase struct {
+	paymentService *service.PaymentService
+}
+
+func NewGetPaymentUseCase(paymentService *service.PaymentService) *GetPaymentUseCase {
+	return &GetPaymentUseCase{paymentService: paymentService}
+}
+
+func (uc *GetPaymentUseCase) Execute(ctx context.Context, input dto.GetPaymentInput) (*dto.GetPaymentOutput, error) {
+	payment, err := uc.paymentService.GetPayment(ctx, input.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := dto.ToPaymentOutput(payment)
+	return &output, nil
+}
@@ -4,23 +4,103 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
 	"github.com/diploma/payment-svc/internal/domain/payment/service"
 	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
 )
 
+const (
+	StripeEventPaymentIntentProcessing = "payment_intent.processing"
+	StripeEventPaymentIntentSucceeded  = "payment_intent.succeeded"
+	StripeEventPaymentIntentFailed     = "payment_intent.payment_failed"
+	StripeEventPaymentIntentCanceled   = "payment_intent.canceled"
+	StripeEventChargeRefunded          = "charge.refunded"
+)
+
 type HandleStripeWebhookUseCase struct {
-	paymentService *service.PaymentService
-	eventPublisher EventPublisher
+	paymentService  *service.PaymentService
+	processedEvents port.ProcessedEventRepository
+	txManager       TransactionManager
+	eventPublisher  EventPublisher
 }
 
 func NewHandleStripeWebhookUseCase(
 	paymentService *service.PaymentService,
+	processedEvents port.ProcessedEventRepository,
+	txManager TransactionManager,
 	eventPublisher EventPublisher,
 ) *HandleStripeWebhookUseCase {
 	return &HandleStripeWebhookUseCase{
-		paymentService: paymentService,
-		eventPublisher: eventPublisher,
+		paymentService:  paymentService,
+		processedEvents: processedEvents,
+		txManager:       txManager,
+		eventPublisher:  eventPublisher,
+	}
+}
+
+func (uc *HandleStripeWebhookUseCase) ProcessEvent(ctx context.Context, input dto.StripeWebhookEventInput) (*dto.StripeWebhookEventOutput, error) {
+	if input.EventID == "" {
+		return nil, pkgerrors.NewInvalidArgumentError("event id is required")
 	}
+
+	var output *dto.StripeWebhookEventOutput
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		processed, err := uc.processedEvents.IsProcessed(ctx, input.EventID)
+		if err != nil {
+			return err
+		}
+		if processed {
+			output = &dto.StripeWebhookEventOutput{Duplicate: true}
+			return nil
+		}
+
+		handled, err := uc.dispatch(ctx, input)
+
+		// Out-of-order deliveries for a payment that already moved on are acknowledged, not retried
+		if err != nil && pkgerrors.GetErrorCode(err) != pkgerrors.CodeFailedPrecondition {
+			return err
+		}
+
+		if err := uc.processedEvents.MarkProcessed(ctx, input.EventID, input.EventType); err != nil {
+			return err
+		}
+
+		output = &dto.StripeWebhookEventOutput{Handled: handled}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *HandleStripeWebhookUseCase) dispatch(ctx context.Context, input dto.StripeWebhookEventInput) (bool, error) {
+	switch input.EventType {
+	case StripeEventPaymentIntentProcessing:
+		return true, uc.HandlePaymentIntentProcessing(ctx, input.PaymentIntentID)
+	case StripeEventPaymentIntentSucceeded:
+		return true, uc.HandlePaymentIntentSucceeded(ctx, input.PaymentIntentID)
+	case StripeEventPaymentIntentFailed, StripeEventPaymentIntentCanceled:
+		return true, uc.HandlePaymentIntentFailed(ctx, input.PaymentIntentID, input.FailureReason)
+	case StripeEventChargeRefunded:
+		return true, uc.HandleChargeRefunded(ctx, input.PaymentIntentID, input.RefundID)
+	default:
+		return false, nil
+	}
+}
+
+func (uc *HandleStripeWebhookUseCase) HandleProviderEvent(ctx context.Context, event port.ProviderEvent) error {
+	_, err := uc.ProcessEvent(ctx, dto.StripeWebhookEventInput{
+		EventID:         event.ID,
+		EventType:       event.Type,
+		PaymentIntentID: event.PaymentIntentID,
+		FailureReason:   event.FailureReason,
+		RefundID:        event.RefundID,
+	})
+	return err
 }
 
 func (uc *HandleStripeWebhookUseCase) HandlePaymentIntentSucceeded(ctx context.Context, stripePaymentIntentID string) error {
@@ -29,6 +109,12 @@ func (uc *HandleStripeWebhookUseCase) HandlePaymentIntentSucceeded(ctx context.C
 		return err
 	}
 
+	if payment.Status == entity.PaymentStatusPending {
+		if err := payment.MarkProcessing(); err != nil {
+			return err
+		}
+	}
+
 	if err := payment.MarkSucceeded(); err != nil {
 		return err
 	}
@@ -38,7 +124,7 @@ func (uc *HandleStripeWebhookUseCase) HandlePaymentIntentSucceeded(ctx context.C
 	}
 
 	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishPaymentSucceeded(ctx, payment.ID, payment.SessionID, payment.UserID, payment.Amount)
+		return uc.eventPublisher.PublishPaymentSucceeded(ctx, payment.ID, payment.SessionID, payment.UserID, payment.Amount, payment.Currency)
 	}
 
 	return nil
@@ -59,7 +145,7 @@ func (uc *HandleStripeWebhookUseCase) HandlePaymentIntentFailed(ctx context.Cont
 	}
 
 	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishPaymentFailed(ctx, payment.ID, payment.SessionID, payment.UserID, reason)
+		return uc.eventPublisher.PublishPaymentFailed(ctx, payment.ID, payment.SessionID, payment.UserID, reason)
 	}
 
 	return nil
@@ -81,3 +167,27 @@ func (uc *HandleStripeWebhookUseCase) HandlePaymentIntentProcessing(ctx context.
 	return uc.paymentService.UpdatePaymentStatus(ctx, payment)
 }
 
+func (uc *HandleStripeWebhookUseCase) HandleChargeRefunded(ctx context.Context, stripePaymentIntentID string, refundID string) error {
+	payment, err := uc.paymentService.GetByStripePaymentIntentID(ctx, stripePaymentIntentID)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status == entity.PaymentStatusRefunded {
+		return nil // Refund was issued through RefundPayment
+	}
+
+	if err := payment.MarkRefunded(refundID); err != nil {
+		return err
+	}
+
+	if err := uc.paymentService.UpdatePaymentStatus(ctx, payment); err != nil {
+		return fmt.Errorf("failed to update payment status: %w", err)
+	}
+
+	if uc.eventPublisher != nil {
+		return uc.eventPublisher.PublishPaymentRefunded(ctx, payment.ID, payment.SessionID, payment.UserID, refundID)
+	}
+
+	return nil
+}
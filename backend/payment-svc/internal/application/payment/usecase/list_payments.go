@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+)
+
+type ListPaymentsBySessionUseCase struct {
+	paymentService *service.PaymentService
+}
+
+func NewListPaymentsBySessionUseCase(paymentService *service.PaymentService) *ListPaymentsBySessionUseCase {
+	return &ListPaymentsBySessionUseCase{paymentService: paymentService}
+}
+
+func (uc *ListPaymentsBySessionUseCase) Execute(ctx context.Context, input dto.ListPaymentsBySessionInput) (*dto.ListPaymentsOutput, error) {
+	payments, err := uc.paymentService.ListPaymentsBySession(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return toListPaymentsOutput(payments), nil
+}
+
+type ListPaymentsByUserUseCase struct {
+	paymentService *service.PaymentService
+}
+
+func NewListPaymentsByUserUseCase(paymentService *service.PaymentService) *ListPaymentsByUserUseCase {
+	return &ListPaymentsByUserUseCase{paymentService: paymentService}
+}
+
+func (uc *ListPaymentsByUserUseCase) Execute(ctx context.Context, input dto.ListPaymentsByUserInput) (*dto.ListPaymentsOutput, error) {
+	payments, err := uc.paymentService.ListPaymentsByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toListPaymentsOutput(payments), nil
+}
+
+func toListPaymentsOutput(payments []*entity.Payment) *dto.ListPaymentsOutput {
+	output := &dto.ListPaymentsOutput{
+		Payments: make([]dto.GetPaymentOutput, 0, len(payments)),
+	}
+	for _, payment := range payments {
+		output.Payments = append(output.Payments, dto.ToPaymentOutput(payment))
+	}
+	return output
+}
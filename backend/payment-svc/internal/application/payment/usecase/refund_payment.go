@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+)
+
+type RefundPaymentUseCase struct {
+	paymentService  *service.PaymentService
+	paymentProvider port.PaymentProvider
+	txManager       TransactionManager
+	eventPublisher  EventPublisher
+}
+
+func NewRefundPaymentUseCase(
+	paymentService *service.PaymentService,
+	paymentProvider port.PaymentProvider,
+	txManager TransactionManager,
+	eventPublisher EventPublisher,
+) *RefundPaymentUseCase {
+	return &RefundPaymentUseCase{
+		paymentService:  paymentService,
+		paymentProvider: paymentProvider,
+		txManager:       txManager,
+		eventPublisher:  eventPublisher,
+	}
+}
+
+func (uc *RefundPaymentUseCase) Execute(ctx context.Context, input dto.RefundPaymentInput) (*dto.RefundPaymentOutput, error) {
+	payment, err := uc.paymentService.GetPayment(ctx, input.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !payment.IsSucceeded() {
+		return nil, pkgerrors.NewFailedPreconditionError("only succeeded payments can be refunded")
+	}
+
+	refund, err := uc.paymentProvider.CreateRefund(ctx, port.RefundInput{
+		PaymentIntentID: payment.StripePaymentIntentID,
+		Reason:          input.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payment.MarkRefunded(refund.RefundID); err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.paymentService.UpdatePaymentStatus(ctx, payment); err != nil {
+			return fmt.Errorf("failed to update payment status: %w", err)
+		}
+
+		if uc.eventPublisher != nil {
+			return uc.eventPublisher.PublishPaymentRefunded(ctx, payment.ID, payment.SessionID, payment.UserID, refund.RefundID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RefundPaymentOutput{
+		Success:  true,
+		RefundID: refund.RefundID,
+	}, nil
+}
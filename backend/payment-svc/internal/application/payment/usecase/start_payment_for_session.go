@@ -3,45 +3,75 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
 	"github.com/diploma/payment-svc/internal/domain/payment/port"
 	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
 )
 
 type StartPaymentForSessionUseCase struct {
-	paymentService *service.PaymentService
-	stripeClient   port.StripeClient
-	eventPublisher EventPublisher
+	paymentService  *service.PaymentService
+	sessionProvider port.SessionProvider
+	paymentProvider port.PaymentProvider
+	txManager       TransactionManager
+	eventPublisher  EventPublisher
 }
 
 func NewStartPaymentForSessionUseCase(
 	paymentService *service.PaymentService,
-	stripeClient port.StripeClient,
+	sessionProvider port.SessionProvider,
+	paymentProvider port.PaymentProvider,
+	txManager TransactionManager,
 	eventPublisher EventPublisher,
 ) *StartPaymentForSessionUseCase {
 	return &StartPaymentForSessionUseCase{
-		paymentService: paymentService,
-		stripeClient:   stripeClient,
-		eventPublisher: eventPublisher,
+		paymentService:  paymentService,
+		sessionProvider: sessionProvider,
+		paymentProvider: paymentProvider,
+		txManager:       txManager,
+		eventPublisher:  eventPublisher,
 	}
 }
 
 func (uc *StartPaymentForSessionUseCase) Execute(ctx context.Context, input dto.StartPaymentForSessionInput) (*dto.StartPaymentForSessionOutput, error) {
+	session, err := uc.sessionProvider.GetSession(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSessionPayable(session, input.UserID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.paymentService.ListPaymentsBySession(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range existing {
+		if p.UserID == input.UserID && p.IsActive() {
+			return nil, pkgerrors.NewAlreadyExistsError("payment for this session already exists")
+		}
+	}
+
 	payment, err := uc.paymentService.CreatePayment(
 		ctx,
 		input.SessionID,
 		input.UserID,
-		input.Amount,
-		input.Currency,
+		session.PricePerParticipant,
+		entity.DefaultCurrency,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	stripeInput := port.CreatePaymentIntentInput{
-		Amount:      int64(input.Amount * 100), // Convert to cents
-		Currency:    input.Currency,
+	intentInput := port.CreatePaymentIntentInput{
+		Amount:      int64(math.Round(payment.Amount * 100)), // Convert to cents
+		Currency:    payment.Currency,
 		Description: fmt.Sprintf("Payment for session %s", input.SessionID),
 		Metadata: map[string]string{
 			"payment_id": payment.ID.String(),
@@ -50,28 +80,48 @@ func (uc *StartPaymentForSessionUseCase) Execute(ctx context.Context, input dto.
 		},
 	}
 
-	stripeOutput, err := uc.stripeClient.CreatePaymentIntent(ctx, stripeInput)
+	intentOutput, err := uc.paymentProvider.CreatePaymentIntent(ctx, intentInput)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := payment.MarkPending(stripeOutput.PaymentIntentID); err != nil {
+	if err := payment.MarkPending(intentOutput.PaymentIntentID); err != nil {
 		return nil, err
 	}
 
-	if err := uc.paymentService.UpdatePaymentStatus(ctx, payment); err != nil {
-		return nil, err
-	}
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.paymentService.UpdatePaymentStatus(ctx, payment); err != nil {
+			return err
+		}
 
-	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishPaymentCreated(ctx, payment.ID, payment.SessionID, payment.UserID, payment.Amount)
+		if uc.eventPublisher != nil {
+			return uc.eventPublisher.PublishPaymentCreated(ctx, payment.ID, payment.SessionID, payment.UserID, payment.Amount, payment.Currency)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &dto.StartPaymentForSessionOutput{
 		PaymentID:    payment.ID,
-		ClientSecret: stripeOutput.ClientSecret,
+		ClientSecret: intentOutput.ClientSecret,
 		Amount:       payment.Amount,
 		Currency:     payment.Currency,
 	}, nil
 }
 
+func checkSessionPayable(session *port.SessionInfo, userID uuid.UUID) error {
+	if session.Status == "CANCELLED" || session.Status == "COMPLETED" {
+		return pkgerrors.NewFailedPreconditionError(fmt.Sprintf("cannot pay for a %s session", strings.ToLower(session.Status)))
+	}
+	if session.PricePerParticipant <= 0 {
+		return pkgerrors.NewFailedPreconditionError("session is free of charge")
+	}
+	for _, participantID := range session.ParticipantIDs {
+		if participantID == userID {
+			return nil
+		}
+	}
+	return pkgerrors.NewFailedPreconditionError("user has not joined this session")
+}
@@ -3,15 +3,23 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	GRPCPort      string
-	DBConfig      DatabaseConfig
-	NATSConfig    NATSConfig
-	StripeConfig  StripeConfig
+	GRPCPort          string
+	HTTPPort          string
+	DBConfig          DatabaseConfig
+	NATSConfig        NATSConfig
+	ProviderConfig    ProviderConfig
+	StripeConfig      StripeConfig
+	FakeConfig        FakeProviderConfig
+	OutboxConfig      OutboxConfig
+	JWT               JWTConfig
+	SessionServiceURL string
 }
 
 type DatabaseConfig struct {
@@ -24,12 +32,39 @@ type DatabaseConfig struct {
 }
 
 type NATSConfig struct {
-	URL string
+	URL          string
+	Timeout      time.Duration
+	StreamMaxAge time.Duration
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxBackoff   time.Duration
+	Retention    time.Duration
+	ClaimTimeout time.Duration
+}
+
+// JWTConfig locates the public keys auth-svc signs access tokens with
+type JWTConfig struct {
+	JWKSURL     string
+	Issuer      string
+	KeyCacheTTL time.Duration
+}
+
+type ProviderConfig struct {
+	Name string
+}
+
+type FakeProviderConfig struct {
+	Latency      time.Duration
+	ConfirmDelay time.Duration
+	FailureRate  float64
 }
 
 type StripeConfig struct {
-	APIKey         string
-	WebhookSecret  string
+	APIKey        string
+	WebhookSecret string
 }
 
 func Load() (*Config, error) {
@@ -37,6 +72,7 @@ func Load() (*Config, error) {
 
 	cfg := &Config{
 		GRPCPort: getEnv("GRPC_PORT", "50055"),
+		HTTPPort: getEnv("HTTP_PORT", "8085"),
 		DBConfig: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnv("DB_PORT", "5432"),
@@ -46,12 +82,41 @@ func Load() (*Config, error) {
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
 		},
 		NATSConfig: NATSConfig{
-			URL: getEnv("NATS_URL", "nats://localhost:4222"),
+			URL:          getEnv("NATS_URL", "nats://localhost:4222"),
+			Timeout:      getEnvAsDuration("NATS_TIMEOUT", 5*time.Second),
+			StreamMaxAge: getEnvAsDuration("EVENT_STREAM_MAX_AGE", 7*24*time.Hour),
+		},
+		OutboxConfig: OutboxConfig{
+			PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", 1*time.Second),
+			BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+			MaxBackoff:   getEnvAsDuration("OUTBOX_MAX_BACKOFF", 1*time.Minute),
+			Retention:    getEnvAsDuration("OUTBOX_RETENTION", 24*time.Hour),
+			ClaimTimeout: getEnvAsDuration("OUTBOX_CLAIM_TIMEOUT", 5*time.Minute),
+		},
+		JWT: JWTConfig{
+			JWKSURL:     getEnv("JWKS_URL", "http://localhost:8080/.well-known/jwks.json"),
+			Issuer:      getEnv("JWT_ISSUER", "auth-svc"),
+			KeyCacheTTL: getEnvAsDuration("JWT_KEY_CACHE_TTL", 10*time.Minute),
+		},
+		ProviderConfig: ProviderConfig{
+			Name: getEnv("PAYMENT_PROVIDER", "stripe"),
 		},
 		StripeConfig: StripeConfig{
 			APIKey:        getEnv("STRIPE_API_KEY", ""),
 			WebhookSecret: getEnv("STRIPE_WEBHOOK_SECRET", ""),
 		},
+		FakeConfig: FakeProviderConfig{
+			Latency:      getEnvAsDuration("FAKE_PROVIDER_LATENCY", 200*time.Millisecond),
+			ConfirmDelay: getEnvAsDuration("FAKE_PROVIDER_CONFIRM_DELAY", 2*time.Second),
+			FailureRate:  getEnvAsFloat("FAKE_PROVIDER_FAILURE_RATE", 0),
+		},
+		SessionServiceURL: getEnv("SESSION_SERVICE_URL", "localhost:50054"),
+	}
+
+	switch cfg.ProviderConfig.Name {
+	case "stripe", "fake":
+	default:
+		return nil, fmt.Errorf("unknown PAYMENT_PROVIDER %q, expected stripe or fake", cfg.ProviderConfig.Name)
 	}
 
 	return cfg, nil
@@ -76,3 +141,26 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	valueStr := os.Getenv(key)
+	if value, err := strconv.ParseFloat(valueStr, 64); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if value, err := time.ParseDuration(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsInt(key string, defaultValue int) int {
+	valueStr := os.Getenv(key)
+	if value, err := strconv.Atoi(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
@@ -18,6 +18,8 @@ const (
 	PaymentStatusRefunded   PaymentStatus = "REFUNDED"
 )
 
+const DefaultCurrency = "USD"
+
 type Payment struct {
 	ID                    uuid.UUID
 	SessionID             uuid.UUID
@@ -126,7 +128,10 @@ func (p *Payment) IsSucceeded() bool {
 	return p.Status == PaymentStatusSucceeded
 }
 
+func (p *Payment) IsActive() bool {
+	return p.Status != PaymentStatusFailed && p.Status != PaymentStatusRefunded
+}
+
 func (p *Payment) IsFinal() bool {
 	return p.Status == PaymentStatusSucceeded || p.Status == PaymentStatusFailed || p.Status == PaymentStatusRefunded
 }
-
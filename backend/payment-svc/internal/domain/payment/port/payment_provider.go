@@ -23,8 +23,19 @@ type RefundOutput struct {
 	RefundID string
 }
 
-type StripeClient interface {
+type PaymentProvider interface {
+	Name() string
 	CreatePaymentIntent(ctx context.Context, input CreatePaymentIntentInput) (*CreatePaymentIntentOutput, error)
 	CreateRefund(ctx context.Context, input RefundInput) (*RefundOutput, error)
 }
 
+// ProviderEvent is an asynchronous payment outcome, shaped like a Stripe webhook event
+type ProviderEvent struct {
+	ID              string
+	Type            string
+	PaymentIntentID string
+	FailureReason   string
+	RefundID        string
+}
+
+type ProviderEventHandler func(ctx context.Context, event ProviderEvent) error
@@ -0,0 +1,8 @@
+package port
+
+import "context"
+
+type ProcessedEventRepository interface {
+	IsProcessed(ctx context.Context, eventID string) (bool, error)
+	MarkProcessed(ctx context.Context, eventID, eventType string) error
+}
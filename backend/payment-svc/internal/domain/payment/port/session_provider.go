@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type SessionInfo struct {
+	ID                  uuid.UUID
+	PricePerParticipant float64
+	Status              string // OPEN, FULL, IN_PROGRESS, COMPLETED, CANCELLED
+	ParticipantIDs      []uuid.UUID
+}
+
+type SessionProvider interface {
+	GetSession(ctx context.Context, sessionID uuid.UUID) (*SessionInfo, error)
+}
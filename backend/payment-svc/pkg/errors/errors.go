@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 type DomainError struct {
 	Code    string
@@ -56,6 +59,9 @@ func GetErrorCode(err error) string {
 	if domainErr, ok := err.(*DomainError); ok {
 		return domainErr.Code
 	}
+	var de *DomainError
+	if errors.As(err, &de) {
+		return de.Code
+	}
 	return CodeInternal
 }
-
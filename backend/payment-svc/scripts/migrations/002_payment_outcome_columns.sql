@@ -0,0 +1,9 @@
+-- Outcome columns for databases created before failure_reason and refund_id existed
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS failure_reason TEXT;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS refund_id VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_payments_refund_id ON payments(refund_id) WHERE refund_id IS NOT NULL;
+
+COMMENT ON COLUMN payments.failure_reason IS 'Provider-reported reason for a FAILED payment';
+COMMENT ON COLUMN payments.refund_id IS 'Provider refund ID for a REFUNDED payment';
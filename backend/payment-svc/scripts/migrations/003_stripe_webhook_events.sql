@@ -0,0 +1,11 @@
+-- Stripe webhook events that have already been applied, so redeliveries are ignored
+
+CREATE TABLE IF NOT EXISTS stripe_webhook_events (
+    event_id VARCHAR(255) PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stripe_webhook_events_processed_at ON stripe_webhook_events(processed_at);
+
+COMMENT ON TABLE stripe_webhook_events IS 'Processed Stripe webhook event IDs for idempotent handling';
@@ -0,0 +1,8 @@
+-- At most one live payment per participant and session; concurrent StartPaymentForSession
+-- calls could both pass the existence check and charge twice.
+-- Creating the index fails if duplicates already exist; list them with:
+--   SELECT session_id, user_id FROM payments
+--   WHERE status NOT IN ('FAILED', 'REFUNDED') GROUP BY session_id, user_id HAVING COUNT(*) > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payments_active_session_user
+    ON payments(session_id, user_id) WHERE status NOT IN ('FAILED', 'REFUNDED');
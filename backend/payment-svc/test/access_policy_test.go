@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diploma/authz"
+	paymentv1 "github.com/diploma/payment-svc/api/v1"
+	"github.com/diploma/payment-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/payment-svc/internal/application/payment/usecase"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubVerifier struct {
+	claims *authz.Claims
+}
+
+func (v stubVerifier) Verify(token string) (*authz.Claims, error) {
+	return v.claims, nil
+}
+
+func newPaymentHandler(repo *MockPaymentRepo) *handler.PaymentGRPCHandler {
+	svc := service.NewPaymentService(repo)
+	return handler.NewPaymentGRPCHandler(
+		nil,
+		usecase.NewGetPaymentUseCase(svc),
+		usecase.NewListPaymentsBySessionUseCase(svc),
+		usecase.NewListPaymentsByUserUseCase(svc),
+		nil,
+	)
+}
+
+func asUser(userID uuid.UUID, role authz.Role) context.Context {
+	return authz.NewContext(context.Background(), authz.Principal{UserID: userID.String(), Role: role})
+}
+
+func TestAccessPolicy_CoversEveryMethod(t *testing.T) {
+	for _, method := range paymentv1.PaymentService_ServiceDesc.Methods {
+		fullMethod := "/" + paymentv1.PaymentService_ServiceDesc.ServiceName + "/" + method.MethodName
+		if _, ok := handler.AccessPolicy[fullMethod]; !ok {
+			t.Errorf("Expected an access rule for %s", fullMethod)
+		}
+	}
+}
+
+func TestGetPayment_RejectsOtherUsers(t *testing.T) {
+	repo := NewMockPaymentRepo()
+	payment := &entity.Payment{ID: uuid.New(), SessionID: uuid.New(), UserID: uuid.New(), Amount: 10, Currency: "USD", Status: entity.PaymentStatusPending}
+	repo.put(payment)
+	h := newPaymentHandler(repo)
+
+	_, err := h.GetPayment(asUser(uuid.New(), authz.RolePlayer), &paymentv1.GetPaymentRequest{PaymentId: payment.ID.String()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+
+	if _, err := h.GetPayment(asUser(payment.UserID, authz.RolePlayer), &paymentv1.GetPaymentRequest{PaymentId: payment.ID.String()}); err != nil {
+		t.Errorf("Expected the payer to read their payment, got %v", err)
+	}
+	if _, err := h.GetPayment(asUser(uuid.New(), authz.RoleAdmin), &paymentv1.GetPaymentRequest{PaymentId: payment.ID.String()}); err != nil {
+		t.Errorf("Expected an admin to read any payment, got %v", err)
+	}
+}
+
+func TestGetPaymentsByUser_RejectsOtherUsers(t *testing.T) {
+	h := newPaymentHandler(NewMockPaymentRepo())
+
+	_, err := h.GetPaymentsByUser(asUser(uuid.New(), authz.RolePlayer), &paymentv1.GetPaymentsByUserRequest{UserId: uuid.New().String()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestGetPaymentsBySession_HidesOtherUsersPayments(t *testing.T) {
+	repo := NewMockPaymentRepo()
+	sessionID := uuid.New()
+	caller := uuid.New()
+	for _, userID := range []uuid.UUID{caller, uuid.New()} {
+		repo.put(&entity.Payment{ID: uuid.New(), SessionID: sessionID, UserID: userID, Amount: 10, Currency: "USD", Status: entity.PaymentStatusPending})
+	}
+	h := newPaymentHandler(repo)
+
+	resp, err := h.GetPaymentsBySession(asUser(caller, authz.RolePlayer), &paymentv1.GetPaymentsBySessionRequest{SessionId: sessionID.String()})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.Payments) != 1 || resp.Payments[0].UserId != caller.String() {
+		t.Errorf("Expected only the caller's payment, got %+v", resp.Payments)
+	}
+
+	resp, err = h.GetPaymentsBySession(asUser(uuid.New(), authz.RoleAdmin), &paymentv1.GetPaymentsBySessionRequest{SessionId: sessionID.String()})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.Payments) != 2 {
+		t.Errorf("Expected an admin to see both payments, got %d", len(resp.Payments))
+	}
+}
+
+func TestStartPaymentForSession_RejectsOtherUsers(t *testing.T) {
+	h := newPaymentHandler(NewMockPaymentRepo())
+
+	_, err := h.StartPaymentForSession(asUser(uuid.New(), authz.RolePlayer), &paymentv1.StartPaymentForSessionRequest{
+		SessionId: uuid.New().String(),
+		UserId:    uuid.New().String(),
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestRefundPayment_RequiresRefundPermission(t *testing.T) {
+	call := func(role authz.Role) error {
+		interceptor := authz.UnaryServerInterceptor(stubVerifier{&authz.Claims{UserID: uuid.New().String(), Role: role}}, handler.AccessPolicy)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: paymentv1.PaymentService_RefundPayment_FullMethodName}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	// Even the payer may not refund their own payment
+	if err := call(authz.RolePlayer); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a player, got %v", err)
+	}
+	if err := call(authz.RoleAdmin); err != nil {
+		t.Errorf("Expected an admin to refund, got %v", err)
+	}
+}
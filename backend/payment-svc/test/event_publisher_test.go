@@ -0,0 +1,107 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
+	"github.com/diploma/payment-svc/internal/adapters/outbound/external/events"
+	"github.com/google/uuid"
+)
+
+type capturingOutbox struct {
+	messages []*outbox.Message
+}
+
+func (o *capturingOutbox) Add(ctx context.Context, message *outbox.Message) error {
+	o.messages = append(o.messages, message)
+	return nil
+}
+
+func (o *capturingOutbox) ClaimPending(ctx context.Context, now, claimedUntil time.Time, limit int) ([]*outbox.Message, error) {
+	return nil, nil
+}
+
+func (o *capturingOutbox) MarkSent(ctx context.Context, ids []uuid.UUID, sentAt time.Time) error {
+	return nil
+}
+
+func (o *capturingOutbox) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error {
+	return nil
+}
+
+func (o *capturingOutbox) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+// The payload keys are the contract notification-svc consumes
+func TestOutboxEventPublisher_Payloads(t *testing.T) {
+	box := &capturingOutbox{}
+	publisher := events.NewOutboxEventPublisher(box, sharedevents.NewCodec("payment-svc"))
+	ctx := context.Background()
+	paymentID, sessionID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	publish := []func() error{
+		func() error { return publisher.PublishPaymentCreated(ctx, paymentID, sessionID, userID, 15, "USD") },
+		func() error { return publisher.PublishPaymentSucceeded(ctx, paymentID, sessionID, userID, 15, "USD") },
+		func() error {
+			return publisher.PublishPaymentFailed(ctx, paymentID, sessionID, userID, "card_declined")
+		},
+		func() error { return publisher.PublishPaymentRefunded(ctx, paymentID, sessionID, userID, "re_123") },
+	}
+	ids := map[string]interface{}{
+		"payment_id": paymentID.String(),
+		"session_id": sessionID.String(),
+		"user_id":    userID.String(),
+	}
+	want := []struct {
+		subject string
+		extra   map[string]interface{}
+	}{
+		{sharedevents.SubjectPaymentCreated, map[string]interface{}{"amount": 15.0, "currency": "USD"}},
+		{sharedevents.SubjectPaymentSucceeded, map[string]interface{}{"amount": 15.0, "currency": "USD"}},
+		{sharedevents.SubjectPaymentFailed, map[string]interface{}{"reason": "card_declined"}},
+		{sharedevents.SubjectPaymentRefunded, map[string]interface{}{"refund_id": "re_123"}},
+	}
+
+	for i, fn := range publish {
+		if err := fn(); err != nil {
+			t.Fatalf("Failed to publish %s: %v", want[i].subject, err)
+		}
+	}
+	if len(box.messages) != len(want) {
+		t.Fatalf("Expected %d outbox messages, got %d", len(want), len(box.messages))
+	}
+
+	for i, message := range box.messages {
+		if message.Subject != want[i].subject {
+			t.Errorf("Expected subject %s, got %s", want[i].subject, message.Subject)
+		}
+		envelope, err := sharedevents.Decode(message.Payload)
+		if err != nil {
+			t.Fatalf("Failed to decode %s: %v", message.Subject, err)
+		}
+		if envelope.Type != message.Subject || envelope.ID != message.EventID || envelope.Producer != "payment-svc" {
+			t.Errorf("Envelope does not match its outbox message: %+v vs %s/%s", envelope, message.Subject, message.EventID)
+		}
+
+		var payload map[string]interface{}
+		if err := json.Unmarshal(envelope.Payload, &payload); err != nil {
+			t.Fatalf("Failed to unmarshal %s payload: %v", message.Subject, err)
+		}
+		expected := map[string]interface{}{}
+		for k, v := range ids {
+			expected[k] = v
+		}
+		for k, v := range want[i].extra {
+			expected[k] = v
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("Unexpected %s payload:\n got  %v\n want %v", message.Subject, payload, expected)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diploma/payment-svc/internal/adapters/outbound/fake"
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/application/payment/usecase"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+var _ port.PaymentProvider = (*fake.FakeProvider)(nil)
+
+func collectEvents(events chan port.ProviderEvent) port.ProviderEventHandler {
+	return func(ctx context.Context, event port.ProviderEvent) error {
+		events <- event
+		return nil
+	}
+}
+
+func nextEvent(t *testing.T, events chan port.ProviderEvent) port.ProviderEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for provider event")
+		return port.ProviderEvent{}
+	}
+}
+
+func TestFakeProvider_ConfirmsIntent(t *testing.T) {
+	events := make(chan port.ProviderEvent, 4)
+	provider := fake.NewFakeProvider(fake.Options{ConfirmDelay: 10 * time.Millisecond}, collectEvents(events))
+	defer provider.Close()
+
+	output, err := provider.CreatePaymentIntent(context.Background(), port.CreatePaymentIntentInput{Amount: 1500, Currency: "USD"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if output.PaymentIntentID == "" || output.ClientSecret == "" {
+		t.Fatalf("Expected intent ID and client secret, got %+v", output)
+	}
+
+	processing := nextEvent(t, events)
+	if processing.Type != usecase.StripeEventPaymentIntentProcessing || processing.PaymentIntentID != output.PaymentIntentID {
+		t.Errorf("Expected processing event for %s, got %+v", output.PaymentIntentID, processing)
+	}
+
+	succeeded := nextEvent(t, events)
+	if succeeded.Type != usecase.StripeEventPaymentIntentSucceeded {
+		t.Errorf("Expected succeeded event, got %s", succeeded.Type)
+	}
+
+	if processing.ID == "" || processing.ID == succeeded.ID {
+		t.Error("Expected unique event IDs")
+	}
+}
+
+func TestFakeProvider_FailureRate(t *testing.T) {
+	events := make(chan port.ProviderEvent, 4)
+	provider := fake.NewFakeProvider(fake.Options{FailureRate: 1}, collectEvents(events))
+	defer provider.Close()
+
+	output, err := provider.CreatePaymentIntent(context.Background(), port.CreatePaymentIntentInput{Amount: 1500, Currency: "USD"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	nextEvent(t, events)
+	failed := nextEvent(t, events)
+	if failed.Type != usecase.StripeEventPaymentIntentFailed || failed.FailureReason == "" {
+		t.Errorf("Expected failed event with reason, got %+v", failed)
+	}
+
+	_, err = provider.CreateRefund(context.Background(), port.RefundInput{PaymentIntentID: output.PaymentIntentID})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeExternalAPI {
+		t.Errorf("Expected refund of failed intent to be rejected, got %v", err)
+	}
+}
+
+func TestFakeProvider_Latency(t *testing.T) {
+	provider := fake.NewFakeProvider(fake.Options{Latency: time.Second, ConfirmDelay: time.Hour}, nil)
+	defer provider.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := provider.CreatePaymentIntent(ctx, port.CreatePaymentIntentInput{Amount: 1500, Currency: "USD"})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeExternalAPI {
+		t.Errorf("Expected latency to exceed the caller deadline, got %v", err)
+	}
+}
+
+func TestFakeProvider_EndToEndPaymentAndRefund(t *testing.T) {
+	repo := NewMockPaymentRepo()
+	paymentService := service.NewPaymentService(repo)
+	webhookUseCase := usecase.NewHandleStripeWebhookUseCase(paymentService, NewMockProcessedEventRepo(), &MockTransactionManager{}, nil)
+
+	delivered := make(chan port.ProviderEvent, 4)
+	provider := fake.NewFakeProvider(fake.Options{ConfirmDelay: 10 * time.Millisecond}, func(ctx context.Context, event port.ProviderEvent) error {
+		if err := webhookUseCase.HandleProviderEvent(ctx, event); err != nil {
+			return err
+		}
+		delivered <- event
+		return nil
+	})
+	defer provider.Close()
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+	sessions := NewMockSessionProvider()
+	sessions.sessions[sessionID] = &port.SessionInfo{
+		ID:                  sessionID,
+		PricePerParticipant: 30,
+		Status:              "OPEN",
+		ParticipantIDs:      []uuid.UUID{userID},
+	}
+
+	ctx := context.Background()
+	started, err := usecase.NewStartPaymentForSessionUseCase(paymentService, sessions, provider, &MockTransactionManager{}, nil).
+		Execute(ctx, dto.StartPaymentForSessionInput{SessionID: sessionID, UserID: userID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	nextEvent(t, delivered)
+	nextEvent(t, delivered)
+
+	if stored := repo.get(started.PaymentID); stored.Status != entity.PaymentStatusSucceeded {
+		t.Fatalf("Expected status SUCCEEDED, got %v", stored.Status)
+	}
+
+	refund, err := usecase.NewRefundPaymentUseCase(paymentService, provider, &MockTransactionManager{}, nil).
+		Execute(ctx, dto.RefundPaymentInput{PaymentID: started.PaymentID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	refunded := nextEvent(t, delivered)
+	if refunded.Type != usecase.StripeEventChargeRefunded || refunded.RefundID != refund.RefundID {
+		t.Errorf("Expected charge.refunded for %s, got %+v", refund.RefundID, refunded)
+	}
+
+	if stored := repo.get(started.PaymentID); stored.Status != entity.PaymentStatusRefunded {
+		t.Errorf("Expected status REFUNDED, got %v", stored.Status)
+	}
+}
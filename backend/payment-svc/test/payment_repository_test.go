@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diploma/payment-svc/internal/adapters/outbound/database/repository"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestPaymentRepository_ConcurrentStartIsAlreadyExists(t *testing.T) {
+	db, conn := newRecordingDB(t)
+	conn.execErr = &pgconn.PgError{Code: "23505", ConstraintName: "uq_payments_active_session_user"}
+	svc := service.NewPaymentService(repository.NewPaymentRepository(db))
+
+	_, err := svc.CreatePayment(context.Background(), uuid.New(), uuid.New(), 15, entity.DefaultCurrency)
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeAlreadyExists {
+		t.Fatalf("Expected AlreadyExists for a unique violation, got %v", err)
+	}
+}
+
+// columnArgs maps each column an INSERT or UPDATE statement writes to the argument bound to it
+func columnArgs(t *testing.T, stmt RecordedStatement) map[string]driver.Value {
+	t.Helper()
+	args := make(map[string]driver.Value)
+	if strings.HasPrefix(stmt.Query, "INSERT") {
+		columns := stmt.Query[strings.Index(stmt.Query, "(")+1 : strings.Index(stmt.Query, ")")]
+		for i, column := range strings.Split(columns, ",") {
+			args[strings.Trim(column, `"`)] = stmt.Args[i]
+		}
+		return args
+	}
+	for _, match := range regexp.MustCompile(`"(\w+)"=\$(\d+)`).FindAllStringSubmatch(stmt.Query, -1) {
+		n, _ := strconv.Atoi(match[2])
+		args[match[1]] = stmt.Args[n-1]
+	}
+	return args
+}
+
+func TestPaymentRepository_CreateLeavesEmptyOutcomeColumnsNull(t *testing.T) {
+	db, conn := newRecordingDB(t)
+	payment := &entity.Payment{ID: uuid.New(), SessionID: uuid.New(), UserID: uuid.New(), Amount: 15, Currency: "USD", Status: entity.PaymentStatusCreated}
+
+	if err := repository.NewPaymentRepository(db).Create(context.Background(), payment); err != nil {
+		t.Fatalf("Failed to create payment: %v", err)
+	}
+
+	args := columnArgs(t, conn.lastExec(t, `INSERT INTO "payments"`))
+	for _, column := range []string{"stripe_payment_intent_id", "failure_reason", "refund_id"} {
+		if value, ok := args[column]; ok {
+			t.Errorf("Expected %s to be left to its NULL default, got %v", column, value)
+		}
+	}
+	if args["status"] != "CREATED" {
+		t.Errorf("Expected status CREATED, got %v", args["status"])
+	}
+}
+
+func TestPaymentRepository_CreateWritesOutcomeColumns(t *testing.T) {
+	db, conn := newRecordingDB(t)
+	payment := &entity.Payment{
+		ID: uuid.New(), SessionID: uuid.New(), UserID: uuid.New(), Amount: 15, Currency: "USD",
+		Status:                entity.PaymentStatusFailed,
+		StripePaymentIntentID: "pi_123",
+		FailureReason:         "card_declined",
+		RefundID:              "re_123",
+	}
+
+	if err := repository.NewPaymentRepository(db).Create(context.Background(), payment); err != nil {
+		t.Fatalf("Failed to create payment: %v", err)
+	}
+
+	args := columnArgs(t, conn.lastExec(t, `INSERT INTO "payments"`))
+	want := map[string]string{"stripe_payment_intent_id": "pi_123", "failure_reason": "card_declined", "refund_id": "re_123"}
+	for column, value := range want {
+		if args[column] != value {
+			t.Errorf("Expected %s to be %q, got %v", column, value, args[column])
+		}
+	}
+}
+
+func TestPaymentRepository_UpdateWritesOutcomeColumns(t *testing.T) {
+	db, conn := newRecordingDB(t)
+	payment := &entity.Payment{ID: uuid.New(), Status: entity.PaymentStatusFailed, StripePaymentIntentID: "pi_123", FailureReason: "card_declined"}
+
+	if err := repository.NewPaymentRepository(db).Update(context.Background(), payment); err != nil {
+		t.Fatalf("Failed to update payment: %v", err)
+	}
+
+	args := columnArgs(t, conn.lastExec(t, `UPDATE "payments"`))
+	if args["status"] != "FAILED" || args["failure_reason"] != "card_declined" || args["stripe_payment_intent_id"] != "pi_123" {
+		t.Errorf("Expected the failure to be written, got %v", args)
+	}
+	if value, ok := args["refund_id"]; !ok || value != nil {
+		t.Errorf("Expected an empty refund_id to be written as NULL, got %v (set: %v)", value, ok)
+	}
+}
+
+func TestPaymentRepository_GetReadsNullOutcomeColumnsAsEmpty(t *testing.T) {
+	db, conn := newRecordingDB(t)
+	id := uuid.New()
+	conn.returnRows(
+		[]string{"id", "session_id", "user_id", "amount", "currency", "stripe_payment_intent_id", "status", "failure_reason", "refund_id", "created_at", "updated_at"},
+		[]driver.Value{id.String(), uuid.NewString(), uuid.NewString(), 15.0, "USD", nil, "CREATED", nil, nil, time.Now(), time.Now()},
+	)
+
+	payment, err := repository.NewPaymentRepository(db).GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("Failed to get payment: %v", err)
+	}
+	if payment.ID != id || payment.StripePaymentIntentID != "" || payment.FailureReason != "" || payment.RefundID != "" {
+		t.Errorf("Expected NULL outcome columns to read as empty strings, got %+v", payment)
+	}
+}
@@ -2,16 +2,21 @@ package test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/diploma/payment-svc/internal/application/payment/dto"
+	"github.com/diploma/payment-svc/internal/application/payment/usecase"
 	"github.com/diploma/payment-svc/internal/domain/payment/entity"
 	"github.com/diploma/payment-svc/internal/domain/payment/port"
 	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	pkgerrors "github.com/diploma/payment-svc/pkg/errors"
 	"github.com/google/uuid"
 )
 
 type MockPaymentRepo struct {
+	mu       sync.Mutex
 	payments map[uuid.UUID]*entity.Payment
 }
 
@@ -19,69 +24,118 @@ func NewMockPaymentRepo() *MockPaymentRepo {
 	return &MockPaymentRepo{payments: make(map[uuid.UUID]*entity.Payment)}
 }
 
+// Payments are copied in and out so callers cannot share state, as with a real database
+func (m *MockPaymentRepo) put(p *entity.Payment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *p
+	m.payments[p.ID] = &stored
+}
+
+func (m *MockPaymentRepo) get(id uuid.UUID) *entity.Payment {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if p, ok := m.payments[id]; ok {
+		copied := *p
+		return &copied
+	}
+	return nil
+}
+
 func (m *MockPaymentRepo) Create(ctx context.Context, p *entity.Payment) error {
-	m.payments[p.ID] = p
+	m.put(p)
 	return nil
 }
 
 func (m *MockPaymentRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.Payment, error) {
-	if p, ok := m.payments[id]; ok {
+	if p := m.get(id); p != nil {
 		return p, nil
 	}
-	return nil, nil
+	return nil, pkgerrors.NewNotFoundError("payment not found")
 }
 
 func (m *MockPaymentRepo) Update(ctx context.Context, p *entity.Payment) error {
-	m.payments[p.ID] = p
+	m.put(p)
 	return nil
 }
 
 func (m *MockPaymentRepo) GetByStripePaymentIntentID(ctx context.Context, stripeID string) (*entity.Payment, error) {
-	for _, p := range m.payments {
-		if p.StripePaymentIntentID == stripeID {
-			return p, nil
-		}
-	}
-	return nil, nil
+	return m.find(func(p *entity.Payment) bool { return p.StripePaymentIntentID == stripeID }, "payment not found")
 }
 
 func (m *MockPaymentRepo) ListBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*entity.Payment, error) {
-	var result []*entity.Payment
-	for _, p := range m.payments {
-		if p.SessionID == sessionID {
-			result = append(result, p)
-		}
-	}
-	return result, nil
+	return m.filter(func(p *entity.Payment) bool { return p.SessionID == sessionID }), nil
 }
 
 func (m *MockPaymentRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Payment, error) {
+	return m.filter(func(p *entity.Payment) bool { return p.UserID == userID }), nil
+}
+
+func (m *MockPaymentRepo) find(match func(*entity.Payment) bool, notFound string) (*entity.Payment, error) {
+	if result := m.filter(match); len(result) > 0 {
+		return result[0], nil
+	}
+	return nil, pkgerrors.NewNotFoundError(notFound)
+}
+
+func (m *MockPaymentRepo) filter(match func(*entity.Payment) bool) []*entity.Payment {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	var result []*entity.Payment
 	for _, p := range m.payments {
-		if p.UserID == userID {
-			result = append(result, p)
+		if match(p) {
+			copied := *p
+			result = append(result, &copied)
 		}
 	}
-	return result, nil
+	return result
 }
 
-type MockStripeClient struct{}
+type MockPaymentProvider struct{}
+
+func (m *MockPaymentProvider) Name() string {
+	return "mock"
+}
 
-func (m *MockStripeClient) CreatePaymentIntent(ctx context.Context, input port.CreatePaymentIntentInput) (*port.CreatePaymentIntentOutput, error) {
+func (m *MockPaymentProvider) CreatePaymentIntent(ctx context.Context, input port.CreatePaymentIntentInput) (*port.CreatePaymentIntentOutput, error) {
 	return &port.CreatePaymentIntentOutput{
 		PaymentIntentID: "pi_test_" + uuid.New().String(),
 		ClientSecret:    "secret_test_" + uuid.New().String(),
 	}, nil
 }
 
-func (m *MockStripeClient) CreateRefund(ctx context.Context, input port.RefundInput) (*port.RefundOutput, error) {
+func (m *MockPaymentProvider) CreateRefund(ctx context.Context, input port.RefundInput) (*port.RefundOutput, error) {
 	return &port.RefundOutput{
 		RefundID: "re_test_" + uuid.New().String(),
 	}, nil
 }
 
+type MockSessionProvider struct {
+	sessions map[uuid.UUID]*port.SessionInfo
+}
+
+func NewMockSessionProvider() *MockSessionProvider {
+	return &MockSessionProvider{sessions: make(map[uuid.UUID]*port.SessionInfo)}
+}
+
+func (m *MockSessionProvider) GetSession(ctx context.Context, sessionID uuid.UUID) (*port.SessionInfo, error) {
+	if s, ok := m.sessions[sessionID]; ok {
+		return s, nil
+	}
+	return nil, pkgerrors.NewNotFoundError("session not found")
+}
+
+// MockTransactionManager runs fn inline; there is nothing to roll back in the mocks
+type MockTransactionManager struct{}
+
+func (m *MockTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 var _ port.PaymentRepository = (*MockPaymentRepo)(nil)
-var _ port.StripeClient = (*MockStripeClient)(nil)
+var _ port.PaymentProvider = (*MockPaymentProvider)(nil)
+var _ port.SessionProvider = (*MockSessionProvider)(nil)
+var _ usecase.TransactionManager = (*MockTransactionManager)(nil)
 
 func TestCreatePayment(t *testing.T) {
 	repo := NewMockPaymentRepo()
@@ -188,3 +242,166 @@ func TestPaymentValidation(t *testing.T) {
 	}
 }
 
+func TestStartPaymentForSession_UsesSessionPrice(t *testing.T) {
+	repo := NewMockPaymentRepo()
+	sessions := NewMockSessionProvider()
+	uc := usecase.NewStartPaymentForSessionUseCase(service.NewPaymentService(repo), sessions, &MockPaymentProvider{}, &MockTransactionManager{}, nil)
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+	sessions.sessions[sessionID] = &port.SessionInfo{
+		ID:                  sessionID,
+		PricePerParticipant: 12.5,
+		Status:              "OPEN",
+		ParticipantIDs:      []uuid.UUID{userID},
+	}
+
+	ctx := context.Background()
+	output, err := uc.Execute(ctx, dto.StartPaymentForSessionInput{SessionID: sessionID, UserID: userID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if output.Amount != 12.5 {
+		t.Errorf("Expected amount 12.5, got %f", output.Amount)
+	}
+
+	if output.Currency != entity.DefaultCurrency {
+		t.Errorf("Expected currency %s, got %s", entity.DefaultCurrency, output.Currency)
+	}
+
+	if stored := repo.get(output.PaymentID); stored.Status != entity.PaymentStatusPending {
+		t.Errorf("Expected status PENDING, got %v", stored.Status)
+	}
+
+	_, err = uc.Execute(ctx, dto.StartPaymentForSessionInput{SessionID: sessionID, UserID: userID})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeAlreadyExists {
+		t.Errorf("Expected ALREADY_EXISTS for duplicate payment, got %v", err)
+	}
+}
+
+func TestStartPaymentForSession_Rejected(t *testing.T) {
+	sessionID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		session  *port.SessionInfo
+		wantCode string
+	}{
+		{
+			name:     "Session not found",
+			session:  nil,
+			wantCode: pkgerrors.CodeNotFound,
+		},
+		{
+			name:     "Not a participant",
+			session:  &port.SessionInfo{ID: sessionID, PricePerParticipant: 10, Status: "OPEN", ParticipantIDs: []uuid.UUID{uuid.New()}},
+			wantCode: pkgerrors.CodeFailedPrecondition,
+		},
+		{
+			name:     "Cancelled session",
+			session:  &port.SessionInfo{ID: sessionID, PricePerParticipant: 10, Status: "CANCELLED", ParticipantIDs: []uuid.UUID{userID}},
+			wantCode: pkgerrors.CodeFailedPrecondition,
+		},
+		{
+			name:     "Free session",
+			session:  &port.SessionInfo{ID: sessionID, PricePerParticipant: 0, Status: "OPEN", ParticipantIDs: []uuid.UUID{userID}},
+			wantCode: pkgerrors.CodeFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := NewMockSessionProvider()
+			if tt.session != nil {
+				sessions.sessions[sessionID] = tt.session
+			}
+			uc := usecase.NewStartPaymentForSessionUseCase(service.NewPaymentService(NewMockPaymentRepo()), sessions, &MockPaymentProvider{}, &MockTransactionManager{}, nil)
+
+			_, err := uc.Execute(context.Background(), dto.StartPaymentForSessionInput{SessionID: sessionID, UserID: userID})
+			if code := pkgerrors.GetErrorCode(err); code != tt.wantCode {
+				t.Errorf("Expected %s, got %s (%v)", tt.wantCode, code, err)
+			}
+		})
+	}
+}
+
+func TestRefundPayment(t *testing.T) {
+	repo := NewMockPaymentRepo()
+	svc := service.NewPaymentService(repo)
+	uc := usecase.NewRefundPaymentUseCase(svc, &MockPaymentProvider{}, &MockTransactionManager{}, nil)
+
+	payment := &entity.Payment{
+		ID:                    uuid.New(),
+		SessionID:             uuid.New(),
+		UserID:                uuid.New(),
+		Amount:                20.0,
+		Currency:              "USD",
+		StripePaymentIntentID: "pi_test_123",
+		Status:                entity.PaymentStatusPending,
+	}
+	repo.put(payment)
+
+	ctx := context.Background()
+	_, err := uc.Execute(ctx, dto.RefundPaymentInput{PaymentID: payment.ID})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeFailedPrecondition {
+		t.Errorf("Expected FAILED_PRECONDITION for pending payment, got %v", err)
+	}
+
+	payment.Status = entity.PaymentStatusSucceeded
+	repo.put(payment)
+	output, err := uc.Execute(ctx, dto.RefundPaymentInput{PaymentID: payment.ID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !output.Success || output.RefundID == "" {
+		t.Errorf("Expected successful refund with ID, got %+v", output)
+	}
+
+	if stored := repo.get(payment.ID); stored.Status != entity.PaymentStatusRefunded {
+		t.Errorf("Expected status REFUNDED, got %v", stored.Status)
+	}
+
+	_, err = uc.Execute(ctx, dto.RefundPaymentInput{PaymentID: uuid.New()})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+		t.Errorf("Expected NOT_FOUND for unknown payment, got %v", err)
+	}
+}
+
+func TestListPayments(t *testing.T) {
+	repo := NewMockPaymentRepo()
+	svc := service.NewPaymentService(repo)
+
+	ctx := context.Background()
+	sessionID := uuid.New()
+	userID := uuid.New()
+	if _, err := svc.CreatePayment(ctx, sessionID, userID, 10.0, "USD"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := svc.CreatePayment(ctx, sessionID, uuid.New(), 10.0, "USD"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	bySession, err := usecase.NewListPaymentsBySessionUseCase(svc).Execute(ctx, dto.ListPaymentsBySessionInput{SessionID: sessionID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(bySession.Payments) != 2 {
+		t.Errorf("Expected 2 payments for session, got %d", len(bySession.Payments))
+	}
+
+	byUser, err := usecase.NewListPaymentsByUserUseCase(svc).Execute(ctx, dto.ListPaymentsByUserInput{UserID: userID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(byUser.Payments) != 1 {
+		t.Errorf("Expected 1 payment for user, got %d", len(byUser.Payments))
+	}
+
+	_, err = usecase.NewListPaymentsByUserUseCase(svc).Execute(ctx, dto.ListPaymentsByUserInput{UserID: uuid.Nil})
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected INVALID_ARGUMENT for nil user, got %v", err)
+	}
+}
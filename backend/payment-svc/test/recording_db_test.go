@@ -0,0 +1,117 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// RecordingConn is a database/sql connection that records every statement and answers queries
+// with canned rows, enough to check what the GORM repositories send to Postgres
+type RecordingConn struct {
+	mu      sync.Mutex
+	execs   []RecordedStatement
+	execErr error
+	columns []string
+	rows    [][]driver.Value
+}
+
+type RecordedStatement struct {
+	Query string
+	Args  []driver.Value
+}
+
+func newRecordingDB(t *testing.T) (*gorm.DB, *RecordingConn) {
+	t.Helper()
+	conn := &RecordingConn{}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(conn)}), &gorm.Config{
+		TranslateError:         true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to open recording database: %v", err)
+	}
+	return db, conn
+}
+
+// returnRows makes every following query answer with rows
+func (c *RecordingConn) returnRows(columns []string, rows ...[]driver.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.columns = columns
+	c.rows = rows
+}
+
+// lastExec returns the most recent statement whose SQL starts with prefix
+func (c *RecordingConn) lastExec(t *testing.T, prefix string) RecordedStatement {
+	t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.execs) - 1; i >= 0; i-- {
+		if strings.HasPrefix(c.execs[i].Query, prefix) {
+			return c.execs[i]
+		}
+	}
+	t.Fatalf("Expected a statement starting with %q, got %+v", prefix, c.execs)
+	return RecordedStatement{}
+}
+
+func (c *RecordingConn) Connect(ctx context.Context) (driver.Conn, error) { return c, nil }
+func (c *RecordingConn) Driver() driver.Driver                            { return nil }
+func (c *RecordingConn) Close() error                                     { return nil }
+func (c *RecordingConn) Begin() (driver.Tx, error)                        { return c, nil }
+func (c *RecordingConn) Commit() error                                    { return nil }
+func (c *RecordingConn) Rollback() error                                  { return nil }
+
+func (c *RecordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *RecordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.execs = append(c.execs, RecordedStatement{Query: query, Args: values(args)})
+	if c.execErr != nil {
+		return nil, c.execErr
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *RecordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.execs = append(c.execs, RecordedStatement{Query: query, Args: values(args)})
+	return &cannedRows{columns: c.columns, rows: c.rows}, nil
+}
+
+func values(args []driver.NamedValue) []driver.Value {
+	out := make([]driver.Value, len(args))
+	for i, arg := range args {
+		out[i] = arg.Value
+	}
+	return out
+}
+
+type cannedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *cannedRows) Columns() []string { return r.columns }
+func (r *cannedRows) Close() error      { return nil }
+
+func (r *cannedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,287 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/diploma/payment-svc/internal/adapters/inbound/http/webhook"
+	"github.com/diploma/payment-svc/internal/application/payment/usecase"
+	"github.com/diploma/payment-svc/internal/domain/payment/entity"
+	"github.com/diploma/payment-svc/internal/domain/payment/port"
+	"github.com/diploma/payment-svc/internal/domain/payment/service"
+	"github.com/google/uuid"
+	stripewebhook "github.com/stripe/stripe-go/v76/webhook"
+)
+
+const testWebhookSecret = "whsec_test_secret"
+
+type MockProcessedEventRepo struct {
+	events map[string]string
+}
+
+func NewMockProcessedEventRepo() *MockProcessedEventRepo {
+	return &MockProcessedEventRepo{events: make(map[string]string)}
+}
+
+func (m *MockProcessedEventRepo) IsProcessed(ctx context.Context, eventID string) (bool, error) {
+	_, ok := m.events[eventID]
+	return ok, nil
+}
+
+func (m *MockProcessedEventRepo) MarkProcessed(ctx context.Context, eventID, eventType string) error {
+	m.events[eventID] = eventType
+	return nil
+}
+
+type RecordingPublisher struct {
+	succeeded int
+	failed    int
+	refunded  int
+	currency  string
+	err       error
+}
+
+func (p *RecordingPublisher) PublishPaymentCreated(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64, currency string) error {
+	return nil
+}
+
+func (p *RecordingPublisher) PublishPaymentSucceeded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, amount float64, currency string) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.succeeded++
+	p.currency = currency
+	return nil
+}
+
+func (p *RecordingPublisher) PublishPaymentFailed(ctx context.Context, paymentID, sessionID, userID uuid.UUID, reason string) error {
+	p.failed++
+	return nil
+}
+
+func (p *RecordingPublisher) PublishPaymentRefunded(ctx context.Context, paymentID, sessionID, userID uuid.UUID, refundID string) error {
+	p.refunded++
+	return nil
+}
+
+var _ port.ProcessedEventRepository = (*MockProcessedEventRepo)(nil)
+var _ usecase.EventPublisher = (*RecordingPublisher)(nil)
+
+// fakeStripe builds webhook deliveries signed the same way Stripe signs them
+type fakeStripe struct {
+	secret string
+}
+
+func (f *fakeStripe) delivery(t *testing.T, eventID, eventType string, object map[string]interface{}) *http.Request {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"id":          eventID,
+		"object":      "event",
+		"type":        eventType,
+		"api_version": "2020-08-27",
+		"created":     time.Now().Unix(),
+		"data":        map[string]interface{}{"object": object},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal event: %v", err)
+	}
+
+	signed := stripewebhook.GenerateTestSignedPayload(&stripewebhook.UnsignedPayload{
+		Payload: payload,
+		Secret:  f.secret,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", bytes.NewReader(payload))
+	req.Header.Set("Stripe-Signature", signed.Header)
+	return req
+}
+
+func newWebhookFixture(status entity.PaymentStatus) (*MockPaymentRepo, *MockProcessedEventRepo, *RecordingPublisher, *entity.Payment, http.Handler) {
+	repo := NewMockPaymentRepo()
+	events := NewMockProcessedEventRepo()
+	publisher := &RecordingPublisher{}
+
+	payment := &entity.Payment{
+		ID:                    uuid.New(),
+		SessionID:             uuid.New(),
+		UserID:                uuid.New(),
+		Amount:                25.0,
+		Currency:              "USD",
+		StripePaymentIntentID: "pi_" + uuid.New().String(),
+		Status:                status,
+	}
+	repo.put(payment)
+
+	uc := usecase.NewHandleStripeWebhookUseCase(service.NewPaymentService(repo), events, &MockTransactionManager{}, publisher)
+	return repo, events, publisher, payment, webhook.NewStripeHandler(uc, testWebhookSecret)
+}
+
+func TestStripeWebhook_PaymentSucceeded(t *testing.T) {
+	repo, events, publisher, payment, handler := newWebhookFixture(entity.PaymentStatusPending)
+	stripe := &fakeStripe{secret: testWebhookSecret}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, stripe.delivery(t, "evt_1", "payment_intent.succeeded", map[string]interface{}{
+		"id":     payment.StripePaymentIntentID,
+		"object": "payment_intent",
+	}))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if stored := repo.get(payment.ID); stored.Status != entity.PaymentStatusSucceeded {
+		t.Errorf("Expected status SUCCEEDED, got %v", stored.Status)
+	}
+
+	if events.events["evt_1"] != "payment_intent.succeeded" {
+		t.Error("Expected event to be recorded as processed")
+	}
+
+	if publisher.succeeded != 1 || publisher.currency != "USD" {
+		t.Errorf("Expected 1 succeeded event in USD, got %d in %q", publisher.succeeded, publisher.currency)
+	}
+}
+
+func TestStripeWebhook_ReplayIsIdempotent(t *testing.T) {
+	_, _, publisher, payment, handler := newWebhookFixture(entity.PaymentStatusProcessing)
+	stripe := &fakeStripe{secret: testWebhookSecret}
+	object := map[string]interface{}{"id": payment.StripePaymentIntentID, "object": "payment_intent"}
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, stripe.delivery(t, "evt_replay", "payment_intent.succeeded", object))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Delivery %d: expected 200, got %d", i+1, rec.Code)
+		}
+	}
+
+	if publisher.succeeded != 1 {
+		t.Errorf("Expected replay to be ignored, got %d succeeded events", publisher.succeeded)
+	}
+}
+
+func TestStripeWebhook_InvalidSignature(t *testing.T) {
+	repo, events, _, payment, handler := newWebhookFixture(entity.PaymentStatusPending)
+	stripe := &fakeStripe{secret: "whsec_wrong_secret"}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, stripe.delivery(t, "evt_forged", "payment_intent.succeeded", map[string]interface{}{
+		"id":     payment.StripePaymentIntentID,
+		"object": "payment_intent",
+	}))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400, got %d", rec.Code)
+	}
+
+	if stored := repo.get(payment.ID); stored.Status != entity.PaymentStatusPending {
+		t.Errorf("Expected status to stay PENDING, got %v", stored.Status)
+	}
+
+	if len(events.events) != 0 {
+		t.Error("Expected forged event not to be recorded")
+	}
+}
+
+func TestStripeWebhook_PaymentFailed(t *testing.T) {
+	repo, _, publisher, payment, handler := newWebhookFixture(entity.PaymentStatusPending)
+	stripe := &fakeStripe{secret: testWebhookSecret}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, stripe.delivery(t, "evt_fail", "payment_intent.payment_failed", map[string]interface{}{
+		"id":                 payment.StripePaymentIntentID,
+		"object":             "payment_intent",
+		"last_payment_error": map[string]interface{}{"message": "Your card was declined."},
+	}))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+
+	if stored := repo.get(payment.ID); stored.Status != entity.PaymentStatusFailed || stored.FailureReason != "Your card was declined." {
+		t.Errorf("Expected FAILED with decline reason, got %v (%q)", stored.Status, stored.FailureReason)
+	}
+
+	if publisher.failed != 1 {
+		t.Errorf("Expected 1 failed event, got %d", publisher.failed)
+	}
+}
+
+func TestStripeWebhook_ChargeRefunded(t *testing.T) {
+	repo, _, publisher, payment, handler := newWebhookFixture(entity.PaymentStatusSucceeded)
+	stripe := &fakeStripe{secret: testWebhookSecret}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, stripe.delivery(t, "evt_refund", "charge.refunded", map[string]interface{}{
+		"id":             "ch_123",
+		"object":         "charge",
+		"payment_intent": payment.StripePaymentIntentID,
+		"refunds": map[string]interface{}{
+			"object": "list",
+			"data":   []interface{}{map[string]interface{}{"id": "re_123", "object": "refund"}},
+		},
+	}))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+
+	if stored := repo.get(payment.ID); stored.Status != entity.PaymentStatusRefunded || stored.RefundID != "re_123" {
+		t.Errorf("Expected REFUNDED with re_123, got %v (%q)", stored.Status, stored.RefundID)
+	}
+
+	if publisher.refunded != 1 {
+		t.Errorf("Expected 1 refunded event, got %d", publisher.refunded)
+	}
+}
+
+func TestStripeWebhook_OutOfOrderEventAcknowledged(t *testing.T) {
+	repo, events, _, payment, handler := newWebhookFixture(entity.PaymentStatusSucceeded)
+	stripe := &fakeStripe{secret: testWebhookSecret}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, stripe.delivery(t, "evt_late", "payment_intent.payment_failed", map[string]interface{}{
+		"id":     payment.StripePaymentIntentID,
+		"object": "payment_intent",
+	}))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+
+	if stored := repo.get(payment.ID); stored.Status != entity.PaymentStatusSucceeded {
+		t.Errorf("Expected status to stay SUCCEEDED, got %v", stored.Status)
+	}
+
+	if _, ok := events.events["evt_late"]; !ok {
+		t.Error("Expected stale event to be recorded")
+	}
+}
+
+func TestStripeWebhook_OutboxFailureIsRetried(t *testing.T) {
+	_, events, publisher, payment, handler := newWebhookFixture(entity.PaymentStatusProcessing)
+	publisher.err = errors.New("outbox unavailable")
+	stripe := &fakeStripe{secret: testWebhookSecret}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, stripe.delivery(t, "evt_retry", "payment_intent.succeeded", map[string]interface{}{
+		"id":     payment.StripePaymentIntentID,
+		"object": "payment_intent",
+	}))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected 500 so Stripe redelivers, got %d", rec.Code)
+	}
+
+	if _, ok := events.events["evt_retry"]; ok {
+		t.Error("Expected event not to be recorded as processed")
+	}
+}
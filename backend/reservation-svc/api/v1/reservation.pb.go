@@ -24,8 +24,10 @@ const (
 type CreateReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ApartmentId   string                 `protobuf:"bytes,2,opt,name=apartment_id,json=apartmentId,proto3" json:"apartment_id,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // RFC3339
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,9 +69,9 @@ func (x *CreateReservationRequest) GetUserId() string {
 	return ""
 }
 
-func (x *CreateReservationRequest) GetApartmentId() string {
+func (x *CreateReservationRequest) GetResourceId() string {
 	if x != nil {
-		return x.ApartmentId
+		return x.ResourceId
 	}
 	return ""
 }
@@ -81,6 +83,20 @@ func (x *CreateReservationRequest) GetComment() string {
 	return ""
 }
 
+func (x *CreateReservationRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type CreateReservationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
@@ -349,11 +365,13 @@ type GetReservationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ApartmentId   string                 `protobuf:"bytes,3,opt,name=apartment_id,json=apartmentId,proto3" json:"apartment_id,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	ReservedAt    string                 `protobuf:"bytes,5,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"`
 	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartTime     string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -402,9 +420,9 @@ func (x *GetReservationResponse) GetUserId() string {
 	return ""
 }
 
-func (x *GetReservationResponse) GetApartmentId() string {
+func (x *GetReservationResponse) GetResourceId() string {
 	if x != nil {
-		return x.ApartmentId
+		return x.ResourceId
 	}
 	return ""
 }
@@ -437,6 +455,20 @@ func (x *GetReservationResponse) GetComment() string {
 	return ""
 }
 
+func (x *GetReservationResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetReservationResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type ListReservationsByUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -525,15 +557,123 @@ func (x *ListReservationsByUserResponse) GetItems() []*GetReservationResponse {
 	return nil
 }
 
+type ListReservationsByResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    string                 `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // RFC3339
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsByResourceRequest) Reset() {
+	*x = ListReservationsByResourceRequest{}
+	mi := &file_api_v1_reservation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsByResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsByResourceRequest) ProtoMessage() {}
+
+func (x *ListReservationsByResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reservation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsByResourceRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsByResourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_reservation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListReservationsByResourceRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListReservationsByResourceRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListReservationsByResourceRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type ListReservationsByResourceResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*GetReservationResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsByResourceResponse) Reset() {
+	*x = ListReservationsByResourceResponse{}
+	mi := &file_api_v1_reservation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsByResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsByResourceResponse) ProtoMessage() {}
+
+func (x *ListReservationsByResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reservation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsByResourceResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsByResourceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_reservation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListReservationsByResourceResponse) GetItems() []*GetReservationResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_api_v1_reservation_proto protoreflect.FileDescriptor
 
 const file_api_v1_reservation_proto_rawDesc = "" +
 	"\n" +
-	"\x18api/v1/reservation.proto\x12\x0ereservation.v1\"p\n" +
+	"\x18api/v1/reservation.proto\x12\x0ereservation.v1\"\xa8\x01\n" +
 	"\x18CreateReservationRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\fapartment_id\x18\x02 \x01(\tR\vapartmentId\x12\x18\n" +
-	"\acomment\x18\x03 \x01(\tR\acomment\"B\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\"B\n" +
 	"\x19CreateReservationResponse\x12%\n" +
 	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"B\n" +
 	"\x19ConfirmReservationRequest\x12%\n" +
@@ -545,27 +685,39 @@ const file_api_v1_reservation_proto_rawDesc = "" +
 	"\x19CancelReservationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\">\n" +
 	"\x15GetReservationRequest\x12%\n" +
-	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"\xd6\x01\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"\x8e\x02\n" +
 	"\x16GetReservationResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
-	"\fapartment_id\x18\x03 \x01(\tR\vapartmentId\x12\x16\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
 	"\vreserved_at\x18\x05 \x01(\tR\n" +
 	"reservedAt\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x18\n" +
-	"\acomment\x18\a \x01(\tR\acomment\"8\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\b \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\t \x01(\tR\aendTime\"8\n" +
 	"\x1dListReservationsByUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
 	"\x1eListReservationsByUserResponse\x12<\n" +
-	"\x05items\x18\x01 \x03(\v2&.reservation.v1.GetReservationResponseR\x05items2\xaf\x04\n" +
+	"\x05items\x18\x01 \x03(\v2&.reservation.v1.GetReservationResponseR\x05items\"h\n" +
+	"!ListReservationsByResourceRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\tR\n" +
+	"resourceId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"b\n" +
+	"\"ListReservationsByResourceResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.reservation.v1.GetReservationResponseR\x05items2\xb5\x05\n" +
 	"\x12ReservationService\x12h\n" +
 	"\x11CreateReservation\x12(.reservation.v1.CreateReservationRequest\x1a).reservation.v1.CreateReservationResponse\x12k\n" +
 	"\x12ConfirmReservation\x12).reservation.v1.ConfirmReservationRequest\x1a*.reservation.v1.ConfirmReservationResponse\x12h\n" +
 	"\x11CancelReservation\x12(.reservation.v1.CancelReservationRequest\x1a).reservation.v1.CancelReservationResponse\x12_\n" +
 	"\x0eGetReservation\x12%.reservation.v1.GetReservationRequest\x1a&.reservation.v1.GetReservationResponse\x12w\n" +
-	"\x16ListReservationsByUser\x12-.reservation.v1.ListReservationsByUserRequest\x1a..reservation.v1.ListReservationsByUserResponseB9Z7github.com/diploma/reservation-svc/api/v1;reservationv1b\x06proto3"
+	"\x16ListReservationsByUser\x12-.reservation.v1.ListReservationsByUserRequest\x1a..reservation.v1.ListReservationsByUserResponse\x12\x83\x01\n" +
+	"\x1aListReservationsByResource\x121.reservation.v1.ListReservationsByResourceRequest\x1a2.reservation.v1.ListReservationsByResourceResponseB9Z7github.com/diploma/reservation-svc/api/v1;reservationv1b\x06proto3"
 
 var (
 	file_api_v1_reservation_proto_rawDescOnce sync.Once
@@ -579,36 +731,41 @@ func file_api_v1_reservation_proto_rawDescGZIP() []byte {
 	return file_api_v1_reservation_proto_rawDescData
 }
 
-var file_api_v1_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_api_v1_reservation_proto_goTypes = []any{
-	(*CreateReservationRequest)(nil),       // 0: reservation.v1.CreateReservationRequest
-	(*CreateReservationResponse)(nil),      // 1: reservation.v1.CreateReservationResponse
-	(*ConfirmReservationRequest)(nil),      // 2: reservation.v1.ConfirmReservationRequest
-	(*ConfirmReservationResponse)(nil),     // 3: reservation.v1.ConfirmReservationResponse
-	(*CancelReservationRequest)(nil),       // 4: reservation.v1.CancelReservationRequest
-	(*CancelReservationResponse)(nil),      // 5: reservation.v1.CancelReservationResponse
-	(*GetReservationRequest)(nil),          // 6: reservation.v1.GetReservationRequest
-	(*GetReservationResponse)(nil),         // 7: reservation.v1.GetReservationResponse
-	(*ListReservationsByUserRequest)(nil),  // 8: reservation.v1.ListReservationsByUserRequest
-	(*ListReservationsByUserResponse)(nil), // 9: reservation.v1.ListReservationsByUserResponse
+	(*CreateReservationRequest)(nil),           // 0: reservation.v1.CreateReservationRequest
+	(*CreateReservationResponse)(nil),          // 1: reservation.v1.CreateReservationResponse
+	(*ConfirmReservationRequest)(nil),          // 2: reservation.v1.ConfirmReservationRequest
+	(*ConfirmReservationResponse)(nil),         // 3: reservation.v1.ConfirmReservationResponse
+	(*CancelReservationRequest)(nil),           // 4: reservation.v1.CancelReservationRequest
+	(*CancelReservationResponse)(nil),          // 5: reservation.v1.CancelReservationResponse
+	(*GetReservationRequest)(nil),              // 6: reservation.v1.GetReservationRequest
+	(*GetReservationResponse)(nil),             // 7: reservation.v1.GetReservationResponse
+	(*ListReservationsByUserRequest)(nil),      // 8: reservation.v1.ListReservationsByUserRequest
+	(*ListReservationsByUserResponse)(nil),     // 9: reservation.v1.ListReservationsByUserResponse
+	(*ListReservationsByResourceRequest)(nil),  // 10: reservation.v1.ListReservationsByResourceRequest
+	(*ListReservationsByResourceResponse)(nil), // 11: reservation.v1.ListReservationsByResourceResponse
 }
 var file_api_v1_reservation_proto_depIdxs = []int32{
-	7, // 0: reservation.v1.ListReservationsByUserResponse.items:type_name -> reservation.v1.GetReservationResponse
-	0, // 1: reservation.v1.ReservationService.CreateReservation:input_type -> reservation.v1.CreateReservationRequest
-	2, // 2: reservation.v1.ReservationService.ConfirmReservation:input_type -> reservation.v1.ConfirmReservationRequest
-	4, // 3: reservation.v1.ReservationService.CancelReservation:input_type -> reservation.v1.CancelReservationRequest
-	6, // 4: reservation.v1.ReservationService.GetReservation:input_type -> reservation.v1.GetReservationRequest
-	8, // 5: reservation.v1.ReservationService.ListReservationsByUser:input_type -> reservation.v1.ListReservationsByUserRequest
-	1, // 6: reservation.v1.ReservationService.CreateReservation:output_type -> reservation.v1.CreateReservationResponse
-	3, // 7: reservation.v1.ReservationService.ConfirmReservation:output_type -> reservation.v1.ConfirmReservationResponse
-	5, // 8: reservation.v1.ReservationService.CancelReservation:output_type -> reservation.v1.CancelReservationResponse
-	7, // 9: reservation.v1.ReservationService.GetReservation:output_type -> reservation.v1.GetReservationResponse
-	9, // 10: reservation.v1.ReservationService.ListReservationsByUser:output_type -> reservation.v1.ListReservationsByUserResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7,  // 0: reservation.v1.ListReservationsByUserResponse.items:type_name -> reservation.v1.GetReservationResponse
+	7,  // 1: reservation.v1.ListReservationsByResourceResponse.items:type_name -> reservation.v1.GetReservationResponse
+	0,  // 2: reservation.v1.ReservationService.CreateReservation:input_type -> reservation.v1.CreateReservationRequest
+	2,  // 3: reservation.v1.ReservationService.ConfirmReservation:input_type -> reservation.v1.ConfirmReservationRequest
+	4,  // 4: reservation.v1.ReservationService.CancelReservation:input_type -> reservation.v1.CancelReservationRequest
+	6,  // 5: reservation.v1.ReservationService.GetReservation:input_type -> reservation.v1.GetReservationRequest
+	8,  // 6: reservation.v1.ReservationService.ListReservationsByUser:input_type -> reservation.v1.ListReservationsByUserRequest
+	10, // 7: reservation.v1.ReservationService.ListReservationsByResource:input_type -> reservation.v1.ListReservationsByResourceRequest
+	1,  // 8: reservation.v1.ReservationService.CreateReservation:output_type -> reservation.v1.CreateReservationResponse
+	3,  // 9: reservation.v1.ReservationService.ConfirmReservation:output_type -> reservation.v1.ConfirmReservationResponse
+	5,  // 10: reservation.v1.ReservationService.CancelReservation:output_type -> reservation.v1.CancelReservationResponse
+	7,  // 11: reservation.v1.ReservationService.GetReservation:output_type -> reservation.v1.GetReservationResponse
+	9,  // 12: reservation.v1.ReservationService.ListReservationsByUser:output_type -> reservation.v1.ListReservationsByUserResponse
+	11, // 13: reservation.v1.ReservationService.ListReservationsByResource:output_type -> reservation.v1.ListReservationsByResourceResponse
+	8,  // [8:14] is the sub-list for method output_type
+	2,  // [2:8] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_reservation_proto_init() }
@@ -622,7 +779,7 @@ func file_api_v1_reservation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_reservation_proto_rawDesc), len(file_api_v1_reservation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReservationService_CreateReservation_FullMethodName      = "/reservation.v1.ReservationService/CreateReservation"
-	ReservationService_ConfirmReservation_FullMethodName     = "/reservation.v1.ReservationService/ConfirmReservation"
-	ReservationService_CancelReservation_FullMethodName      = "/reservation.v1.ReservationService/CancelReservation"
-	ReservationService_GetReservation_FullMethodName         = "/reservation.v1.ReservationService/GetReservation"
-	ReservationService_ListReservationsByUser_FullMethodName = "/reservation.v1.ReservationService/ListReservationsByUser"
+	ReservationService_CreateReservation_FullMethodName          = "/reservation.v1.ReservationService/CreateReservation"
+	ReservationService_ConfirmReservation_FullMethodName         = "/reservation.v1.ReservationService/ConfirmReservation"
+	ReservationService_CancelReservation_FullMethodName          = "/reservation.v1.ReservationService/CancelReservation"
+	ReservationService_GetReservation_FullMethodName             = "/reservation.v1.ReservationService/GetReservation"
+	ReservationService_ListReservationsByUser_FullMethodName     = "/reservation.v1.ReservationService/ListReservationsByUser"
+	ReservationService_ListReservationsByResource_FullMethodName = "/reservation.v1.ReservationService/ListReservationsByResource"
 )
 
 // ReservationServiceClient is the client API for ReservationService service.
@@ -35,6 +36,7 @@ type ReservationServiceClient interface {
 	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
 	GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*GetReservationResponse, error)
 	ListReservationsByUser(ctx context.Context, in *ListReservationsByUserRequest, opts ...grpc.CallOption) (*ListReservationsByUserResponse, error)
+	ListReservationsByResource(ctx context.Context, in *ListReservationsByResourceRequest, opts ...grpc.CallOption) (*ListReservationsByResourceResponse, error)
 }
 
 type reservationServiceClient struct {
@@ -95,6 +97,16 @@ func (c *reservationServiceClient) ListReservationsByUser(ctx context.Context, i
 	return out, nil
 }
 
+func (c *reservationServiceClient) ListReservationsByResource(ctx context.Context, in *ListReservationsByResourceRequest, opts ...grpc.CallOption) (*ListReservationsByResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReservationsByResourceResponse)
+	err := c.cc.Invoke(ctx, ReservationService_ListReservationsByResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReservationServiceServer is the server API for ReservationService service.
 // All implementations must embed UnimplementedReservationServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type ReservationServiceServer interface {
 	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
 	GetReservation(context.Context, *GetReservationRequest) (*GetReservationResponse, error)
 	ListReservationsByUser(context.Context, *ListReservationsByUserRequest) (*ListReservationsByUserResponse, error)
+	ListReservationsByResource(context.Context, *ListReservationsByResourceRequest) (*ListReservationsByResourceResponse, error)
 	mustEmbedUnimplementedReservationServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedReservationServiceServer) GetReservation(context.Context, *Ge
 func (UnimplementedReservationServiceServer) ListReservationsByUser(context.Context, *ListReservationsByUserRequest) (*ListReservationsByUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListReservationsByUser not implemented")
 }
+func (UnimplementedReservationServiceServer) ListReservationsByResource(context.Context, *ListReservationsByResourceRequest) (*ListReservationsByResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReservationsByResource not implemented")
+}
 func (UnimplementedReservationServiceServer) mustEmbedUnimplementedReservationServiceServer() {}
 func (UnimplementedReservationServiceServer) testEmbeddedByValue()                            {}
 
@@ -240,6 +256,24 @@ func _ReservationService_ListReservationsByUser_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReservationService_ListReservationsByResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsByResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).ListReservationsByResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReservationService_ListReservationsByResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).ListReservationsByResource(ctx, req.(*ListReservationsByResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReservationService_ServiceDesc is the grpc.ServiceDesc for ReservationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var ReservationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReservationsByUser",
 			Handler:    _ReservationService_ListReservationsByUser_Handler,
 		},
+		{
+			MethodName: "ListReservationsByResource",
+			Handler:    _ReservationService_ListReservationsByResource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/reservation.proto",
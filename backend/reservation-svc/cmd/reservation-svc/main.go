@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -8,14 +9,20 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/diploma/authz"
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
 	reservationv1 "github.com/diploma/reservation-svc/api/v1"
 	"github.com/diploma/reservation-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/reservation-svc/internal/adapters/inbound/scheduler"
 	"github.com/diploma/reservation-svc/internal/adapters/outbound/database/repository"
 	"github.com/diploma/reservation-svc/internal/adapters/outbound/external/events"
+	"github.com/diploma/reservation-svc/internal/adapters/outbound/external/venue"
 	"github.com/diploma/reservation-svc/internal/application/reservation/usecase"
 	"github.com/diploma/reservation-svc/internal/config"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/jaeger"
@@ -62,16 +69,37 @@ func main() {
 	}
 	defer natsConn.Close()
 
-	reservationRepo := repository.NewReservationRepository(db)
-	reservationService := service.NewReservationService(reservationRepo)
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		log.Fatalf("Failed to create JetStream context: %v", err)
+	}
+	if err := sharedevents.EnsureStream(context.Background(), js, sharedevents.StreamReservations, cfg.NATS.StreamMaxAge); err != nil {
+		log.Fatalf("Failed to ensure event stream: %v", err)
+	}
 
-	eventPublisher := events.NewNATSPublisher(natsConn)
+	venueClient, err := venue.NewVenueClient(cfg.Venue.ServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to venue-svc: %v", err)
+	}
+	defer venueClient.Close()
 
-	createReservationUseCase := usecase.NewCreateReservationUseCase(reservationService, eventPublisher)
-	confirmReservationUseCase := usecase.NewConfirmReservationUseCase(reservationService, eventPublisher)
-	cancelReservationUseCase := usecase.NewCancelReservationUseCase(reservationService, eventPublisher)
+	reservationRepo := repository.NewReservationRepository(db)
+	outboxRepo := outbox.NewRepository(db, "reservation_outbox")
+	txManager := outbox.NewTransactionManager(db)
+	reservationService := service.NewReservationService(reservationRepo, venueClient, cfg.Expiry.HoldTTL)
+
+	eventCodec := sharedevents.NewCodec("reservation-svc").WithTraceInjector(func(ctx context.Context, carrier sharedevents.TraceCarrier) {
+		otel.GetTextMapPropagator().Inject(ctx, carrier)
+	})
+	eventPublisher := events.NewOutboxPublisher(outboxRepo, eventCodec)
+
+	createReservationUseCase := usecase.NewCreateReservationUseCase(reservationService, txManager, eventPublisher)
+	confirmReservationUseCase := usecase.NewConfirmReservationUseCase(reservationService, txManager, eventPublisher)
+	cancelReservationUseCase := usecase.NewCancelReservationUseCase(reservationService, txManager, eventPublisher)
 	getReservationUseCase := usecase.NewGetReservationUseCase(reservationService)
 	listReservationsByUserUseCase := usecase.NewListReservationsByUserUseCase(reservationService)
+	listReservationsByResourceUseCase := usecase.NewListReservationsByResourceUseCase(reservationService)
+	expireReservationsUseCase := usecase.NewExpireReservationsUseCase(reservationService, txManager, eventPublisher)
 
 	reservationHandler := handler.NewReservationGRPCHandler(
 		createReservationUseCase,
@@ -79,6 +107,15 @@ func main() {
 		cancelReservationUseCase,
 		getReservationUseCase,
 		listReservationsByUserUseCase,
+		listReservationsByResourceUseCase,
+	)
+
+	authInterceptor := authz.UnaryServerInterceptor(
+		authz.WithServiceKey(
+			authz.NewJWKSVerifier(authz.NewHTTPKeySource(cfg.JWT.JWKSURL), cfg.JWT.Issuer, cfg.JWT.KeyCacheTTL),
+			cfg.JWT.ServiceKey,
+		),
+		handler.AccessPolicy,
 	)
 
 	var serverOpts []grpc.ServerOption
@@ -87,9 +124,14 @@ func main() {
 		serverOpts = []grpc.ServerOption{
 			grpc.ChainUnaryInterceptor(
 				otelgrpc.UnaryServerInterceptor(),
+				authInterceptor,
 			),
 			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
 		}
+	} else {
+		serverOpts = []grpc.ServerOption{
+			grpc.UnaryInterceptor(authInterceptor),
+		}
 	}
 
 	grpcServer := grpc.NewServer(serverOpts...)
@@ -112,11 +154,26 @@ func main() {
 		}
 	}()
 
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	expirySweeper := scheduler.NewExpirySweeper(expireReservationsUseCase, cfg.Expiry.SweepInterval, cfg.Expiry.BatchSize)
+	go expirySweeper.Run(workerCtx)
+
+	outboxRelay := outbox.NewRelay(outboxRepo, js, outbox.RelayOptions{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		MaxBackoff:   cfg.Outbox.MaxBackoff,
+		Retention:    cfg.Outbox.Retention,
+		AckTimeout:   cfg.NATS.Timeout,
+		ClaimTimeout: cfg.Outbox.ClaimTimeout,
+	})
+	go outboxRelay.Run(workerCtx)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopWorkers()
 	grpcServer.GracefulStop()
 	log.Println("Server stopped")
 }
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"github.com/diploma/authz"
+	reservationv1 "github.com/diploma/reservation-svc/api/v1"
+)
+
+// AccessPolicy gates each RPC by permission; handlers check the caller against the reservation's user.
+// Other services read reservations with the internal service key.
+var AccessPolicy = authz.Policy{
+	reservationv1.ReservationService_GetReservation_FullMethodName:         authz.Authenticated(),
+	reservationv1.ReservationService_ListReservationsByUser_FullMethodName: authz.Authenticated(),
+	// Availability only needs the booked slots, which venue-svc and the booking UI read anonymously
+	reservationv1.ReservationService_ListReservationsByResource_FullMethodName: authz.Public(),
+
+	reservationv1.ReservationService_CreateReservation_FullMethodName: authz.Require(authz.PermReservationCreate),
+	reservationv1.ReservationService_CancelReservation_FullMethodName: authz.Require(authz.PermReservationCancel),
+	// Payments confirm reservations through events; the RPC is left to admins
+	reservationv1.ReservationService_ConfirmReservation_FullMethodName: authz.Require(authz.PermReservationConfirm),
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/diploma/authz"
 	"github.com/diploma/reservation-svc/api/v1"
 	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
 	"github.com/diploma/reservation-svc/internal/application/reservation/usecase"
@@ -15,11 +16,12 @@ import (
 
 type ReservationGRPCHandler struct {
 	reservationv1.UnimplementedReservationServiceServer
-	createReservationUseCase      *usecase.CreateReservationUseCase
-	confirmReservationUseCase      *usecase.ConfirmReservationUseCase
-	cancelReservationUseCase       *usecase.CancelReservationUseCase
-	getReservationUseCase          *usecase.GetReservationUseCase
-	listReservationsByUserUseCase  *usecase.ListReservationsByUserUseCase
+	createReservationUseCase          *usecase.CreateReservationUseCase
+	confirmReservationUseCase         *usecase.ConfirmReservationUseCase
+	cancelReservationUseCase          *usecase.CancelReservationUseCase
+	getReservationUseCase             *usecase.GetReservationUseCase
+	listReservationsByUserUseCase     *usecase.ListReservationsByUserUseCase
+	listReservationsByResourceUseCase *usecase.ListReservationsByResourceUseCase
 }
 
 func NewReservationGRPCHandler(
@@ -28,13 +30,15 @@ func NewReservationGRPCHandler(
 	cancelReservationUseCase *usecase.CancelReservationUseCase,
 	getReservationUseCase *usecase.GetReservationUseCase,
 	listReservationsByUserUseCase *usecase.ListReservationsByUserUseCase,
+	listReservationsByResourceUseCase *usecase.ListReservationsByResourceUseCase,
 ) *ReservationGRPCHandler {
 	return &ReservationGRPCHandler{
-		createReservationUseCase:     createReservationUseCase,
-		confirmReservationUseCase:     confirmReservationUseCase,
-		cancelReservationUseCase:      cancelReservationUseCase,
-		getReservationUseCase:         getReservationUseCase,
-		listReservationsByUserUseCase: listReservationsByUserUseCase,
+		createReservationUseCase:          createReservationUseCase,
+		confirmReservationUseCase:         confirmReservationUseCase,
+		cancelReservationUseCase:          cancelReservationUseCase,
+		getReservationUseCase:             getReservationUseCase,
+		listReservationsByUserUseCase:     listReservationsByUserUseCase,
+		listReservationsByResourceUseCase: listReservationsByResourceUseCase,
 	}
 }
 
@@ -42,18 +46,34 @@ func (h *ReservationGRPCHandler) CreateReservation(ctx context.Context, req *res
 	if req.UserId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
 	}
-	if req.ApartmentId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "apartment_id is required")
+	if req.ResourceId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_id is required")
+	}
+	if req.StartTime == "" || req.EndTime == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required")
 	}
 
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id format: %v", err)
 	}
+	if err := authz.AuthorizeUser(ctx, userID.String()); err != nil {
+		return nil, err
+	}
 
-	apartmentID, err := uuid.Parse(req.ApartmentId)
+	resourceID, err := uuid.Parse(req.ResourceId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid apartment_id format: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource_id format: %v", err)
+	}
+
+	startTime, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_time format: %v", err)
+	}
+
+	endTime, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_time format: %v", err)
 	}
 
 	var comment *string
@@ -62,9 +82,11 @@ func (h *ReservationGRPCHandler) CreateReservation(ctx context.Context, req *res
 	}
 
 	input := dto.CreateReservationInput{
-		UserID:      userID,
-		ApartmentID: apartmentID,
-		Comment:     comment,
+		UserID:     userID,
+		ResourceID: resourceID,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Comment:    comment,
 	}
 
 	output, err := h.createReservationUseCase.Execute(ctx, input)
@@ -111,6 +133,14 @@ func (h *ReservationGRPCHandler) CancelReservation(ctx context.Context, req *res
 		return nil, status.Errorf(codes.InvalidArgument, "invalid reservation_id format: %v", err)
 	}
 
+	reservation, err := h.getReservationUseCase.Execute(ctx, dto.GetReservationInput{ReservationID: reservationID})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+	if err := authz.AuthorizeUser(ctx, reservation.UserID.String()); err != nil {
+		return nil, err
+	}
+
 	input := dto.CancelReservationInput{
 		ReservationID: reservationID,
 	}
@@ -143,13 +173,18 @@ func (h *ReservationGRPCHandler) GetReservation(ctx context.Context, req *reserv
 	if err != nil {
 		return nil, mapErrorToGRPCStatus(err)
 	}
+	if err := authz.AuthorizeUser(ctx, output.UserID.String()); err != nil {
+		return nil, err
+	}
 
 	response := &reservationv1.GetReservationResponse{
 		Id:         output.ID.String(),
 		UserId:     output.UserID.String(),
-		ApartmentId: output.ApartmentID.String(),
+		ResourceId: output.ResourceID.String(),
 		Status:     output.Status,
 		ReservedAt: output.ReservedAt.Format(time.RFC3339),
+		StartTime:  output.StartTime.Format(time.RFC3339),
+		EndTime:    output.EndTime.Format(time.RFC3339),
 	}
 
 	if output.ExpiresAt != nil {
@@ -171,6 +206,9 @@ func (h *ReservationGRPCHandler) ListReservationsByUser(ctx context.Context, req
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id format: %v", err)
 	}
+	if err := authz.AuthorizeUser(ctx, userID.String()); err != nil {
+		return nil, err
+	}
 
 	input := dto.ListReservationsByUserInput{
 		UserID: userID,
@@ -183,29 +221,79 @@ func (h *ReservationGRPCHandler) ListReservationsByUser(ctx context.Context, req
 
 	items := make([]*reservationv1.GetReservationResponse, 0, len(output.Items))
 	for _, item := range output.Items {
-		resp := &reservationv1.GetReservationResponse{
-			Id:         item.ID.String(),
-			UserId:     item.UserID.String(),
-			ApartmentId: item.ApartmentID.String(),
-			Status:     item.Status,
-			ReservedAt: item.ReservedAt.Format(time.RFC3339),
-		}
+		items = append(items, toGetReservationResponse(item))
+	}
 
-		if item.ExpiresAt != nil {
-			resp.ExpiresAt = item.ExpiresAt.Format(time.RFC3339)
-		}
-		if item.Comment != nil {
-			resp.Comment = *item.Comment
-		}
+	return &reservationv1.ListReservationsByUserResponse{
+		Items: items,
+	}, nil
+}
 
-		items = append(items, resp)
+func (h *ReservationGRPCHandler) ListReservationsByResource(ctx context.Context, req *reservationv1.ListReservationsByResourceRequest) (*reservationv1.ListReservationsByResourceResponse, error) {
+	if req.ResourceId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_id is required")
+	}
+	if req.From == "" || req.To == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "from and to are required")
 	}
 
-	return &reservationv1.ListReservationsByUserResponse{
+	resourceID, err := uuid.Parse(req.ResourceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource_id format: %v", err)
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from format: %v", err)
+	}
+
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to format: %v", err)
+	}
+
+	input := dto.ListReservationsByResourceInput{
+		ResourceID: resourceID,
+		From:       from,
+		To:         to,
+	}
+
+	output, err := h.listReservationsByResourceUseCase.Execute(ctx, input)
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	items := make([]*reservationv1.GetReservationResponse, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, toGetReservationResponse(item))
+	}
+
+	return &reservationv1.ListReservationsByResourceResponse{
 		Items: items,
 	}, nil
 }
 
+func toGetReservationResponse(item dto.GetReservationOutput) *reservationv1.GetReservationResponse {
+	resp := &reservationv1.GetReservationResponse{
+		Id:         item.ID.String(),
+		UserId:     item.UserID.String(),
+		ResourceId: item.ResourceID.String(),
+		Status:     item.Status,
+		ReservedAt: item.ReservedAt.Format(time.RFC3339),
+		StartTime:  item.StartTime.Format(time.RFC3339),
+		EndTime:    item.EndTime.Format(time.RFC3339),
+	}
+
+	if item.ExpiresAt != nil {
+		resp.ExpiresAt = item.ExpiresAt.Format(time.RFC3339)
+	}
+	if item.Comment != nil {
+		resp.Comment = *item.Comment
+	}
+
+	return resp
+}
+
 func mapErrorToGRPCStatus(err error) error {
 	if err == nil {
 		return nil
@@ -233,4 +321,3 @@ func mapErrorToGRPCStatus(err error) error {
 
 	return status.Errorf(codes.Internal, "internal server error")
 }
-
@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/application/reservation/usecase"
+)
+
+type ExpirySweeper struct {
+	expireReservationsUseCase *usecase.ExpireReservationsUseCase
+	interval                  time.Duration
+	batchSize                 int
+}
+
+func NewExpirySweeper(
+	expireReservationsUseCase *usecase.ExpireReservationsUseCase,
+	interval time.Duration,
+	batchSize int,
+) *ExpirySweeper {
+	return &ExpirySweeper{
+		expireReservationsUseCase: expireReservationsUseCase,
+		interval:                  interval,
+		batchSize:                 batchSize,
+	}
+}
+
+func (s *ExpirySweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+func (s *ExpirySweeper) sweep(ctx context.Context) {
+	for {
+		output, err := s.expireReservationsUseCase.Execute(ctx, dto.ExpireReservationsInput{
+			BatchSize: s.batchSize,
+		})
+		if err != nil {
+			log.Printf("Failed to expire reservations: %v", err)
+			return
+		}
+		if output.ExpiredCount > 0 {
+			log.Printf("Expired %d pending reservations", output.ExpiredCount)
+		}
+		if output.ExpiredCount < s.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/port"
 	pkgerrors "github.com/diploma/reservation-svc/pkg/errors"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 )
 
+const pgExclusionViolation = "23P01"
+
 type ReservationRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -23,13 +27,17 @@ func NewReservationRepository(db *gorm.DB) port.ReservationRepository {
 }
 
 func (r *ReservationRepositoryImpl) Create(ctx context.Context, reservation *entity.Reservation) error {
-	result := r.db.WithContext(ctx).Create(reservation)
+	result := dbFromContext(ctx, r.db).Create(reservation)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
 			return pkgerrors.NewConflictError("reservation already exists or conflicts with existing reservation")
 		}
 		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
-			return pkgerrors.NewInvalidArgumentError("invalid user_id or apartment_id reference")
+			return pkgerrors.NewInvalidArgumentError("invalid user_id or resource_id reference")
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(result.Error, &pgErr) && pgErr.Code == pgExclusionViolation {
+			return pkgerrors.NewConflictError("resource is already booked for the requested time")
 		}
 		return fmt.Errorf("failed to create reservation: %w", result.Error)
 	}
@@ -39,7 +47,7 @@ func (r *ReservationRepositoryImpl) Create(ctx context.Context, reservation *ent
 
 func (r *ReservationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Reservation, error) {
 	var reservation entity.Reservation
-	result := r.db.WithContext(ctx).Where("id = ?", id).First(&reservation)
+	result := dbFromContext(ctx, r.db).Where("id = ?", id).First(&reservation)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -51,8 +59,9 @@ func (r *ReservationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (
 	return &reservation, nil
 }
 
-func (r *ReservationRepositoryImpl) Update(ctx context.Context, reservation *entity.Reservation) error {
-	result := r.db.WithContext(ctx).Model(&entity.Reservation{}).Where("id = ?", reservation.ID).Updates(map[string]interface{}{
+func (r *ReservationRepositoryImpl) Update(ctx context.Context, reservation *entity.Reservation, from entity.ReservationStatus) error {
+	db := dbFromContext(ctx, r.db)
+	result := db.Model(&entity.Reservation{}).Where("id = ? AND status = ?", reservation.ID, from).Updates(map[string]interface{}{
 		"status":     reservation.Status,
 		"expires_at": reservation.ExpiresAt,
 		"comment":    reservation.Comment,
@@ -63,7 +72,16 @@ func (r *ReservationRepositoryImpl) Update(ctx context.Context, reservation *ent
 	}
 
 	if result.RowsAffected == 0 {
-		return pkgerrors.NewNotFoundError("reservation not found")
+		var current entity.Reservation
+		if err := db.Select("status").Where("id = ?", reservation.ID).First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.NewNotFoundError("reservation not found")
+			}
+			return fmt.Errorf("failed to update reservation: %w", err)
+		}
+		return pkgerrors.NewFailedPreconditionError(
+			fmt.Sprintf("reservation changed to %s in the meantime", current.Status),
+		)
 	}
 
 	return nil
@@ -71,7 +89,7 @@ func (r *ReservationRepositoryImpl) Update(ctx context.Context, reservation *ent
 
 func (r *ReservationRepositoryImpl) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Reservation, error) {
 	var reservations []*entity.Reservation
-	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("reserved_at DESC").Find(&reservations)
+	result := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Order("reserved_at DESC").Find(&reservations)
 
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to list reservations: %w", result.Error)
@@ -79,3 +97,61 @@ func (r *ReservationRepositoryImpl) ListByUserID(ctx context.Context, userID uui
 
 	return reservations, nil
 }
+
+func (r *ReservationRepositoryImpl) ListActiveByResourceInRange(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]*entity.Reservation, error) {
+	var reservations []*entity.Reservation
+	// A lapsed hold no longer blocks the slot, even before the sweeper has marked it EXPIRED
+	result := dbFromContext(ctx, r.db).
+		Where("resource_id = ? AND status IN ? AND start_time < ? AND end_time > ?",
+			resourceID, []entity.ReservationStatus{entity.StatusPending, entity.StatusConfirmed}, to, from).
+		Where("(status <> ? OR expires_at IS NULL OR expires_at > now())", entity.StatusPending).
+		Order("start_time").
+		Find(&reservations)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list reservations for resource: %w", result.Error)
+	}
+
+	return reservations, nil
+}
+
+func (r *ReservationRepositoryImpl) ExpirePending(ctx context.Context, now time.Time, limit int) ([]*entity.Reservation, error) {
+	var reservations []*entity.Reservation
+	// SKIP LOCKED lets concurrent sweepers on other replicas claim disjoint batches
+	result := dbFromContext(ctx, r.db).Raw(`
+		UPDATE reservations SET status = ?
+		WHERE id IN (
+			SELECT id FROM reservations
+			WHERE status = ? AND expires_at IS NOT NULL AND expires_at <= ?
+			ORDER BY expires_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		entity.StatusExpired, entity.StatusPending, now, limit).
+		Scan(&reservations)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to expire pending reservations: %w", result.Error)
+	}
+
+	return reservations, nil
+}
+
+func (r *ReservationRepositoryImpl) ExpirePendingInRange(ctx context.Context, resourceID uuid.UUID, from, to, now time.Time) ([]*entity.Reservation, error) {
+	var reservations []*entity.Reservation
+	result := dbFromContext(ctx, r.db).Raw(`
+		UPDATE reservations SET status = ?
+		WHERE resource_id = ? AND status = ?
+			AND expires_at IS NOT NULL AND expires_at <= ?
+			AND start_time < ? AND end_time > ?
+		RETURNING *`,
+		entity.StatusExpired, resourceID, entity.StatusPending, now, to, from).
+		Scan(&reservations)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to expire lapsed holds: %w", result.Error)
+	}
+
+	return reservations, nil
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
+)
+
+// OutboxPublisher stores events in the outbox within the caller's transaction;
+// outbox.Relay delivers them to NATS once the transaction has committed
+type OutboxPublisher struct {
+	outbox outbox.Repository
+	codec  *sharedevents.Codec
+}
+
+func NewOutboxPublisher(outbox outbox.Repository, codec *sharedevents.Codec) *OutboxPublisher {
+	return &OutboxPublisher{
+		outbox: outbox,
+		codec:  codec,
+	}
+}
+
+func (p *OutboxPublisher) PublishReservationCreated(ctx context.Context, reservationID, userID, resourceID string, startTime, endTime time.Time) error {
+	return p.publish(ctx, sharedevents.SubjectReservationCreated, sharedevents.ReservationCreated{
+		ReservationID: reservationID,
+		UserID:        userID,
+		ResourceID:    resourceID,
+		StartTime:     startTime.UTC(),
+		EndTime:       endTime.UTC(),
+		Status:        "PENDING",
+	})
+}
+
+func (p *OutboxPublisher) PublishReservationConfirmed(ctx context.Context, reservationID, userID, resourceID string) error {
+	return p.publish(ctx, sharedevents.SubjectReservationConfirmed, sharedevents.ReservationConfirmed{
+		ReservationID: reservationID,
+		UserID:        userID,
+		ResourceID:    resourceID,
+	})
+}
+
+func (p *OutboxPublisher) PublishReservationCancelled(ctx context.Context, reservationID, userID, resourceID string) error {
+	return p.publish(ctx, sharedevents.SubjectReservationCancelled, sharedevents.ReservationCancelled{
+		ReservationID: reservationID,
+		UserID:        userID,
+		ResourceID:    resourceID,
+	})
+}
+
+func (p *OutboxPublisher) PublishReservationExpired(ctx context.Context, reservationID, userID, resourceID string) error {
+	return p.publish(ctx, sharedevents.SubjectReservationExpired, sharedevents.ReservationExpired{
+		ReservationID: reservationID,
+		UserID:        userID,
+		ResourceID:    resourceID,
+	})
+}
+
+func (p *OutboxPublisher) publish(ctx context.Context, subject string, payload interface{}) error {
+	envelope, err := p.codec.NewEnvelope(ctx, subject, payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return p.outbox.Add(ctx, outbox.NewMessage(envelope.ID, subject, data))
+}
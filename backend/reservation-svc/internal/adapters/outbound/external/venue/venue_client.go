@@ -0,0 +1,73 @@
+package venue
+
+import (
+	"context"
+
+	venuev1 "github.com/diploma/reservation-svc/api/proto/venue/v1"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/port"
+	pkgerrors "github.com/diploma/reservation-svc/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type VenueClient struct {
+	client venuev1.VenueServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewVenueClient(address string) (*VenueClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &VenueClient{
+		client: venuev1.NewVenueServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *VenueClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *VenueClient) GetResourceSchedule(ctx context.Context, resourceID uuid.UUID) ([]port.ScheduleSlot, error) {
+	resource, err := c.client.GetResource(ctx, &venuev1.GetResourceRequest{ResourceId: resourceID.String()})
+	if err != nil {
+		return nil, mapVenueError(err, "failed to get resource")
+	}
+	if !resource.IsActive {
+		return nil, pkgerrors.NewFailedPreconditionError("resource is not active")
+	}
+
+	resp, err := c.client.GetResourceSchedule(ctx, &venuev1.GetResourceScheduleRequest{ResourceId: resourceID.String()})
+	if err != nil {
+		return nil, mapVenueError(err, "failed to get resource schedule")
+	}
+
+	slots := make([]port.ScheduleSlot, len(resp.Slots))
+	for i, slot := range resp.Slots {
+		slots[i] = port.ScheduleSlot{
+			DayOfWeek: int(slot.DayOfWeek),
+			StartTime: slot.StartTime,
+			EndTime:   slot.EndTime,
+			BasePrice: slot.BasePrice,
+		}
+	}
+
+	return slots, nil
+}
+
+func mapVenueError(err error, message string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return pkgerrors.NewNotFoundError("resource not found")
+	case codes.InvalidArgument:
+		return pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+	default:
+		return pkgerrors.NewInternalError(message, err)
+	}
+}
@@ -8,9 +8,11 @@ import (
 )
 
 type CreateReservationInput struct {
-	UserID      uuid.UUID
-	ApartmentID uuid.UUID
-	Comment     *string
+	UserID     uuid.UUID
+	ResourceID uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+	Comment    *string
 }
 
 type CreateReservationOutput struct {
@@ -38,13 +40,15 @@ type GetReservationInput struct {
 }
 
 type GetReservationOutput struct {
-	ID          uuid.UUID
-	UserID      uuid.UUID
-	ApartmentID uuid.UUID
-	Status      string
-	ReservedAt  time.Time
-	ExpiresAt   *time.Time
-	Comment     *string
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	ResourceID uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+	Status     string
+	ReservedAt time.Time
+	ExpiresAt  *time.Time
+	Comment    *string
 }
 
 type ListReservationsByUserInput struct {
@@ -55,15 +59,34 @@ type ListReservationsByUserOutput struct {
 	Items []GetReservationOutput
 }
 
+type ListReservationsByResourceInput struct {
+	ResourceID uuid.UUID
+	From       time.Time
+	To         time.Time
+}
+
+type ListReservationsByResourceOutput struct {
+	Items []GetReservationOutput
+}
+
+type ExpireReservationsInput struct {
+	BatchSize int
+}
+
+type ExpireReservationsOutput struct {
+	ExpiredCount int
+}
+
 func ToGetReservationOutput(reservation *entity.Reservation) GetReservationOutput {
 	return GetReservationOutput{
-		ID:          reservation.ID,
-		UserID:      reservation.UserID,
-		ApartmentID: reservation.ApartmentID,
-		Status:      string(reservation.Status),
-		ReservedAt:  reservation.ReservedAt,
-		ExpiresAt:   reservation.ExpiresAt,
-		Comment:     reservation.Comment,
+		ID:         reservation.ID,
+		UserID:     reservation.UserID,
+		ResourceID: reservation.ResourceID,
+		StartTime:  reservation.StartTime,
+		EndTime:    reservation.EndTime,
+		Status:     string(reservation.Status),
+		ReservedAt: reservation.ReservedAt,
+		ExpiresAt:  reservation.ExpiresAt,
+		Comment:    reservation.Comment,
 	}
 }
-
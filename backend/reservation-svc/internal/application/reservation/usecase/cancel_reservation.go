@@ -5,34 +5,48 @@ import (
 	"fmt"
 
 	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
 )
 
 type CancelReservationUseCase struct {
 	reservationService *service.ReservationService
+	txManager          TransactionManager
 	eventPublisher     EventPublisher
 }
 
 func NewCancelReservationUseCase(
 	reservationService *service.ReservationService,
+	txManager TransactionManager,
 	eventPublisher EventPublisher,
 ) *CancelReservationUseCase {
 	return &CancelReservationUseCase{
 		reservationService: reservationService,
+		txManager:          txManager,
 		eventPublisher:     eventPublisher,
 	}
 }
 
 func (uc *CancelReservationUseCase) Execute(ctx context.Context, input dto.CancelReservationInput) (*dto.CancelReservationOutput, error) {
-	reservation, err := uc.reservationService.CancelReservation(ctx, input.ReservationID)
+	var reservation *entity.Reservation
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reservation, err = uc.reservationService.CancelReservation(ctx, input.ReservationID)
+		if err != nil {
+			return err
+		}
+
+		return uc.eventPublisher.PublishReservationCancelled(
+			ctx,
+			reservation.ID.String(),
+			reservation.UserID.String(),
+			reservation.ResourceID.String(),
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to cancel reservation: %w", err)
 	}
 
-	if err := uc.eventPublisher.PublishReservationCancelled(ctx, reservation.ID.String()); err != nil {
-		fmt.Printf("Warning: Failed to publish RESERVATION.CANCELLED event: %v\n", err)
-	}
-
 	return &dto.CancelReservationOutput{
 		Success: true,
 	}, nil
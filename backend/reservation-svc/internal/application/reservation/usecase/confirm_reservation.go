@@ -5,34 +5,48 @@ import (
 	"fmt"
 
 	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
 )
 
 type ConfirmReservationUseCase struct {
 	reservationService *service.ReservationService
+	txManager          TransactionManager
 	eventPublisher     EventPublisher
 }
 
 func NewConfirmReservationUseCase(
 	reservationService *service.ReservationService,
+	txManager TransactionManager,
 	eventPublisher EventPublisher,
 ) *ConfirmReservationUseCase {
 	return &ConfirmReservationUseCase{
 		reservationService: reservationService,
+		txManager:          txManager,
 		eventPublisher:     eventPublisher,
 	}
 }
 
 func (uc *ConfirmReservationUseCase) Execute(ctx context.Context, input dto.ConfirmReservationInput) (*dto.ConfirmReservationOutput, error) {
-	reservation, err := uc.reservationService.ConfirmReservation(ctx, input.ReservationID)
+	var reservation *entity.Reservation
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reservation, err = uc.reservationService.ConfirmReservation(ctx, input.ReservationID)
+		if err != nil {
+			return err
+		}
+
+		return uc.eventPublisher.PublishReservationConfirmed(
+			ctx,
+			reservation.ID.String(),
+			reservation.UserID.String(),
+			reservation.ResourceID.String(),
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to confirm reservation: %w", err)
 	}
 
-	if err := uc.eventPublisher.PublishReservationConfirmed(ctx, reservation.ID.String()); err != nil {
-		fmt.Printf("Warning: Failed to publish RESERVATION.CONFIRMED event: %v\n", err)
-	}
-
 	return &dto.ConfirmReservationOutput{
 		Success: true,
 	}, nil
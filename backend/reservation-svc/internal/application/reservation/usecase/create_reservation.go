@@ -5,36 +5,65 @@ import (
 	"fmt"
 
 	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
 )
 
 type CreateReservationUseCase struct {
 	reservationService *service.ReservationService
+	txManager          TransactionManager
 	eventPublisher     EventPublisher
 }
 
 func NewCreateReservationUseCase(
 	reservationService *service.ReservationService,
+	txManager TransactionManager,
 	eventPublisher EventPublisher,
 ) *CreateReservationUseCase {
 	return &CreateReservationUseCase{
 		reservationService: reservationService,
+		txManager:          txManager,
 		eventPublisher:     eventPublisher,
 	}
 }
 
 func (uc *CreateReservationUseCase) Execute(ctx context.Context, input dto.CreateReservationInput) (*dto.CreateReservationOutput, error) {
-	reservation, err := uc.reservationService.CreateReservation(ctx, input.UserID, input.ApartmentID, input.Comment)
+	var reservation *entity.Reservation
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		lapsed, err := uc.reservationService.ReleaseLapsedHolds(ctx, input.ResourceID, input.StartTime, input.EndTime)
+		if err != nil {
+			return err
+		}
+		for _, expired := range lapsed {
+			if err := uc.eventPublisher.PublishReservationExpired(
+				ctx,
+				expired.ID.String(),
+				expired.UserID.String(),
+				expired.ResourceID.String(),
+			); err != nil {
+				return err
+			}
+		}
+
+		reservation, err = uc.reservationService.CreateReservation(ctx, input.UserID, input.ResourceID, input.StartTime, input.EndTime, input.Comment)
+		if err != nil {
+			return err
+		}
+
+		return uc.eventPublisher.PublishReservationCreated(
+			ctx,
+			reservation.ID.String(),
+			reservation.UserID.String(),
+			reservation.ResourceID.String(),
+			reservation.StartTime,
+			reservation.EndTime,
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create reservation: %w", err)
 	}
 
-	if err := uc.eventPublisher.PublishReservationCreated(ctx, reservation.ID.String(), reservation.UserID.String(), reservation.ApartmentID.String()); err != nil {
-		fmt.Printf("Warning: Failed to publish RESERVATION.CREATED event: %v\n", err)
-	}
-
 	return &dto.CreateReservationOutput{
 		ReservationID: reservation.ID,
 	}, nil
 }
-
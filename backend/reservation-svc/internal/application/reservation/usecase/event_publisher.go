@@ -1,10 +1,13 @@
 package usecase
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type EventPublisher interface {
-	PublishReservationCreated(ctx context.Context, reservationID, userID, apartmentID string) error
-	PublishReservationConfirmed(ctx context.Context, reservationID string) error
-	PublishReservationCancelled(ctx context.Context, reservationID string) error
+	PublishReservationCreated(ctx context.Context, reservationID, userID, resourceID string, startTime, endTime time.Time) error
+	PublishReservationConfirmed(ctx context.Context, reservationID, userID, resourceID string) error
+	PublishReservationCancelled(ctx context.Context, reservationID, userID, resourceID string) error
+	PublishReservationExpired(ctx context.Context, reservationID, userID, resourceID string) error
 }
-
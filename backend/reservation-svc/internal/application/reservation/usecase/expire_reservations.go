@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
+)
+
+type ExpireReservationsUseCase struct {
+	reservationService *service.ReservationService
+	txManager          TransactionManager
+	eventPublisher     EventPublisher
+}
+
+func NewExpireReservationsUseCase(
+	reservationService *service.ReservationService,
+	txManager TransactionManager,
+	eventPublisher EventPublisher,
+) *ExpireReservationsUseCase {
+	return &ExpireReservationsUseCase{
+		reservationService: reservationService,
+		txManager:          txManager,
+		eventPublisher:     eventPublisher,
+	}
+}
+
+func (uc *ExpireReservationsUseCase) Execute(ctx context.Context, input dto.ExpireReservationsInput) (*dto.ExpireReservationsOutput, error) {
+	var expired []*entity.Reservation
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		expired, err = uc.reservationService.ExpireStaleReservations(ctx, input.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, reservation := range expired {
+			if err := uc.eventPublisher.PublishReservationExpired(
+				ctx,
+				reservation.ID.String(),
+				reservation.UserID.String(),
+				reservation.ResourceID.String(),
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire reservations: %w", err)
+	}
+
+	return &dto.ExpireReservationsOutput{
+		ExpiredCount: len(expired),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
+)
+
+type ListReservationsByResourceUseCase struct {
+	reservationService *service.ReservationService
+}
+
+func NewListReservationsByResourceUseCase(reservationService *service.ReservationService) *ListReservationsByResourceUseCase {
+	return &ListReservationsByResourceUseCase{
+		reservationService: reservationService,
+	}
+}
+
+func (uc *ListReservationsByResourceUseCase) Execute(ctx context.Context, input dto.ListReservationsByResourceInput) (*dto.ListReservationsByResourceOutput, error) {
+	reservations, err := uc.reservationService.ListActiveReservationsByResource(ctx, input.ResourceID, input.From, input.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+
+	items := make([]dto.GetReservationOutput, 0, len(reservations))
+	for _, reservation := range reservations {
+		items = append(items, dto.ToGetReservationOutput(reservation))
+	}
+
+	return &dto.ListReservationsByResourceOutput{
+		Items: items,
+	}, nil
+}
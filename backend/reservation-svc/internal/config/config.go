@@ -13,6 +13,10 @@ type Config struct {
 	NATS     NATSConfig
 	Jaeger   JaegerConfig
 	Server   ServerConfig
+	Venue    VenueConfig
+	Expiry   ExpiryConfig
+	Outbox   OutboxConfig
+	JWT      JWTConfig
 }
 
 type DatabaseConfig struct {
@@ -23,8 +27,9 @@ type DatabaseConfig struct {
 }
 
 type NATSConfig struct {
-	URL     string
-	Timeout time.Duration
+	URL          string
+	Timeout      time.Duration
+	StreamMaxAge time.Duration
 }
 
 type JaegerConfig struct {
@@ -36,6 +41,33 @@ type ServerConfig struct {
 	GRPCPort string
 }
 
+type VenueConfig struct {
+	ServiceURL string
+}
+
+type ExpiryConfig struct {
+	HoldTTL       time.Duration
+	SweepInterval time.Duration
+	BatchSize     int
+}
+
+// JWTConfig locates the public keys auth-svc signs access tokens with
+type JWTConfig struct {
+	JWKSURL     string
+	Issuer      string
+	KeyCacheTTL time.Duration
+	// ServiceKey is the shared internal key other services read reservations with
+	ServiceKey string
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxBackoff   time.Duration
+	Retention    time.Duration
+	ClaimTimeout time.Duration
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
@@ -47,8 +79,9 @@ func Load() (*Config, error) {
 			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", 1*time.Hour),
 		},
 		NATS: NATSConfig{
-			URL:     getEnv("NATS_URL", "nats://localhost:4222"),
-			Timeout: getEnvAsDuration("NATS_TIMEOUT", 5*time.Second),
+			URL:          getEnv("NATS_URL", "nats://localhost:4222"),
+			Timeout:      getEnvAsDuration("NATS_TIMEOUT", 5*time.Second),
+			StreamMaxAge: getEnvAsDuration("EVENT_STREAM_MAX_AGE", 7*24*time.Hour),
 		},
 		Jaeger: JaegerConfig{
 			URL:     getEnv("JAEGER_URL", "http://localhost:14268/api/traces"),
@@ -57,6 +90,27 @@ func Load() (*Config, error) {
 		Server: ServerConfig{
 			GRPCPort: getEnv("GRPC_PORT", "9092"),
 		},
+		Venue: VenueConfig{
+			ServiceURL: getEnv("VENUE_SERVICE_URL", "localhost:50053"),
+		},
+		Expiry: ExpiryConfig{
+			HoldTTL:       getEnvAsDuration("RESERVATION_HOLD_TTL", 15*time.Minute),
+			SweepInterval: getEnvAsDuration("EXPIRY_SWEEP_INTERVAL", 30*time.Second),
+			BatchSize:     getEnvAsInt("EXPIRY_BATCH_SIZE", 100),
+		},
+		Outbox: OutboxConfig{
+			PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", 1*time.Second),
+			BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+			MaxBackoff:   getEnvAsDuration("OUTBOX_MAX_BACKOFF", 1*time.Minute),
+			Retention:    getEnvAsDuration("OUTBOX_RETENTION", 24*time.Hour),
+			ClaimTimeout: getEnvAsDuration("OUTBOX_CLAIM_TIMEOUT", 5*time.Minute),
+		},
+		JWT: JWTConfig{
+			JWKSURL:     getEnv("JWKS_URL", "http://localhost:8080/.well-known/jwks.json"),
+			Issuer:      getEnv("JWT_ISSUER", "auth-svc"),
+			KeyCacheTTL: getEnvAsDuration("JWT_KEY_CACHE_TTL", 10*time.Minute),
+			ServiceKey:  getEnv("INTERNAL_SERVICE_KEY", ""),
+		},
 	}
 
 	return cfg, nil
@@ -92,4 +146,3 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
-
@@ -14,20 +14,31 @@ const (
 	StatusPending   ReservationStatus = "PENDING"
 	StatusConfirmed ReservationStatus = "CONFIRMED"
 	StatusCancelled ReservationStatus = "CANCELLED"
+	StatusExpired   ReservationStatus = "EXPIRED"
 )
 
 type Reservation struct {
-	ID          uuid.UUID
-	UserID      uuid.UUID
-	ApartmentID uuid.UUID
-	Status      ReservationStatus
-	ReservedAt  time.Time
-	ExpiresAt   *time.Time
-	Comment     *string
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	ResourceID uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+	Status     ReservationStatus
+	ReservedAt time.Time
+	ExpiresAt  *time.Time
+	Comment    *string
 }
 
 func (r *Reservation) IsValid() bool {
-	return r.UserID != uuid.Nil && r.ApartmentID != uuid.Nil
+	return r.UserID != uuid.Nil && r.ResourceID != uuid.Nil && r.EndTime.After(r.StartTime)
+}
+
+func (r *Reservation) Overlaps(start, end time.Time) bool {
+	return r.StartTime.Before(end) && start.Before(r.EndTime)
+}
+
+func (r *Reservation) HoldsSlot() bool {
+	return r.Status == StatusPending || r.Status == StatusConfirmed
 }
 
 func (r *Reservation) CanConfirm() bool {
@@ -44,7 +55,11 @@ func (r *Reservation) Confirm() error {
 			fmt.Sprintf("cannot confirm reservation with status %s", r.Status),
 		)
 	}
+	if r.IsExpired() {
+		return pkgerrors.NewFailedPreconditionError("reservation hold has expired")
+	}
 	r.Status = StatusConfirmed
+	r.ExpiresAt = nil
 	return nil
 }
 
@@ -58,10 +73,19 @@ func (r *Reservation) Cancel() error {
 	return nil
 }
 
+func (r *Reservation) Expire() error {
+	if r.Status != StatusPending {
+		return pkgerrors.NewFailedPreconditionError(
+			fmt.Sprintf("cannot expire reservation with status %s", r.Status),
+		)
+	}
+	r.Status = StatusExpired
+	return nil
+}
+
 func (r *Reservation) IsExpired() bool {
 	if r.ExpiresAt == nil {
 		return false
 	}
 	return time.Now().After(*r.ExpiresAt)
 }
-
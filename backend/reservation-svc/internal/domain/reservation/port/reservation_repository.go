@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
 	"github.com/google/uuid"
@@ -10,7 +11,12 @@ import (
 type ReservationRepository interface {
 	Create(ctx context.Context, reservation *entity.Reservation) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Reservation, error)
-	Update(ctx context.Context, reservation *entity.Reservation) error
+	// Update saves reservation only if it is still in status from, so a transition made concurrently,
+	// e.g. by the expiry sweeper, is never overwritten; otherwise it fails with FailedPrecondition
+	Update(ctx context.Context, reservation *entity.Reservation, from entity.ReservationStatus) error
 	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Reservation, error)
+	ListActiveByResourceInRange(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]*entity.Reservation, error)
+	ExpirePending(ctx context.Context, now time.Time, limit int) ([]*entity.Reservation, error)
+	// ExpirePendingInRange expires the resource's lapsed holds that overlap [from, to)
+	ExpirePendingInRange(ctx context.Context, resourceID uuid.UUID, from, to, now time.Time) ([]*entity.Reservation, error)
 }
-
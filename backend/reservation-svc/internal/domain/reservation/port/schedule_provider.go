@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ScheduleSlot struct {
+	DayOfWeek int    // 0=Sunday, 6=Saturday
+	StartTime string // HH:MM format
+	EndTime   string // HH:MM format
+	BasePrice float64
+}
+
+type ScheduleProvider interface {
+	GetResourceSchedule(ctx context.Context, resourceID uuid.UUID) ([]ScheduleSlot, error)
+}
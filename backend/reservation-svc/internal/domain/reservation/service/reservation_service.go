@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/diploma/clock"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/port"
 	pkgerrors "github.com/diploma/reservation-svc/pkg/errors"
@@ -11,35 +14,65 @@ import (
 )
 
 type ReservationService struct {
-	repo port.ReservationRepository
+	repo      port.ReservationRepository
+	schedules port.ScheduleProvider
+	holdTTL   time.Duration
 }
 
-func NewReservationService(repo port.ReservationRepository) *ReservationService {
+func NewReservationService(repo port.ReservationRepository, schedules port.ScheduleProvider, holdTTL time.Duration) *ReservationService {
 	return &ReservationService{
-		repo: repo,
+		repo:      repo,
+		schedules: schedules,
+		holdTTL:   holdTTL,
 	}
 }
 
-func (s *ReservationService) CreateReservation(ctx context.Context, userID, apartmentID uuid.UUID, comment *string) (*entity.Reservation, error) {
+func (s *ReservationService) CreateReservation(ctx context.Context, userID, resourceID uuid.UUID, startTime, endTime time.Time, comment *string) (*entity.Reservation, error) {
 	if userID == uuid.Nil {
 		return nil, pkgerrors.NewInvalidArgumentError("user_id is required")
 	}
-	if apartmentID == uuid.Nil {
-		return nil, pkgerrors.NewInvalidArgumentError("apartment_id is required")
+	if resourceID == uuid.Nil {
+		return nil, pkgerrors.NewInvalidArgumentError("resource_id is required")
+	}
+	if startTime.IsZero() || endTime.IsZero() {
+		return nil, pkgerrors.NewInvalidArgumentError("start_time and end_time are required")
+	}
+	if !endTime.After(startTime) {
+		return nil, pkgerrors.NewInvalidArgumentError("end_time must be after start_time")
 	}
 
+	expiresAt := time.Now().UTC().Add(s.holdTTL)
 	reservation := &entity.Reservation{
-		ID:          uuid.New(),
-		UserID:      userID,
-		ApartmentID: apartmentID,
-		Status:      entity.StatusPending,
-		Comment:     comment,
+		ID:         uuid.New(),
+		UserID:     userID,
+		ResourceID: resourceID,
+		StartTime:  startTime.UTC(),
+		EndTime:    endTime.UTC(),
+		Status:     entity.StatusPending,
+		ExpiresAt:  &expiresAt,
+		Comment:    comment,
 	}
 
 	if !reservation.IsValid() {
 		return nil, pkgerrors.NewInvalidArgumentError("invalid reservation data")
 	}
 
+	slots, err := s.schedules.GetResourceSchedule(ctx, resourceID)
+	if err != nil {
+		return nil, err // Schedule provider already returns typed error
+	}
+	if !fitsSchedule(slots, reservation.StartTime, reservation.EndTime) {
+		return nil, pkgerrors.NewFailedPreconditionError("requested time is outside the resource schedule")
+	}
+
+	available, err := s.IsAvailable(ctx, resourceID, reservation.StartTime, reservation.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, pkgerrors.NewConflictError("resource is already booked for the requested time")
+	}
+
 	if err := s.repo.Create(ctx, reservation); err != nil {
 		return nil, fmt.Errorf("failed to create reservation: %w", err)
 	}
@@ -53,11 +86,12 @@ func (s *ReservationService) ConfirmReservation(ctx context.Context, id uuid.UUI
 		return nil, err // Repository already returns typed error
 	}
 
+	from := reservation.Status
 	if err := reservation.Confirm(); err != nil {
 		return nil, err // Entity already returns typed error
 	}
 
-	if err := s.repo.Update(ctx, reservation); err != nil {
+	if err := s.repo.Update(ctx, reservation, from); err != nil {
 		return nil, fmt.Errorf("failed to update reservation: %w", err)
 	}
 
@@ -70,17 +104,42 @@ func (s *ReservationService) CancelReservation(ctx context.Context, id uuid.UUID
 		return nil, err // Repository already returns typed error
 	}
 
+	from := reservation.Status
 	if err := reservation.Cancel(); err != nil {
 		return nil, err // Entity already returns typed error
 	}
 
-	if err := s.repo.Update(ctx, reservation); err != nil {
+	if err := s.repo.Update(ctx, reservation, from); err != nil {
 		return nil, fmt.Errorf("failed to update reservation: %w", err)
 	}
 
 	return reservation, nil
 }
 
+func (s *ReservationService) ExpireStaleReservations(ctx context.Context, batchSize int) ([]*entity.Reservation, error) {
+	if batchSize <= 0 {
+		return nil, pkgerrors.NewInvalidArgumentError("batch size must be positive")
+	}
+
+	expired, err := s.repo.ExpirePending(ctx, time.Now().UTC(), batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire reservations: %w", err)
+	}
+
+	return expired, nil
+}
+
+// ReleaseLapsedHolds expires lapsed holds in the requested window so a new booking does not
+// trip the overlap constraint while it waits for the sweeper
+func (s *ReservationService) ReleaseLapsedHolds(ctx context.Context, resourceID uuid.UUID, startTime, endTime time.Time) ([]*entity.Reservation, error) {
+	expired, err := s.repo.ExpirePendingInRange(ctx, resourceID, startTime.UTC(), endTime.UTC(), time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to release lapsed holds: %w", err)
+	}
+
+	return expired, nil
+}
+
 func (s *ReservationService) GetReservation(ctx context.Context, id uuid.UUID) (*entity.Reservation, error) {
 	if id == uuid.Nil {
 		return nil, pkgerrors.NewInvalidArgumentError("reservation_id is required")
@@ -88,6 +147,31 @@ func (s *ReservationService) GetReservation(ctx context.Context, id uuid.UUID) (
 	return s.repo.GetByID(ctx, id)
 }
 
+func (s *ReservationService) ListActiveReservationsByResource(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]*entity.Reservation, error) {
+	if resourceID == uuid.Nil {
+		return nil, pkgerrors.NewInvalidArgumentError("resource_id is required")
+	}
+	if !to.After(from) {
+		return nil, pkgerrors.NewInvalidArgumentError("to must be after from")
+	}
+	return s.repo.ListActiveByResourceInRange(ctx, resourceID, from, to)
+}
+
+func (s *ReservationService) IsAvailable(ctx context.Context, resourceID uuid.UUID, startTime, endTime time.Time) (bool, error) {
+	reservations, err := s.repo.ListActiveByResourceInRange(ctx, resourceID, startTime, endTime)
+	if err != nil {
+		return false, err
+	}
+
+	for _, reservation := range reservations {
+		if reservation.HoldsSlot() && reservation.Overlaps(startTime, endTime) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (s *ReservationService) ListReservationsByUser(ctx context.Context, userID uuid.UUID) ([]*entity.Reservation, error) {
 	if userID == uuid.Nil {
 		return nil, pkgerrors.NewInvalidArgumentError("user_id is required")
@@ -95,3 +179,44 @@ func (s *ReservationService) ListReservationsByUser(ctx context.Context, userID
 	return s.repo.ListByUserID(ctx, userID)
 }
 
+// Schedule slots are weekly HH:MM templates interpreted in UTC. Adjacent slots
+// on the same day are merged so a booking may span them.
+func fitsSchedule(slots []port.ScheduleSlot, startTime, endTime time.Time) bool {
+	dayStart := time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, time.UTC)
+	startMinute := int(startTime.Sub(dayStart) / time.Minute)
+	endMinute := int(endTime.Sub(dayStart) / time.Minute)
+	if endMinute > 24*60 {
+		return false
+	}
+
+	type window struct{ start, end int }
+	var windows []window
+	for _, slot := range slots {
+		if slot.DayOfWeek != int(startTime.Weekday()) {
+			continue
+		}
+		start, err := clock.Parse(slot.StartTime)
+		if err != nil {
+			continue
+		}
+		end, err := clock.Parse(slot.EndTime)
+		if err != nil || end <= start {
+			continue
+		}
+		windows = append(windows, window{start: start, end: end})
+	}
+
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start < windows[j].start })
+
+	covered := startMinute
+	for _, w := range windows {
+		if w.start <= covered && w.end > covered {
+			covered = w.end
+		}
+		if covered >= endMinute {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+-- Time-slotted reservations of venue-svc resources
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE reservations RENAME COLUMN apartment_id TO resource_id;
+ALTER INDEX idx_reservations_apartment_id RENAME TO idx_reservations_resource_id;
+
+ALTER TABLE reservations
+    ADD COLUMN start_time TIMESTAMPTZ,
+    ADD COLUMN end_time TIMESTAMPTZ;
+
+-- Legacy rows only carried reserved_at; treat them as one-hour bookings
+UPDATE reservations
+SET start_time = reserved_at,
+    end_time = reserved_at + INTERVAL '1 hour'
+WHERE start_time IS NULL;
+
+ALTER TABLE reservations
+    ALTER COLUMN start_time SET NOT NULL,
+    ALTER COLUMN end_time SET NOT NULL,
+    ADD CONSTRAINT reservations_time_range_check CHECK (end_time > start_time);
+
+-- A resource can never hold two active bookings for intersecting windows
+ALTER TABLE reservations
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        resource_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (status IN ('PENDING', 'CONFIRMED'));
+
+CREATE INDEX idx_reservations_resource_time ON reservations(resource_id, start_time, end_time);
+
+COMMENT ON COLUMN reservations.resource_id IS 'Reference to resource from venue-svc';
+COMMENT ON COLUMN reservations.start_time IS 'Start of the booked window (inclusive)';
+COMMENT ON COLUMN reservations.end_time IS 'End of the booked window (exclusive)';
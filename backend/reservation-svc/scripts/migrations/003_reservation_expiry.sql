@@ -0,0 +1,15 @@
+-- Expiry of unconfirmed holds
+
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+-- Backfill holds created before expires_at was populated
+UPDATE reservations
+SET expires_at = reserved_at + INTERVAL '15 minutes'
+WHERE status = 'PENDING' AND expires_at IS NULL;
+
+-- Supports the sweeper's "oldest expired holds first" scan
+CREATE INDEX IF NOT EXISTS idx_reservations_pending_expires_at
+    ON reservations(expires_at)
+    WHERE status = 'PENDING';
+
+COMMENT ON COLUMN reservations.expires_at IS 'Deadline for confirming a PENDING hold; expired holds move to EXPIRED';
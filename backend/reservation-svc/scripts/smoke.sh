@@ -29,7 +29,9 @@ if [ $RETRIES -eq 0 ]; then
 fi
 
 echo -e "${YELLOW}Step 3: Running database migrations...${NC}"
-docker-compose exec -T postgres psql -U postgres -d auth < backend/reservation-svc/scripts/migrations/001_init.sql
+for migration in backend/reservation-svc/scripts/migrations/*.sql; do
+  docker-compose exec -T postgres psql -U postgres -d auth < "$migration"
+done
 
 if [ $? -ne 0 ]; then
   echo -e "${RED}FAIL: Migration failed${NC}"
@@ -59,11 +61,15 @@ else
 fi
 
 TEST_USER_ID="123e4567-e89b-12d3-a456-426614174000"
-TEST_APARTMENT_ID="223e4567-e89b-12d3-a456-426614174001"
+TEST_RESOURCE_ID="223e4567-e89b-12d3-a456-426614174001"
+TEST_START_TIME="$(date -u -d 'tomorrow 10:00' +%Y-%m-%dT%H:%M:%SZ)"
+TEST_END_TIME="$(date -u -d 'tomorrow 11:00' +%Y-%m-%dT%H:%M:%SZ)"
 
 RESPONSE=$($GRPCURL -plaintext -d "{
   \"user_id\": \"$TEST_USER_ID\",
-  \"apartment_id\": \"$TEST_APARTMENT_ID\",
+  \"resource_id\": \"$TEST_RESOURCE_ID\",
+  \"start_time\": \"$TEST_START_TIME\",
+  \"end_time\": \"$TEST_END_TIME\",
   \"comment\": \"Test reservation\"
 }" localhost:9092 reservation.v1.ReservationService/CreateReservation 2>&1) || true
 
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diploma/authz"
+	reservationv1 "github.com/diploma/reservation-svc/api/v1"
+	"github.com/diploma/reservation-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/reservation-svc/internal/application/reservation/usecase"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newAccessHandler() *handler.ReservationGRPCHandler {
+	svc := service.NewReservationService(NewMockReservationRepository(), NewMockScheduleProvider(), testHoldTTL)
+	txManager := &MockTransactionManager{}
+	events := NewMockEventPublisher()
+
+	return handler.NewReservationGRPCHandler(
+		usecase.NewCreateReservationUseCase(svc, txManager, events),
+		usecase.NewConfirmReservationUseCase(svc, txManager, events),
+		usecase.NewCancelReservationUseCase(svc, txManager, events),
+		usecase.NewGetReservationUseCase(svc),
+		usecase.NewListReservationsByUserUseCase(svc),
+		usecase.NewListReservationsByResourceUseCase(svc),
+	)
+}
+
+func actingAs(userID string, role authz.Role) context.Context {
+	return authz.NewContext(context.Background(), authz.Principal{UserID: userID, Role: role})
+}
+
+func createRequest(userID string) *reservationv1.CreateReservationRequest {
+	start, end := slotAt(10, 1)
+	return &reservationv1.CreateReservationRequest{
+		UserId:     userID,
+		ResourceId: uuid.NewString(),
+		StartTime:  start.Format(time.RFC3339),
+		EndTime:    end.Format(time.RFC3339),
+	}
+}
+
+func TestReservationAccess_CreateOnlyForSelf(t *testing.T) {
+	h := newAccessHandler()
+	userID := uuid.NewString()
+
+	if _, err := h.CreateReservation(actingAs(uuid.NewString(), authz.RolePlayer), createRequest(userID)); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied when booking for someone else, got %v", err)
+	}
+	if _, err := h.CreateReservation(actingAs(userID, authz.RolePlayer), createRequest(userID)); err != nil {
+		t.Errorf("Expected a player to book for themselves, got %v", err)
+	}
+}
+
+func TestReservationAccess_CancelOnlyOwnReservation(t *testing.T) {
+	h := newAccessHandler()
+	userID := uuid.NewString()
+
+	created, err := h.CreateReservation(actingAs(userID, authz.RolePlayer), createRequest(userID))
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+	cancel := &reservationv1.CancelReservationRequest{ReservationId: created.ReservationId}
+
+	if _, err := h.CancelReservation(actingAs(uuid.NewString(), authz.RolePlayer), cancel); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied when cancelling someone else's reservation, got %v", err)
+	}
+	if _, err := h.CancelReservation(actingAs(uuid.NewString(), authz.RoleAdmin), cancel); err != nil {
+		t.Errorf("Expected an admin to cancel any reservation, got %v", err)
+	}
+}
+
+func TestReservationAccess_ReadsOnlyOwnReservations(t *testing.T) {
+	h := newAccessHandler()
+	userID := uuid.NewString()
+
+	created, err := h.CreateReservation(actingAs(userID, authz.RolePlayer), createRequest(userID))
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+	get := &reservationv1.GetReservationRequest{ReservationId: created.ReservationId}
+	list := &reservationv1.ListReservationsByUserRequest{UserId: userID}
+
+	other := actingAs(uuid.NewString(), authz.RolePlayer)
+	if _, err := h.GetReservation(other, get); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied when reading someone else's reservation, got %v", err)
+	}
+	if _, err := h.ListReservationsByUser(other, list); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied when listing someone else's reservations, got %v", err)
+	}
+
+	for _, ctx := range []context.Context{actingAs(userID, authz.RolePlayer), actingAs(authz.ServiceUserID, authz.RoleService)} {
+		if _, err := h.GetReservation(ctx, get); err != nil {
+			t.Errorf("Expected the reservation to be readable, got %v", err)
+		}
+		if _, err := h.ListReservationsByUser(ctx, list); err != nil {
+			t.Errorf("Expected the reservations to be listable, got %v", err)
+		}
+	}
+}
+
+func TestReservationAccess_PolicyCoversEveryMethod(t *testing.T) {
+	for _, method := range reservationv1.ReservationService_ServiceDesc.Methods {
+		fullMethod := "/" + reservationv1.ReservationService_ServiceDesc.ServiceName + "/" + method.MethodName
+		if _, ok := handler.AccessPolicy[fullMethod]; !ok {
+			t.Errorf("Expected an access rule for %s", fullMethod)
+		}
+	}
+}
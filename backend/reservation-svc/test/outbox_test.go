@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
+	"github.com/diploma/reservation-svc/internal/adapters/outbound/external/events"
+	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
+	"github.com/diploma/reservation-svc/internal/application/reservation/usecase"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
+	"github.com/google/uuid"
+)
+
+// MockTransactionManager runs fn inline and counts how each transaction ended
+type MockTransactionManager struct {
+	committed  int
+	rolledBack int
+}
+
+func (m *MockTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		m.rolledBack++
+		return err
+	}
+	m.committed++
+	return nil
+}
+
+type MockOutboxRepository struct {
+	messages []*outbox.Message
+}
+
+func (m *MockOutboxRepository) Add(ctx context.Context, message *outbox.Message) error {
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+func (m *MockOutboxRepository) ClaimPending(ctx context.Context, now, claimedUntil time.Time, limit int) ([]*outbox.Message, error) {
+	var pending []*outbox.Message
+	for _, message := range m.messages {
+		if !message.IsSent() && !message.NextAttemptAt.After(now) && len(pending) < limit {
+			message.NextAttemptAt = claimedUntil
+			pending = append(pending, message)
+		}
+	}
+	return pending, nil
+}
+
+func (m *MockOutboxRepository) MarkSent(ctx context.Context, ids []uuid.UUID, sentAt time.Time) error {
+	for _, id := range ids {
+		m.find(id).SentAt = &sentAt
+	}
+	return nil
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error {
+	message := m.find(id)
+	message.Attempts++
+	message.LastError = &lastError
+	message.NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (m *MockOutboxRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockOutboxRepository) find(id uuid.UUID) *outbox.Message {
+	for _, message := range m.messages {
+		if message.ID == id {
+			return message
+		}
+	}
+	return nil
+}
+
+var _ usecase.TransactionManager = (*MockTransactionManager)(nil)
+var _ outbox.Repository = (*MockOutboxRepository)(nil)
+
+func TestOutboxPublisher_StoresEnvelopeInTransaction(t *testing.T) {
+	outbox := &MockOutboxRepository{}
+	txManager := &MockTransactionManager{}
+	svc := service.NewReservationService(NewMockReservationRepository(), NewMockScheduleProvider(), testHoldTTL)
+	publisher := events.NewOutboxPublisher(outbox, sharedevents.NewCodec("reservation-svc"))
+
+	start, end := slotAt(10, 1)
+	output, err := usecase.NewCreateReservationUseCase(svc, txManager, publisher).Execute(context.Background(), dto.CreateReservationInput{
+		UserID:     uuid.New(),
+		ResourceID: uuid.New(),
+		StartTime:  start,
+		EndTime:    end,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if txManager.committed != 1 || len(outbox.messages) != 1 {
+		t.Fatalf("Expected 1 committed transaction with 1 outbox message, got %d and %d", txManager.committed, len(outbox.messages))
+	}
+
+	message := outbox.messages[0]
+	envelope, err := sharedevents.Decode(message.Payload)
+	if err != nil {
+		t.Fatalf("Expected stored payload to be a valid envelope, got %v", err)
+	}
+
+	var payload sharedevents.ReservationCreated
+	if err := envelope.UnmarshalPayload(&payload); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if message.Subject != sharedevents.SubjectReservationCreated || message.EventID != envelope.ID || payload.ReservationID != output.ReservationID.String() {
+		t.Errorf("Unexpected outbox message %+v for envelope %+v", message, envelope)
+	}
+}
+
+func TestCreateReservation_RollsBackWhenOutboxWriteFails(t *testing.T) {
+	repo := NewMockReservationRepository()
+	txManager := &MockTransactionManager{}
+	eventPublisher := NewMockEventPublisher()
+	eventPublisher.shouldError = true
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+	start, end := slotAt(10, 1)
+	_, err := usecase.NewCreateReservationUseCase(svc, txManager, eventPublisher).Execute(context.Background(), dto.CreateReservationInput{
+		UserID:     uuid.New(),
+		ResourceID: uuid.New(),
+		StartTime:  start,
+		EndTime:    end,
+	})
+
+	if err == nil {
+		t.Fatal("Expected error when the event cannot be recorded")
+	}
+
+	if txManager.rolledBack != 1 {
+		t.Errorf("Expected transaction to be rolled back, got %d rollbacks", txManager.rolledBack)
+	}
+}
@@ -9,14 +9,19 @@ import (
 	"github.com/diploma/reservation-svc/internal/application/reservation/dto"
 	"github.com/diploma/reservation-svc/internal/application/reservation/usecase"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/entity"
+	"github.com/diploma/reservation-svc/internal/domain/reservation/port"
 	"github.com/diploma/reservation-svc/internal/domain/reservation/service"
 	pkgerrors "github.com/diploma/reservation-svc/pkg/errors"
 	"github.com/google/uuid"
 )
 
+const testHoldTTL = 15 * time.Minute
+
 type MockReservationRepository struct {
 	reservations map[uuid.UUID]*entity.Reservation
 	shouldError  bool
+	// beforeUpdate runs between a service reading a reservation and writing it back
+	beforeUpdate func()
 }
 
 func NewMockReservationRepository() *MockReservationRepository {
@@ -29,7 +34,7 @@ func (m *MockReservationRepository) Create(ctx context.Context, reservation *ent
 	if m.shouldError {
 		return fmt.Errorf("database error")
 	}
-	
+
 	m.reservations[reservation.ID] = reservation
 	reservation.ReservedAt = time.Now()
 	return nil
@@ -39,31 +44,94 @@ func (m *MockReservationRepository) GetByID(ctx context.Context, id uuid.UUID) (
 	if m.shouldError {
 		return nil, fmt.Errorf("database error")
 	}
-	
+
 	reservation, ok := m.reservations[id]
 	if !ok {
 		return nil, pkgerrors.NewNotFoundError("reservation not found")
 	}
-	return reservation, nil
+	// A copy, like a row read from the database, so concurrent writes are not seen through it
+	read := *reservation
+	return &read, nil
 }
 
-func (m *MockReservationRepository) Update(ctx context.Context, reservation *entity.Reservation) error {
+func (m *MockReservationRepository) Update(ctx context.Context, reservation *entity.Reservation, from entity.ReservationStatus) error {
 	if m.shouldError {
 		return fmt.Errorf("database error")
 	}
-	
-	if _, ok := m.reservations[reservation.ID]; !ok {
+	if m.beforeUpdate != nil {
+		m.beforeUpdate()
+	}
+
+	stored, ok := m.reservations[reservation.ID]
+	if !ok {
 		return pkgerrors.NewNotFoundError("reservation not found")
 	}
-	m.reservations[reservation.ID] = reservation
+	if stored.Status != from {
+		return pkgerrors.NewFailedPreconditionError(fmt.Sprintf("reservation changed to %s in the meantime", stored.Status))
+	}
+	written := *reservation
+	m.reservations[reservation.ID] = &written
 	return nil
 }
 
+func (m *MockReservationRepository) ListActiveByResourceInRange(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]*entity.Reservation, error) {
+	if m.shouldError {
+		return nil, fmt.Errorf("database error")
+	}
+
+	var result []*entity.Reservation
+	for _, reservation := range m.reservations {
+		if reservation.Status == entity.StatusPending && reservation.IsExpired() {
+			continue
+		}
+		if reservation.ResourceID == resourceID && reservation.HoldsSlot() && reservation.Overlaps(from, to) {
+			result = append(result, reservation)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockReservationRepository) ExpirePending(ctx context.Context, now time.Time, limit int) ([]*entity.Reservation, error) {
+	if m.shouldError {
+		return nil, fmt.Errorf("database error")
+	}
+
+	var result []*entity.Reservation
+	for _, reservation := range m.reservations {
+		if len(result) == limit {
+			break
+		}
+		if reservation.Status == entity.StatusPending && reservation.ExpiresAt != nil && !reservation.ExpiresAt.After(now) {
+			reservation.Status = entity.StatusExpired
+			result = append(result, reservation)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockReservationRepository) ExpirePendingInRange(ctx context.Context, resourceID uuid.UUID, from, to, now time.Time) ([]*entity.Reservation, error) {
+	if m.shouldError {
+		return nil, fmt.Errorf("database error")
+	}
+
+	var result []*entity.Reservation
+	for _, reservation := range m.reservations {
+		if reservation.ResourceID != resourceID || !reservation.Overlaps(from, to) {
+			continue
+		}
+		if reservation.Status == entity.StatusPending && reservation.ExpiresAt != nil && !reservation.ExpiresAt.After(now) {
+			reservation.Status = entity.StatusExpired
+			result = append(result, reservation)
+		}
+	}
+	return result, nil
+}
+
 func (m *MockReservationRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Reservation, error) {
 	if m.shouldError {
 		return nil, fmt.Errorf("database error")
 	}
-	
+
 	var result []*entity.Reservation
 	for _, reservation := range m.reservations {
 		if reservation.UserID == userID {
@@ -73,10 +141,37 @@ func (m *MockReservationRepository) ListByUserID(ctx context.Context, userID uui
 	return result, nil
 }
 
+type MockScheduleProvider struct {
+	slots       []port.ScheduleSlot
+	shouldError bool
+}
+
+func NewMockScheduleProvider() *MockScheduleProvider {
+	slots := make([]port.ScheduleSlot, 0, 7)
+	for day := 0; day <= 6; day++ {
+		slots = append(slots, port.ScheduleSlot{DayOfWeek: day, StartTime: "08:00", EndTime: "22:00", BasePrice: 40})
+	}
+	return &MockScheduleProvider{slots: slots}
+}
+
+func (m *MockScheduleProvider) GetResourceSchedule(ctx context.Context, resourceID uuid.UUID) ([]port.ScheduleSlot, error) {
+	if m.shouldError {
+		return nil, pkgerrors.NewNotFoundError("resource not found")
+	}
+	return m.slots, nil
+}
+
+func slotAt(hour, durationHours int) (time.Time, time.Time) {
+	day := time.Now().UTC().AddDate(0, 0, 1)
+	start := time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, time.UTC)
+	return start, start.Add(time.Duration(durationHours) * time.Hour)
+}
+
 type MockEventPublisher struct {
 	CreatedEvents   []string
 	ConfirmedEvents []string
 	CancelledEvents []string
+	ExpiredEvents   []string
 	shouldError     bool
 }
 
@@ -85,10 +180,11 @@ func NewMockEventPublisher() *MockEventPublisher {
 		CreatedEvents:   make([]string, 0),
 		ConfirmedEvents: make([]string, 0),
 		CancelledEvents: make([]string, 0),
+		ExpiredEvents:   make([]string, 0),
 	}
 }
 
-func (m *MockEventPublisher) PublishReservationCreated(ctx context.Context, reservationID, userID, apartmentID string) error {
+func (m *MockEventPublisher) PublishReservationCreated(ctx context.Context, reservationID, userID, resourceID string, startTime, endTime time.Time) error {
 	if m.shouldError {
 		return fmt.Errorf("event publish error")
 	}
@@ -96,7 +192,7 @@ func (m *MockEventPublisher) PublishReservationCreated(ctx context.Context, rese
 	return nil
 }
 
-func (m *MockEventPublisher) PublishReservationConfirmed(ctx context.Context, reservationID string) error {
+func (m *MockEventPublisher) PublishReservationConfirmed(ctx context.Context, reservationID, userID, resourceID string) error {
 	if m.shouldError {
 		return fmt.Errorf("event publish error")
 	}
@@ -104,7 +200,7 @@ func (m *MockEventPublisher) PublishReservationConfirmed(ctx context.Context, re
 	return nil
 }
 
-func (m *MockEventPublisher) PublishReservationCancelled(ctx context.Context, reservationID string) error {
+func (m *MockEventPublisher) PublishReservationCancelled(ctx context.Context, reservationID, userID, resourceID string) error {
 	if m.shouldError {
 		return fmt.Errorf("event publish error")
 	}
@@ -112,15 +208,24 @@ func (m *MockEventPublisher) PublishReservationCancelled(ctx context.Context, re
 	return nil
 }
 
+func (m *MockEventPublisher) PublishReservationExpired(ctx context.Context, reservationID, userID, resourceID string) error {
+	if m.shouldError {
+		return fmt.Errorf("event publish error")
+	}
+	m.ExpiredEvents = append(m.ExpiredEvents, reservationID)
+	return nil
+}
+
 func TestCreateReservation(t *testing.T) {
 	repo := NewMockReservationRepository()
-	service := service.NewReservationService(repo)
+	service := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 	comment := "Test comment"
 
-	reservation, err := service.CreateReservation(context.Background(), userID, apartmentID, &comment)
+	reservation, err := service.CreateReservation(context.Background(), userID, resourceID, start, end, &comment)
 	if err != nil {
 		t.Fatalf("Failed to create reservation: %v", err)
 	}
@@ -140,12 +245,13 @@ func TestCreateReservation(t *testing.T) {
 
 func TestConfirmReservation(t *testing.T) {
 	repo := NewMockReservationRepository()
-	service := service.NewReservationService(repo)
+	service := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 
-	reservation, err := service.CreateReservation(context.Background(), userID, apartmentID, nil)
+	reservation, err := service.CreateReservation(context.Background(), userID, resourceID, start, end, nil)
 	if err != nil {
 		t.Fatalf("Failed to create reservation: %v", err)
 	}
@@ -166,12 +272,13 @@ func TestConfirmReservation(t *testing.T) {
 
 func TestCancelReservation(t *testing.T) {
 	repo := NewMockReservationRepository()
-	service := service.NewReservationService(repo)
+	service := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 
-	reservation, err := service.CreateReservation(context.Background(), userID, apartmentID, nil)
+	reservation, err := service.CreateReservation(context.Background(), userID, resourceID, start, end, nil)
 	if err != nil {
 		t.Fatalf("Failed to create reservation: %v", err)
 	}
@@ -192,12 +299,13 @@ func TestCancelReservation(t *testing.T) {
 
 func TestReservationStatusTransitions(t *testing.T) {
 	repo := NewMockReservationRepository()
-	svc := service.NewReservationService(repo)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 
-	reservation, _ := svc.CreateReservation(context.Background(), userID, apartmentID, nil)
+	reservation, _ := svc.CreateReservation(context.Background(), userID, resourceID, start, end, nil)
 
 	_, err := svc.ConfirmReservation(context.Background(), reservation.ID)
 	if err != nil {
@@ -213,7 +321,7 @@ func TestReservationStatusTransitions(t *testing.T) {
 	if err == nil {
 		t.Error("Should not be able to confirm CANCELLED reservation")
 	}
-	
+
 	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeFailedPrecondition {
 		t.Errorf("Expected FailedPrecondition error, got %s", pkgerrors.GetErrorCode(err))
 	}
@@ -222,17 +330,20 @@ func TestReservationStatusTransitions(t *testing.T) {
 func TestCreateReservationSuccess(t *testing.T) {
 	repo := NewMockReservationRepository()
 	eventPublisher := NewMockEventPublisher()
-	svc := service.NewReservationService(repo)
-	createUseCase := usecase.NewCreateReservationUseCase(svc, eventPublisher)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	createUseCase := usecase.NewCreateReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 	comment := "Test reservation"
 
 	input := dto.CreateReservationInput{
-		UserID:      userID,
-		ApartmentID: apartmentID,
-		Comment:     &comment,
+		UserID:     userID,
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    end,
+		Comment:    &comment,
 	}
 
 	output, err := createUseCase.Execute(context.Background(), input)
@@ -263,28 +374,48 @@ func TestCreateReservationSuccess(t *testing.T) {
 
 func TestCreateReservationInvalidInput(t *testing.T) {
 	repo := NewMockReservationRepository()
-	svc := service.NewReservationService(repo)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+	start, end := slotAt(10, 1)
 
 	tests := []struct {
-		name        string
-		userID      uuid.UUID
-		apartmentID uuid.UUID
+		name       string
+		userID     uuid.UUID
+		resourceID uuid.UUID
+		start      time.Time
+		end        time.Time
 	}{
 		{
-			name:        "empty user_id",
-			userID:      uuid.Nil,
-			apartmentID: uuid.New(),
+			name:       "empty user_id",
+			userID:     uuid.Nil,
+			resourceID: uuid.New(),
+			start:      start,
+			end:        end,
+		},
+		{
+			name:       "empty resource_id",
+			userID:     uuid.New(),
+			resourceID: uuid.Nil,
+			start:      start,
+			end:        end,
 		},
 		{
-			name:        "empty apartment_id",
-			userID:      uuid.New(),
-			apartmentID: uuid.Nil,
+			name:       "missing time window",
+			userID:     uuid.New(),
+			resourceID: uuid.New(),
+		},
+		{
+			name:       "end before start",
+			userID:     uuid.New(),
+			resourceID: uuid.New(),
+			start:      end,
+			end:        start,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := svc.CreateReservation(context.Background(), tt.userID, tt.apartmentID, nil)
+			_, err := svc.CreateReservation(context.Background(), tt.userID, tt.resourceID, tt.start, tt.end, nil)
 			if err == nil {
 				t.Error("Expected validation error")
 			}
@@ -299,15 +430,18 @@ func TestCreateReservationInvalidInput(t *testing.T) {
 func TestConfirmReservationSuccess(t *testing.T) {
 	repo := NewMockReservationRepository()
 	eventPublisher := NewMockEventPublisher()
-	svc := service.NewReservationService(repo)
-	createUseCase := usecase.NewCreateReservationUseCase(svc, eventPublisher)
-	confirmUseCase := usecase.NewConfirmReservationUseCase(svc, eventPublisher)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	createUseCase := usecase.NewCreateReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
+	confirmUseCase := usecase.NewConfirmReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 	createInput := dto.CreateReservationInput{
-		UserID:      userID,
-		ApartmentID: apartmentID,
+		UserID:     userID,
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    end,
 	}
 	createOutput, _ := createUseCase.Execute(context.Background(), createInput)
 
@@ -335,7 +469,7 @@ func TestConfirmReservationSuccess(t *testing.T) {
 
 func TestConfirmReservationNotFound(t *testing.T) {
 	repo := NewMockReservationRepository()
-	svc := service.NewReservationService(repo)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
 	_, err := svc.ConfirmReservation(context.Background(), uuid.New())
 	if err == nil {
@@ -349,9 +483,10 @@ func TestConfirmReservationNotFound(t *testing.T) {
 
 func TestConfirmReservationInvalidState(t *testing.T) {
 	repo := NewMockReservationRepository()
-	svc := service.NewReservationService(repo)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
-	reservation, _ := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), nil)
+	start, end := slotAt(10, 1)
+	reservation, _ := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil)
 	svc.CancelReservation(context.Background(), reservation.ID)
 
 	_, err := svc.ConfirmReservation(context.Background(), reservation.ID)
@@ -367,13 +502,16 @@ func TestConfirmReservationInvalidState(t *testing.T) {
 func TestCancelReservationSuccess(t *testing.T) {
 	repo := NewMockReservationRepository()
 	eventPublisher := NewMockEventPublisher()
-	svc := service.NewReservationService(repo)
-	createUseCase := usecase.NewCreateReservationUseCase(svc, eventPublisher)
-	cancelUseCase := usecase.NewCancelReservationUseCase(svc, eventPublisher)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	createUseCase := usecase.NewCreateReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
+	cancelUseCase := usecase.NewCancelReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
 
+	start, end := slotAt(10, 1)
 	createInput := dto.CreateReservationInput{
-		UserID:      uuid.New(),
-		ApartmentID: uuid.New(),
+		UserID:     uuid.New(),
+		ResourceID: uuid.New(),
+		StartTime:  start,
+		EndTime:    end,
 	}
 	createOutput, _ := createUseCase.Execute(context.Background(), createInput)
 
@@ -402,15 +540,18 @@ func TestCancelReservationSuccess(t *testing.T) {
 func TestGetReservationSuccess(t *testing.T) {
 	repo := NewMockReservationRepository()
 	eventPublisher := NewMockEventPublisher()
-	svc := service.NewReservationService(repo)
-	createUseCase := usecase.NewCreateReservationUseCase(svc, eventPublisher)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	createUseCase := usecase.NewCreateReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
 	getUseCase := usecase.NewGetReservationUseCase(svc)
 
 	userID := uuid.New()
-	apartmentID := uuid.New()
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
 	createInput := dto.CreateReservationInput{
-		UserID:      userID,
-		ApartmentID: apartmentID,
+		UserID:     userID,
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    end,
 	}
 	createOutput, _ := createUseCase.Execute(context.Background(), createInput)
 
@@ -435,7 +576,7 @@ func TestGetReservationSuccess(t *testing.T) {
 
 func TestGetReservationNotFound(t *testing.T) {
 	repo := NewMockReservationRepository()
-	svc := service.NewReservationService(repo)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 
 	_, err := svc.GetReservation(context.Background(), uuid.New())
 	if err == nil {
@@ -450,16 +591,19 @@ func TestGetReservationNotFound(t *testing.T) {
 func TestListReservationsByUserSuccess(t *testing.T) {
 	repo := NewMockReservationRepository()
 	eventPublisher := NewMockEventPublisher()
-	svc := service.NewReservationService(repo)
-	createUseCase := usecase.NewCreateReservationUseCase(svc, eventPublisher)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	createUseCase := usecase.NewCreateReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
 	listUseCase := usecase.NewListReservationsByUserUseCase(svc)
 
 	userID := uuid.New()
 
 	for i := 0; i < 3; i++ {
+		start, end := slotAt(10+i, 1)
 		createInput := dto.CreateReservationInput{
-			UserID:      userID,
-			ApartmentID: uuid.New(),
+			UserID:     userID,
+			ResourceID: uuid.New(),
+			StartTime:  start,
+			EndTime:    end,
 		}
 		createUseCase.Execute(context.Background(), createInput)
 	}
@@ -485,7 +629,7 @@ func TestListReservationsByUserSuccess(t *testing.T) {
 
 func TestListReservationsByUserEmpty(t *testing.T) {
 	repo := NewMockReservationRepository()
-	svc := service.NewReservationService(repo)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
 	listUseCase := usecase.NewListReservationsByUserUseCase(svc)
 
 	listInput := dto.ListReservationsByUserInput{
@@ -505,7 +649,7 @@ func TestReservationIsExpired(t *testing.T) {
 	r1 := &entity.Reservation{
 		ID:         uuid.New(),
 		UserID:     uuid.New(),
-		ApartmentID: uuid.New(),
+		ResourceID: uuid.New(),
 		Status:     entity.StatusPending,
 		ExpiresAt:  nil,
 	}
@@ -517,7 +661,7 @@ func TestReservationIsExpired(t *testing.T) {
 	r2 := &entity.Reservation{
 		ID:         uuid.New(),
 		UserID:     uuid.New(),
-		ApartmentID: uuid.New(),
+		ResourceID: uuid.New(),
 		Status:     entity.StatusPending,
 		ExpiresAt:  &futureTime,
 	}
@@ -529,7 +673,7 @@ func TestReservationIsExpired(t *testing.T) {
 	r3 := &entity.Reservation{
 		ID:         uuid.New(),
 		UserID:     uuid.New(),
-		ApartmentID: uuid.New(),
+		ResourceID: uuid.New(),
 		Status:     entity.StatusPending,
 		ExpiresAt:  &pastTime,
 	}
@@ -538,3 +682,313 @@ func TestReservationIsExpired(t *testing.T) {
 	}
 }
 
+func TestCreateReservationOverlapConflict(t *testing.T) {
+	repo := NewMockReservationRepository()
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+	resourceID := uuid.New()
+	start, end := slotAt(10, 2)
+
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, start, end, nil); err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	overlapStart, overlapEnd := slotAt(11, 2)
+	_, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, overlapStart, overlapEnd, nil)
+	if err == nil {
+		t.Fatal("Expected conflict for overlapping reservation")
+	}
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeConflict {
+		t.Errorf("Expected Conflict error, got %s", pkgerrors.GetErrorCode(err))
+	}
+
+	adjacentStart, adjacentEnd := slotAt(12, 1)
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, adjacentStart, adjacentEnd, nil); err != nil {
+		t.Errorf("Adjacent reservation should be allowed: %v", err)
+	}
+
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil); err != nil {
+		t.Errorf("Same window on another resource should be allowed: %v", err)
+	}
+}
+
+func TestCancelledReservationReleasesSlot(t *testing.T) {
+	repo := NewMockReservationRepository()
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+	resourceID := uuid.New()
+	start, end := slotAt(15, 1)
+
+	reservation, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, start, end, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	if _, err := svc.CancelReservation(context.Background(), reservation.ID); err != nil {
+		t.Fatalf("Failed to cancel reservation: %v", err)
+	}
+
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, start, end, nil); err != nil {
+		t.Errorf("Slot should be free after cancellation: %v", err)
+	}
+}
+
+func TestCreateReservationOutsideSchedule(t *testing.T) {
+	repo := NewMockReservationRepository()
+	schedules := NewMockScheduleProvider()
+	svc := service.NewReservationService(repo, schedules, testHoldTTL)
+
+	tests := []struct {
+		name  string
+		hour  int
+		hours int
+	}{
+		{name: "before opening", hour: 6, hours: 1},
+		{name: "past closing", hour: 21, hours: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := slotAt(tt.hour, tt.hours)
+			_, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil)
+			if err == nil {
+				t.Fatal("Expected schedule validation error")
+			}
+			if pkgerrors.GetErrorCode(err) != pkgerrors.CodeFailedPrecondition {
+				t.Errorf("Expected FailedPrecondition error, got %s", pkgerrors.GetErrorCode(err))
+			}
+		})
+	}
+}
+
+func TestCreateReservationSpansAdjacentSlots(t *testing.T) {
+	repo := NewMockReservationRepository()
+	start, end := slotAt(11, 2)
+	day := int(start.Weekday())
+
+	schedules := &MockScheduleProvider{slots: []port.ScheduleSlot{
+		{DayOfWeek: day, StartTime: "12:00", EndTime: "15:00", BasePrice: 50},
+		{DayOfWeek: day, StartTime: "09:00", EndTime: "12:00", BasePrice: 40},
+	}}
+	svc := service.NewReservationService(repo, schedules, testHoldTTL)
+
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil); err != nil {
+		t.Errorf("Reservation spanning adjacent slots should be allowed: %v", err)
+	}
+
+	schedules.slots = schedules.slots[:1]
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil); err == nil {
+		t.Error("Reservation should not fit when the morning slot is missing")
+	}
+}
+
+func TestCreateReservationUnknownResource(t *testing.T) {
+	repo := NewMockReservationRepository()
+	schedules := NewMockScheduleProvider()
+	schedules.shouldError = true
+	svc := service.NewReservationService(repo, schedules, testHoldTTL)
+
+	start, end := slotAt(10, 1)
+	_, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil)
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeNotFound {
+		t.Errorf("Expected NotFound error, got %s", pkgerrors.GetErrorCode(err))
+	}
+}
+
+func TestListActiveReservationsByResource(t *testing.T) {
+	repo := NewMockReservationRepository()
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+	resourceID := uuid.New()
+	morningStart, morningEnd := slotAt(9, 1)
+	eveningStart, eveningEnd := slotAt(18, 1)
+
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, morningStart, morningEnd, nil); err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+	cancelled, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, eveningStart, eveningEnd, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+	if _, err := svc.CancelReservation(context.Background(), cancelled.ID); err != nil {
+		t.Fatalf("Failed to cancel reservation: %v", err)
+	}
+
+	dayStart, _ := slotAt(0, 1)
+	reservations, err := svc.ListActiveReservationsByResource(context.Background(), resourceID, dayStart, dayStart.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("Failed to list reservations: %v", err)
+	}
+	if len(reservations) != 1 {
+		t.Fatalf("Expected 1 active reservation, got %d", len(reservations))
+	}
+	if !reservations[0].StartTime.Equal(morningStart) {
+		t.Errorf("Expected reservation starting at %v, got %v", morningStart, reservations[0].StartTime)
+	}
+
+	if _, err := svc.ListActiveReservationsByResource(context.Background(), resourceID, dayStart, dayStart); pkgerrors.GetErrorCode(err) != pkgerrors.CodeInvalidArgument {
+		t.Errorf("Expected InvalidArgument for empty range, got %v", err)
+	}
+}
+
+func TestCreateReservationSetsHoldExpiry(t *testing.T) {
+	repo := NewMockReservationRepository()
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+	start, end := slotAt(10, 1)
+	before := time.Now()
+	reservation, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	if reservation.ExpiresAt == nil {
+		t.Fatal("Expected expires_at to be set on a pending reservation")
+	}
+	if reservation.ExpiresAt.Before(before.Add(testHoldTTL)) || reservation.ExpiresAt.After(time.Now().Add(testHoldTTL)) {
+		t.Errorf("Expected expires_at about %v from now, got %v", testHoldTTL, reservation.ExpiresAt)
+	}
+
+	confirmed, err := svc.ConfirmReservation(context.Background(), reservation.ID)
+	if err != nil {
+		t.Fatalf("Failed to confirm reservation: %v", err)
+	}
+	if confirmed.ExpiresAt != nil {
+		t.Error("Confirmed reservation should no longer expire")
+	}
+}
+
+func TestTransitionLosesRaceWithExpirySweeper(t *testing.T) {
+	for name, transition := range map[string]func(*service.ReservationService, context.Context, uuid.UUID) (*entity.Reservation, error){
+		"confirm": (*service.ReservationService).ConfirmReservation,
+		"cancel":  (*service.ReservationService).CancelReservation,
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := NewMockReservationRepository()
+			svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+
+			start, end := slotAt(10, 1)
+			reservation, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil)
+			if err != nil {
+				t.Fatalf("Failed to create reservation: %v", err)
+			}
+
+			// The sweeper, whose clock is past the hold, expires it after the transition read it as PENDING
+			repo.beforeUpdate = func() {
+				if _, err := repo.ExpirePending(context.Background(), time.Now().Add(time.Hour), 10); err != nil {
+					t.Fatalf("Failed to expire reservations: %v", err)
+				}
+			}
+
+			_, err = transition(svc, context.Background(), reservation.ID)
+			if pkgerrors.GetErrorCode(err) != pkgerrors.CodeFailedPrecondition {
+				t.Errorf("Expected FAILED_PRECONDITION, got %v", err)
+			}
+			if status := repo.reservations[reservation.ID].Status; status != entity.StatusExpired {
+				t.Errorf("Expected the sweeper's EXPIRED to stand, got %s", status)
+			}
+		})
+	}
+}
+
+func TestConfirmExpiredHoldFails(t *testing.T) {
+	repo := NewMockReservationRepository()
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), -time.Minute)
+
+	start, end := slotAt(10, 1)
+	reservation, err := svc.CreateReservation(context.Background(), uuid.New(), uuid.New(), start, end, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	_, err = svc.ConfirmReservation(context.Background(), reservation.ID)
+	if pkgerrors.GetErrorCode(err) != pkgerrors.CodeFailedPrecondition {
+		t.Errorf("Expected FailedPrecondition for expired hold, got %v", err)
+	}
+}
+
+func TestExpireReservationsReleasesSlot(t *testing.T) {
+	repo := NewMockReservationRepository()
+	eventPublisher := NewMockEventPublisher()
+	expiredSvc := service.NewReservationService(repo, NewMockScheduleProvider(), -time.Minute)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	expireUseCase := usecase.NewExpireReservationsUseCase(svc, &MockTransactionManager{}, eventPublisher)
+
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
+	stale, err := expiredSvc.CreateReservation(context.Background(), uuid.New(), resourceID, start, end, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	freshStart, freshEnd := slotAt(12, 1)
+	fresh, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, freshStart, freshEnd, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	output, err := expireUseCase.Execute(context.Background(), dto.ExpireReservationsInput{BatchSize: 10})
+	if err != nil {
+		t.Fatalf("Failed to expire reservations: %v", err)
+	}
+
+	if output.ExpiredCount != 1 {
+		t.Fatalf("Expected 1 expired reservation, got %d", output.ExpiredCount)
+	}
+	if repo.reservations[stale.ID].Status != entity.StatusExpired {
+		t.Errorf("Expected stale reservation to be EXPIRED, got %s", repo.reservations[stale.ID].Status)
+	}
+	if repo.reservations[fresh.ID].Status != entity.StatusPending {
+		t.Errorf("Expected fresh reservation to stay PENDING, got %s", repo.reservations[fresh.ID].Status)
+	}
+	if len(eventPublisher.ExpiredEvents) != 1 || eventPublisher.ExpiredEvents[0] != stale.ID.String() {
+		t.Errorf("Expected one expired event for %s, got %v", stale.ID, eventPublisher.ExpiredEvents)
+	}
+
+	if _, err := svc.CreateReservation(context.Background(), uuid.New(), resourceID, start, end, nil); err != nil {
+		t.Errorf("Slot should be free after expiry: %v", err)
+	}
+
+	if err := repo.reservations[stale.ID].Expire(); err == nil {
+		t.Error("Expected error expiring a non-pending reservation")
+	}
+}
+
+func TestCreateReservationReplacesLapsedHold(t *testing.T) {
+	repo := NewMockReservationRepository()
+	eventPublisher := NewMockEventPublisher()
+	expiredSvc := service.NewReservationService(repo, NewMockScheduleProvider(), -time.Minute)
+	svc := service.NewReservationService(repo, NewMockScheduleProvider(), testHoldTTL)
+	createUseCase := usecase.NewCreateReservationUseCase(svc, &MockTransactionManager{}, eventPublisher)
+
+	resourceID := uuid.New()
+	start, end := slotAt(10, 1)
+	stale, err := expiredSvc.CreateReservation(context.Background(), uuid.New(), resourceID, start, end, nil)
+	if err != nil {
+		t.Fatalf("Failed to create reservation: %v", err)
+	}
+
+	active, err := svc.ListActiveReservationsByResource(context.Background(), resourceID, start, end)
+	if err != nil {
+		t.Fatalf("Failed to list reservations: %v", err)
+	}
+	if len(active) != 0 {
+		t.Errorf("Expected the lapsed hold to be left out of active reservations, got %d", len(active))
+	}
+
+	if _, err := createUseCase.Execute(context.Background(), dto.CreateReservationInput{
+		UserID:     uuid.New(),
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    end,
+	}); err != nil {
+		t.Fatalf("Expected the lapsed hold not to block the slot: %v", err)
+	}
+
+	if repo.reservations[stale.ID].Status != entity.StatusExpired {
+		t.Errorf("Expected the lapsed hold to be EXPIRED, got %s", repo.reservations[stale.ID].Status)
+	}
+	if len(eventPublisher.ExpiredEvents) != 1 || eventPublisher.ExpiredEvents[0] != stale.ID.String() {
+		t.Errorf("Expected one expired event for %s, got %v", stale.ID, eventPublisher.ExpiredEvents)
+	}
+}
@@ -21,7 +21,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Session status enum
 type SessionStatus int32
 
 const (
@@ -80,7 +79,6 @@ func (SessionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_session_proto_rawDescGZIP(), []int{0}
 }
 
-// Session visibility enum
 type SessionVisibility int32
 
 const (
@@ -130,7 +128,6 @@ func (SessionVisibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_session_proto_rawDescGZIP(), []int{1}
 }
 
-// Participant role enum
 type ParticipantRole int32
 
 const (
@@ -180,7 +177,6 @@ func (ParticipantRole) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_session_proto_rawDescGZIP(), []int{2}
 }
 
-// Participant status enum
 type ParticipantStatus int32
 
 const (
@@ -233,7 +229,6 @@ func (ParticipantStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_session_proto_rawDescGZIP(), []int{3}
 }
 
-// Session messages
 type CreateSessionRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	ReservationId       string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
@@ -446,6 +441,8 @@ type GetSessionResponse struct {
 	Description         string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
 	CreatedAt           string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt           string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartTime           string                 `protobuf:"bytes,15,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             string                 `protobuf:"bytes,16,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -578,6 +575,20 @@ func (x *GetSessionResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *GetSessionResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetSessionResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type ListOpenSessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SportType     string                 `protobuf:"bytes,1,opt,name=sport_type,json=sportType,proto3" json:"sport_type,omitempty"`    // Filter by sport (optional)
@@ -906,7 +917,6 @@ func (x *CancelSessionResponse) GetSuccess() bool {
 	return false
 }
 
-// Participant messages
 type JoinSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -1296,7 +1306,7 @@ const file_api_v1_session_proto_rawDesc = "" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\"2\n" +
 	"\x11GetSessionRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb3\x04\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xed\x04\n" +
 	"\x12GetSessionResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\x12\x17\n" +
@@ -1318,7 +1328,10 @@ const file_api_v1_session_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"\x8a\x01\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x0f \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x10 \x01(\tR\aendTime\"\x8a\x01\n" +
 	"\x17ListOpenSessionsRequest\x12\x1d\n" +
 	"\n" +
 	"sport_type\x18\x01 \x01(\tR\tsportType\x12\x1f\n" +
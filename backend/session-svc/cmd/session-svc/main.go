@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -8,16 +9,21 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/diploma/authz"
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
 	sessionv1 "github.com/diploma/session-svc/api/v1"
 	"github.com/diploma/session-svc/internal/adapters/inbound/grpc/handler"
 	"github.com/diploma/session-svc/internal/adapters/outbound/database/repository"
 	"github.com/diploma/session-svc/internal/adapters/outbound/external/events"
+	"github.com/diploma/session-svc/internal/adapters/outbound/external/reservation"
 	participantusecase "github.com/diploma/session-svc/internal/application/participant/usecase"
 	sessionusecase "github.com/diploma/session-svc/internal/application/session/usecase"
 	"github.com/diploma/session-svc/internal/config"
 	participantservice "github.com/diploma/session-svc/internal/domain/participant/service"
 	sessionservice "github.com/diploma/session-svc/internal/domain/session/service"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"gorm.io/driver/postgres"
@@ -45,28 +51,44 @@ func main() {
 		log.Fatalf("Failed to ping database: %v", err)
 	}
 
-	natsConn, err := nats.Connect(cfg.NATSConfig.URL)
+	natsConn, err := nats.Connect(cfg.NATSConfig.URL, nats.Timeout(cfg.NATSConfig.Timeout))
 	if err != nil {
 		log.Fatalf("Failed to connect to NATS: %v", err)
 	}
 	defer natsConn.Close()
 
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		log.Fatalf("Failed to create JetStream context: %v", err)
+	}
+	if err := sharedevents.EnsureStream(context.Background(), js, sharedevents.StreamSessions, cfg.NATSConfig.StreamMaxAge); err != nil {
+		log.Fatalf("Failed to ensure event stream: %v", err)
+	}
+
+	reservationClient, err := reservation.NewReservationClient(cfg.ReservationServiceURL, cfg.JWT.ServiceKey)
+	if err != nil {
+		log.Fatalf("Failed to create reservation client: %v", err)
+	}
+	defer reservationClient.Close()
+
 	sessionRepo := repository.NewSessionRepository(db)
 	participantRepo := repository.NewParticipantRepository(db)
+	outboxRepo := outbox.NewRepository(db, "session_outbox")
+	txManager := outbox.NewTransactionManager(db)
 
-	sessionService := sessionservice.NewSessionService(sessionRepo, participantRepo)
+	sessionService := sessionservice.NewSessionService(sessionRepo, participantRepo, reservationClient)
 	participantService := participantservice.NewParticipantService(participantRepo)
 
-	eventPublisher := events.NewNATSEventPublisher(natsConn)
+	eventPublisher := events.NewOutboxEventPublisher(outboxRepo, sharedevents.NewCodec("session-svc"))
 
-	createSessionUseCase := sessionusecase.NewCreateSessionUseCase(sessionService, participantService, eventPublisher)
+	createSessionUseCase := sessionusecase.NewCreateSessionUseCase(sessionService, participantService, txManager, eventPublisher)
 	getSessionUseCase := sessionusecase.NewGetSessionUseCase(sessionService)
 	listOpenSessionsUseCase := sessionusecase.NewListOpenSessionsUseCase(sessionService)
 	listUserSessionsUseCase := sessionusecase.NewListUserSessionsUseCase(sessionService)
-	cancelSessionUseCase := sessionusecase.NewCancelSessionUseCase(sessionService, eventPublisher)
+	cancelSessionUseCase := sessionusecase.NewCancelSessionUseCase(sessionService, txManager, eventPublisher)
 
-	joinSessionUseCase := participantusecase.NewJoinSessionUseCase(sessionService, participantService, eventPublisher)
-	leaveSessionUseCase := participantusecase.NewLeaveSessionUseCase(sessionService, participantService, eventPublisher)
+	joinSessionUseCase := participantusecase.NewJoinSessionUseCase(sessionService, participantService, txManager, eventPublisher)
+	leaveSessionUseCase := participantusecase.NewLeaveSessionUseCase(sessionService, participantService, txManager, eventPublisher)
 	listSessionParticipantsUseCase := participantusecase.NewListSessionParticipantsUseCase(participantService)
 
 	sessionHandler := handler.NewSessionGRPCHandler(
@@ -80,7 +102,13 @@ func main() {
 		listSessionParticipantsUseCase,
 	)
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authz.UnaryServerInterceptor(
+		authz.WithServiceKey(
+			authz.NewJWKSVerifier(authz.NewHTTPKeySource(cfg.JWT.JWKSURL), cfg.JWT.Issuer, cfg.JWT.KeyCacheTTL),
+			cfg.JWT.ServiceKey,
+		),
+		handler.AccessPolicy,
+	)))
 
 	sessionv1.RegisterSessionServiceServer(grpcServer, sessionHandler)
 
@@ -100,11 +128,23 @@ func main() {
 		}
 	}()
 
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	outboxRelay := outbox.NewRelay(outboxRepo, js, outbox.RelayOptions{
+		PollInterval: cfg.OutboxConfig.PollInterval,
+		BatchSize:    cfg.OutboxConfig.BatchSize,
+		MaxBackoff:   cfg.OutboxConfig.MaxBackoff,
+		Retention:    cfg.OutboxConfig.Retention,
+		AckTimeout:   cfg.NATSConfig.Timeout,
+		ClaimTimeout: cfg.OutboxConfig.ClaimTimeout,
+	})
+	go outboxRelay.Run(relayCtx)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopRelay()
 	grpcServer.GracefulStop()
 	log.Println("Server stopped")
 }
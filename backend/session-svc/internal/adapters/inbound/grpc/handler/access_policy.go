@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/diploma/authz"
+	sessionv1 "github.com/diploma/session-svc/api/v1"
+)
+
+// AccessPolicy gates each RPC by permission; handlers taking a user_id or host_id must also
+// check it against the caller with authz.AuthorizeUser
+var AccessPolicy = authz.Policy{
+	sessionv1.SessionService_GetSession_FullMethodName:              authz.Public(),
+	sessionv1.SessionService_ListOpenSessions_FullMethodName:        authz.Public(),
+	sessionv1.SessionService_ListSessionParticipants_FullMethodName: authz.Public(),
+	// A user's sessions include private ones; the calendar feed reads them with the internal service key
+	sessionv1.SessionService_ListUserSessions_FullMethodName: authz.Authenticated(),
+
+	sessionv1.SessionService_CreateSession_FullMethodName: authz.Require(authz.PermSessionHost).WithVerifiedEmail(),
+	sessionv1.SessionService_CancelSession_FullMethodName: authz.Require(authz.PermSessionHost),
+	sessionv1.SessionService_JoinSession_FullMethodName:   authz.Require(authz.PermSessionJoin),
+	sessionv1.SessionService_LeaveSession_FullMethodName:  authz.Require(authz.PermSessionJoin),
+}
@@ -2,10 +2,16 @@ package handler
 
 import (
 	"context"
+	"time"
 
+	"github.com/diploma/authz"
 	sessionv1 "github.com/diploma/session-svc/api/v1"
+	participantdto "github.com/diploma/session-svc/internal/application/participant/dto"
 	participantusecase "github.com/diploma/session-svc/internal/application/participant/usecase"
+	sessiondto "github.com/diploma/session-svc/internal/application/session/dto"
 	sessionusecase "github.com/diploma/session-svc/internal/application/session/usecase"
+	pkgerrors "github.com/diploma/session-svc/pkg/errors"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -49,7 +55,40 @@ func (h *SessionGRPCHandler) CreateSession(ctx context.Context, req *sessionv1.C
 }
 
 func (h *SessionGRPCHandler) GetSession(ctx context.Context, req *sessionv1.GetSessionRequest) (*sessionv1.GetSessionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session_id: %v", err)
+	}
+
+	output, err := h.getSessionUseCase.Execute(ctx, sessiondto.GetSessionInput{
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	return toGetSessionResponse(*output), nil
+}
+
+func toGetSessionResponse(output sessiondto.GetSessionOutput) *sessionv1.GetSessionResponse {
+	return &sessionv1.GetSessionResponse{
+		Id:                  output.ID.String(),
+		ReservationId:       output.ReservationID.String(),
+		HostId:              output.HostID.String(),
+		SportType:           output.SportType,
+		SkillLevel:          output.SkillLevel,
+		MaxParticipants:     int32(output.MaxParticipants),
+		MinParticipants:     int32(output.MinParticipants),
+		CurrentParticipants: int32(output.CurrentParticipants),
+		PricePerParticipant: output.PricePerParticipant,
+		Visibility:          sessionv1.SessionVisibility(sessionv1.SessionVisibility_value["SESSION_VISIBILITY_"+string(output.Visibility)]),
+		Status:              sessionv1.SessionStatus(sessionv1.SessionStatus_value["SESSION_STATUS_"+string(output.Status)]),
+		Description:         output.Description,
+		CreatedAt:           output.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           output.UpdatedAt.Format(time.RFC3339),
+		StartTime:           output.StartTime.Format(time.RFC3339),
+		EndTime:             output.EndTime.Format(time.RFC3339),
+	}
 }
 
 func (h *SessionGRPCHandler) ListOpenSessions(ctx context.Context, req *sessionv1.ListOpenSessionsRequest) (*sessionv1.ListOpenSessionsResponse, error) {
@@ -57,7 +96,32 @@ func (h *SessionGRPCHandler) ListOpenSessions(ctx context.Context, req *sessionv
 }
 
 func (h *SessionGRPCHandler) ListUserSessions(ctx context.Context, req *sessionv1.ListUserSessionsRequest) (*sessionv1.ListUserSessionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	if err := authz.AuthorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	output, err := h.listUserSessionsUseCase.Execute(ctx, sessiondto.ListUserSessionsInput{
+		UserID:   userID,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	items := make([]*sessionv1.GetSessionResponse, len(output.Items))
+	for i, item := range output.Items {
+		items[i] = toGetSessionResponse(item)
+	}
+
+	return &sessionv1.ListUserSessionsResponse{
+		Items:      items,
+		TotalCount: int32(output.TotalCount),
+	}, nil
 }
 
 func (h *SessionGRPCHandler) JoinSession(ctx context.Context, req *sessionv1.JoinSessionRequest) (*sessionv1.JoinSessionResponse, error) {
@@ -73,6 +137,50 @@ func (h *SessionGRPCHandler) CancelSession(ctx context.Context, req *sessionv1.C
 }
 
 func (h *SessionGRPCHandler) ListSessionParticipants(ctx context.Context, req *sessionv1.ListSessionParticipantsRequest) (*sessionv1.ListSessionParticipantsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session_id: %v", err)
+	}
+
+	output, err := h.listSessionParticipantsUseCase.Execute(ctx, participantdto.ListSessionParticipantsInput{
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	participants := make([]*sessionv1.Participant, len(output.Participants))
+	for i, participant := range output.Participants {
+		participants[i] = &sessionv1.Participant{
+			Id:       participant.ID.String(),
+			UserId:   participant.UserID.String(),
+			Role:     sessionv1.ParticipantRole(sessionv1.ParticipantRole_value["PARTICIPANT_ROLE_"+string(participant.Role)]),
+			Status:   sessionv1.ParticipantStatus(sessionv1.ParticipantStatus_value["PARTICIPANT_STATUS_"+string(participant.Status)]),
+			JoinedAt: participant.JoinedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &sessionv1.ListSessionParticipantsResponse{
+		Participants: participants,
+	}, nil
+}
+
+func mapErrorToGRPCStatus(err error) error {
+	switch pkgerrors.GetErrorCode(err) {
+	case pkgerrors.CodeNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case pkgerrors.CodeAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case pkgerrors.CodeInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case pkgerrors.CodeFailedPrecondition:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case pkgerrors.CodePermissionDenied:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case pkgerrors.CodeResourceExhausted:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
 }
 
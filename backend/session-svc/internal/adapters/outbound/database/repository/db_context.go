@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/diploma/events/outbox"
+	"gorm.io/gorm"
+)
+
+// dbFromContext joins the transaction outbox.TransactionManager carries in ctx, if any
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return outbox.DB(ctx, db)
+}
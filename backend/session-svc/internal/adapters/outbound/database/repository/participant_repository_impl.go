@@ -19,7 +19,7 @@ func NewParticipantRepository(db *gorm.DB) *ParticipantRepositoryImpl {
 }
 
 func (r *ParticipantRepositoryImpl) Create(ctx context.Context, participant *entity.Participant) error {
-	result := r.db.WithContext(ctx).Create(participant)
+	result := dbFromContext(ctx, r.db).Create(participant)
 	if result.Error != nil {
 		return pkgerrors.NewInternalError("failed to create participant", result.Error)
 	}
@@ -28,7 +28,7 @@ func (r *ParticipantRepositoryImpl) Create(ctx context.Context, participant *ent
 
 func (r *ParticipantRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error) {
 	var p entity.Participant
-	result := r.db.WithContext(ctx).Where("id = ?", id).First(&p)
+	result := dbFromContext(ctx, r.db).Where("id = ?", id).First(&p)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -41,7 +41,7 @@ func (r *ParticipantRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (
 
 func (r *ParticipantRepositoryImpl) GetBySessionAndUser(ctx context.Context, sessionID, userID uuid.UUID) (*entity.Participant, error) {
 	var p entity.Participant
-	result := r.db.WithContext(ctx).Where("session_id = ? AND user_id = ?", sessionID, userID).Order("joined_at DESC").First(&p)
+	result := dbFromContext(ctx, r.db).Where("session_id = ? AND user_id = ?", sessionID, userID).Order("joined_at DESC").First(&p)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -54,7 +54,7 @@ func (r *ParticipantRepositoryImpl) GetBySessionAndUser(ctx context.Context, ses
 
 func (r *ParticipantRepositoryImpl) ListBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*entity.Participant, error) {
 	var participants []*entity.Participant
-	result := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("joined_at").Find(&participants)
+	result := dbFromContext(ctx, r.db).Where("session_id = ?", sessionID).Order("joined_at").Find(&participants)
 
 	if result.Error != nil {
 		return nil, pkgerrors.NewInternalError("failed to list participants", result.Error)
@@ -64,7 +64,7 @@ func (r *ParticipantRepositoryImpl) ListBySessionID(ctx context.Context, session
 
 func (r *ParticipantRepositoryImpl) ListActiveBySessionID(ctx context.Context, sessionID uuid.UUID) ([]*entity.Participant, error) {
 	var participants []*entity.Participant
-	result := r.db.WithContext(ctx).Where("session_id = ? AND status = ?", sessionID, "JOINED").Order("joined_at").Find(&participants)
+	result := dbFromContext(ctx, r.db).Where("session_id = ? AND status = ?", sessionID, "JOINED").Order("joined_at").Find(&participants)
 
 	if result.Error != nil {
 		return nil, pkgerrors.NewInternalError("failed to list active participants", result.Error)
@@ -74,7 +74,7 @@ func (r *ParticipantRepositoryImpl) ListActiveBySessionID(ctx context.Context, s
 
 func (r *ParticipantRepositoryImpl) CountActiveBySessionID(ctx context.Context, sessionID uuid.UUID) (int, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(&entity.Participant{}).Where("session_id = ? AND status = ?", sessionID, "JOINED").Count(&count)
+	result := dbFromContext(ctx, r.db).Model(&entity.Participant{}).Where("session_id = ? AND status = ?", sessionID, "JOINED").Count(&count)
 
 	if result.Error != nil {
 		return 0, pkgerrors.NewInternalError("failed to count participants", result.Error)
@@ -83,7 +83,7 @@ func (r *ParticipantRepositoryImpl) CountActiveBySessionID(ctx context.Context,
 }
 
 func (r *ParticipantRepositoryImpl) Update(ctx context.Context, participant *entity.Participant) error {
-	result := r.db.WithContext(ctx).Model(&entity.Participant{}).Where("id = ?", participant.ID).Update("status", participant.Status)
+	result := dbFromContext(ctx, r.db).Model(&entity.Participant{}).Where("id = ?", participant.ID).Update("status", participant.Status)
 
 	if result.Error != nil {
 		return pkgerrors.NewInternalError("failed to update participant", result.Error)
@@ -95,7 +95,7 @@ func (r *ParticipantRepositoryImpl) Update(ctx context.Context, participant *ent
 }
 
 func (r *ParticipantRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&entity.Participant{})
+	result := dbFromContext(ctx, r.db).Where("id = ?", id).Delete(&entity.Participant{})
 
 	if result.Error != nil {
 		return pkgerrors.NewInternalError("failed to delete participant", result.Error)
@@ -22,7 +22,7 @@ func NewSessionRepository(db *gorm.DB) *SessionRepositoryImpl {
 }
 
 func (r *SessionRepositoryImpl) Create(ctx context.Context, session *entity.Session) error {
-	result := r.db.WithContext(ctx).Create(session)
+	result := dbFromContext(ctx, r.db).Create(session)
 	if result.Error != nil {
 		return pkgerrors.NewInternalError("failed to create session", result.Error)
 	}
@@ -32,7 +32,7 @@ func (r *SessionRepositoryImpl) Create(ctx context.Context, session *entity.Sess
 
 func (r *SessionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
 	var session entity.Session
-	result := r.db.WithContext(ctx).Where("id = ?", id).First(&session)
+	result := dbFromContext(ctx, r.db).Where("id = ?", id).First(&session)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -46,7 +46,7 @@ func (r *SessionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent
 
 func (r *SessionRepositoryImpl) GetByReservationID(ctx context.Context, reservationID uuid.UUID) (*entity.Session, error) {
 	var session entity.Session
-	result := r.db.WithContext(ctx).Where("reservation_id = ?", reservationID).First(&session)
+	result := dbFromContext(ctx, r.db).Where("reservation_id = ?", reservationID).First(&session)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -60,7 +60,7 @@ func (r *SessionRepositoryImpl) GetByReservationID(ctx context.Context, reservat
 
 func (r *SessionRepositoryImpl) ListOpen(ctx context.Context, sportType, skillLevel string, offset, limit int) ([]*entity.Session, int, error) {
 	var totalCount int64
-	query := r.db.WithContext(ctx).Model(&entity.Session{}).Where("status IN (?, ?) AND visibility = ?", "OPEN", "FULL", "PUBLIC")
+	query := dbFromContext(ctx, r.db).Model(&entity.Session{}).Where("status IN (?, ?) AND visibility = ?", "OPEN", "FULL", "PUBLIC")
 
 	if sportType != "" {
 		query = query.Where("sport_type = ?", sportType)
@@ -85,7 +85,7 @@ func (r *SessionRepositoryImpl) ListOpen(ctx context.Context, sportType, skillLe
 
 func (r *SessionRepositoryImpl) ListByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*entity.Session, int, error) {
 	var totalCount int64
-	countQuery := r.db.WithContext(ctx).Table("sessions s").
+	countQuery := dbFromContext(ctx, r.db).Table("sessions s").
 		Joins("INNER JOIN session_participants sp ON s.id = sp.session_id").
 		Where("sp.user_id = ? AND sp.status = ?", userID, "JOINED")
 
@@ -94,7 +94,7 @@ func (r *SessionRepositoryImpl) ListByUserID(ctx context.Context, userID uuid.UU
 	}
 
 	var sessions []*entity.Session
-	result := r.db.WithContext(ctx).Table("sessions s").
+	result := dbFromContext(ctx, r.db).Table("sessions s").
 		Select("DISTINCT s.*").
 		Joins("INNER JOIN session_participants sp ON s.id = sp.session_id").
 		Where("sp.user_id = ? AND sp.status = ?", userID, "JOINED").
@@ -111,7 +111,7 @@ func (r *SessionRepositoryImpl) ListByUserID(ctx context.Context, userID uuid.UU
 }
 
 func (r *SessionRepositoryImpl) Update(ctx context.Context, session *entity.Session) error {
-	result := r.db.WithContext(ctx).Model(&entity.Session{}).Where("id = ?", session.ID).Updates(map[string]interface{}{
+	result := dbFromContext(ctx, r.db).Model(&entity.Session{}).Where("id = ?", session.ID).Updates(map[string]interface{}{
 		"sport_type":            session.SportType,
 		"skill_level":           session.SkillLevel,
 		"max_participants":      session.MaxParticipants,
@@ -135,7 +135,7 @@ func (r *SessionRepositoryImpl) Update(ctx context.Context, session *entity.Sess
 }
 
 func (r *SessionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&entity.Session{})
+	result := dbFromContext(ctx, r.db).Where("id = ?", id).Delete(&entity.Session{})
 
 	if result.Error != nil {
 		return pkgerrors.NewInternalError("failed to delete session", result.Error)
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sharedevents "github.com/diploma/events"
+	"github.com/diploma/events/outbox"
+	"github.com/google/uuid"
+)
+
+// OutboxEventPublisher stores events in the outbox within the caller's transaction;
+// outbox.Relay delivers them to NATS once the transaction has committed
+type OutboxEventPublisher struct {
+	outbox outbox.Repository
+	codec  *sharedevents.Codec
+}
+
+func NewOutboxEventPublisher(outbox outbox.Repository, codec *sharedevents.Codec) *OutboxEventPublisher {
+	return &OutboxEventPublisher{outbox: outbox, codec: codec}
+}
+
+func (p *OutboxEventPublisher) PublishSessionCreated(ctx context.Context, sessionID, reservationID, hostID uuid.UUID, startTime, endTime time.Time) error {
+	return p.publish(ctx, sharedevents.SubjectSessionCreated, sharedevents.SessionCreated{
+		SessionID:     sessionID.String(),
+		ReservationID: reservationID.String(),
+		HostID:        hostID.String(),
+		StartTime:     startTime.UTC(),
+		EndTime:       endTime.UTC(),
+	})
+}
+
+func (p *OutboxEventPublisher) PublishSessionJoined(ctx context.Context, sessionID, userID uuid.UUID, currentParticipants int) error {
+	return p.publish(ctx, sharedevents.SubjectSessionJoined, sharedevents.SessionJoined{
+		SessionID:           sessionID.String(),
+		UserID:              userID.String(),
+		CurrentParticipants: currentParticipants,
+	})
+}
+
+func (p *OutboxEventPublisher) PublishSessionFull(ctx context.Context, sessionID uuid.UUID) error {
+	return p.publish(ctx, sharedevents.SubjectSessionFull, sharedevents.SessionFull{SessionID: sessionID.String()})
+}
+
+func (p *OutboxEventPublisher) PublishSessionCancelled(ctx context.Context, sessionID uuid.UUID) error {
+	return p.publish(ctx, sharedevents.SubjectSessionCancelled, sharedevents.SessionCancelled{SessionID: sessionID.String()})
+}
+
+func (p *OutboxEventPublisher) PublishSessionLeft(ctx context.Context, sessionID, userID uuid.UUID) error {
+	return p.publish(ctx, sharedevents.SubjectSessionLeft, sharedevents.SessionLeft{
+		SessionID: sessionID.String(),
+		UserID:    userID.String(),
+	})
+}
+
+func (p *OutboxEventPublisher) publish(ctx context.Context, subject string, payload interface{}) error {
+	envelope, err := p.codec.NewEnvelope(ctx, subject, payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return p.outbox.Add(ctx, outbox.NewMessage(envelope.ID, subject, data))
+}
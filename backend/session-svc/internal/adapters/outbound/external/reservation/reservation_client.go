@@ -0,0 +1,68 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/diploma/authz"
+	reservationv1 "github.com/diploma/session-svc/api/proto/reservation/v1"
+	"github.com/diploma/session-svc/internal/domain/session/port"
+	pkgerrors "github.com/diploma/session-svc/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type ReservationClient struct {
+	client reservationv1.ReservationServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewReservationClient authenticates with the internal service key, since reservations are read
+// on behalf of users who are not part of the call
+func NewReservationClient(address, serviceKey string) (*ReservationClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(authz.ServiceKeyInterceptor(serviceKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservationClient{
+		client: reservationv1.NewReservationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *ReservationClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *ReservationClient) GetReservationWindow(ctx context.Context, reservationID uuid.UUID) (*port.ReservationWindow, error) {
+	resp, err := c.client.GetReservation(ctx, &reservationv1.GetReservationRequest{ReservationId: reservationID.String()})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, pkgerrors.NewNotFoundError("reservation not found")
+		case codes.InvalidArgument:
+			return nil, pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+		default:
+			return nil, pkgerrors.NewInternalError("failed to get reservation", err)
+		}
+	}
+
+	start, err := time.Parse(time.RFC3339, resp.StartTime)
+	if err != nil {
+		return nil, pkgerrors.NewInternalError("invalid reservation start_time", err)
+	}
+	end, err := time.Parse(time.RFC3339, resp.EndTime)
+	if err != nil {
+		return nil, pkgerrors.NewInternalError("invalid reservation end_time", err)
+	}
+
+	return &port.ReservationWindow{
+		Status:    resp.Status,
+		StartTime: start,
+		EndTime:   end,
+	}, nil
+}
@@ -13,56 +13,70 @@ import (
 type JoinSessionUseCase struct {
 	sessionService     *sessionService.SessionService
 	participantService *participantService.ParticipantService
+	txManager          sessionUsecase.TransactionManager
 	eventPublisher     sessionUsecase.EventPublisher
 }
 
 func NewJoinSessionUseCase(
 	sessionService *sessionService.SessionService,
 	participantService *participantService.ParticipantService,
+	txManager sessionUsecase.TransactionManager,
 	eventPublisher sessionUsecase.EventPublisher,
 ) *JoinSessionUseCase {
 	return &JoinSessionUseCase{
 		sessionService:     sessionService,
 		participantService: participantService,
+		txManager:          txManager,
 		eventPublisher:     eventPublisher,
 	}
 }
 
 func (uc *JoinSessionUseCase) Execute(ctx context.Context, input participantDto.JoinSessionInput) (*participantDto.JoinSessionOutput, error) {
-	session, err := uc.sessionService.GetSession(ctx, input.SessionID)
-	if err != nil {
-		return nil, err
-	}
+	var participant *participantEntity.Participant
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		session, err := uc.sessionService.GetSession(ctx, input.SessionID)
+		if err != nil {
+			return err
+		}
 
-	if err := session.CanAddParticipant(); err != nil {
-		return nil, err
-	}
+		if err := session.CanAddParticipant(); err != nil {
+			return err
+		}
 
-	participant, err := uc.participantService.AddParticipant(
-		ctx,
-		input.SessionID,
-		input.UserID,
-		participantEntity.ParticipantRolePlayer,
-	)
-	if err != nil {
-		return nil, err
-	}
+		participant, err = uc.participantService.AddParticipant(
+			ctx,
+			input.SessionID,
+			input.UserID,
+			participantEntity.ParticipantRolePlayer,
+		)
+		if err != nil {
+			return err
+		}
 
-	if err := uc.sessionService.UpdateSessionParticipantCount(ctx, input.SessionID); err != nil {
-		return nil, err
-	}
+		if err := uc.sessionService.UpdateSessionParticipantCount(ctx, input.SessionID); err != nil {
+			return err
+		}
 
-	session, err = uc.sessionService.GetSession(ctx, input.SessionID)
-	if err != nil {
-		return nil, err
-	}
+		session, err = uc.sessionService.GetSession(ctx, input.SessionID)
+		if err != nil {
+			return err
+		}
+
+		if uc.eventPublisher == nil {
+			return nil
+		}
+
+		if err := uc.eventPublisher.PublishSessionJoined(ctx, input.SessionID, input.UserID, session.CurrentParticipants); err != nil {
+			return err
+		}
 
-	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishSessionJoined(ctx, input.SessionID, input.UserID, session.CurrentParticipants)
-		
 		if session.IsFull() {
-			_ = uc.eventPublisher.PublishSessionFull(ctx, input.SessionID)
+			return uc.eventPublisher.PublishSessionFull(ctx, input.SessionID)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &participantDto.JoinSessionOutput{
@@ -12,35 +12,43 @@ import (
 type LeaveSessionUseCase struct {
 	sessionService     *sessionService.SessionService
 	participantService *participantService.ParticipantService
+	txManager          sessionUsecase.TransactionManager
 	eventPublisher     sessionUsecase.EventPublisher
 }
 
 func NewLeaveSessionUseCase(
 	sessionService *sessionService.SessionService,
 	participantService *participantService.ParticipantService,
+	txManager sessionUsecase.TransactionManager,
 	eventPublisher sessionUsecase.EventPublisher,
 ) *LeaveSessionUseCase {
 	return &LeaveSessionUseCase{
 		sessionService:     sessionService,
 		participantService: participantService,
+		txManager:          txManager,
 		eventPublisher:     eventPublisher,
 	}
 }
 
 func (uc *LeaveSessionUseCase) Execute(ctx context.Context, input participantDto.LeaveSessionInput) (*participantDto.LeaveSessionOutput, error) {
-	err := uc.participantService.RemoveParticipant(ctx, input.SessionID, input.UserID)
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.participantService.RemoveParticipant(ctx, input.SessionID, input.UserID); err != nil {
+			return err
+		}
+
+		if err := uc.sessionService.UpdateSessionParticipantCount(ctx, input.SessionID); err != nil {
+			return err
+		}
+
+		if uc.eventPublisher != nil {
+			return uc.eventPublisher.PublishSessionLeft(ctx, input.SessionID, input.UserID)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := uc.sessionService.UpdateSessionParticipantCount(ctx, input.SessionID); err != nil {
-		return nil, err
-	}
-
-	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishSessionLeft(ctx, input.SessionID, input.UserID)
-	}
-
 	return &participantDto.LeaveSessionOutput{
 		Success: true,
 	}, nil
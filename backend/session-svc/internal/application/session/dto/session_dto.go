@@ -40,6 +40,8 @@ type GetSessionOutput struct {
 	Visibility          sessionEntity.SessionVisibility
 	Status              sessionEntity.SessionStatus
 	Description         string
+	StartTime           time.Time
+	EndTime             time.Time
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
@@ -90,6 +92,8 @@ func ToSessionOutput(session *sessionEntity.Session) GetSessionOutput {
 		Visibility:          session.Visibility,
 		Status:              session.Status,
 		Description:         session.Description,
+		StartTime:           session.StartTime,
+		EndTime:             session.EndTime,
 		CreatedAt:           session.CreatedAt,
 		UpdatedAt:           session.UpdatedAt,
 	}
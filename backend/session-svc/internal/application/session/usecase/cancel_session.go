@@ -9,26 +9,33 @@ import (
 
 type CancelSessionUseCase struct {
 	sessionService *service.SessionService
+	txManager      TransactionManager
 	eventPublisher EventPublisher
 }
 
-func NewCancelSessionUseCase(sessionService *service.SessionService, eventPublisher EventPublisher) *CancelSessionUseCase {
+func NewCancelSessionUseCase(sessionService *service.SessionService, txManager TransactionManager, eventPublisher EventPublisher) *CancelSessionUseCase {
 	return &CancelSessionUseCase{
 		sessionService: sessionService,
+		txManager:      txManager,
 		eventPublisher: eventPublisher,
 	}
 }
 
 func (uc *CancelSessionUseCase) Execute(ctx context.Context, input dto.CancelSessionInput) (*dto.CancelSessionOutput, error) {
-	err := uc.sessionService.CancelSession(ctx, input.SessionID, input.UserID)
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.sessionService.CancelSession(ctx, input.SessionID, input.UserID); err != nil {
+			return err
+		}
+
+		if uc.eventPublisher != nil {
+			return uc.eventPublisher.PublishSessionCancelled(ctx, input.SessionID)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishSessionCancelled(ctx, input.SessionID)
-	}
-
 	return &dto.CancelSessionOutput{
 		Success: true,
 	}, nil
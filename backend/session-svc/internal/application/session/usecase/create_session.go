@@ -5,6 +5,7 @@ import (
 
 	participantEntity "github.com/diploma/session-svc/internal/domain/participant/entity"
 	participantService "github.com/diploma/session-svc/internal/domain/participant/service"
+	"github.com/diploma/session-svc/internal/domain/session/entity"
 	"github.com/diploma/session-svc/internal/domain/session/service"
 	"github.com/diploma/session-svc/internal/application/session/dto"
 )
@@ -12,47 +13,57 @@ import (
 type CreateSessionUseCase struct {
 	sessionService     *service.SessionService
 	participantService *participantService.ParticipantService
+	txManager          TransactionManager
 	eventPublisher     EventPublisher
 }
 
 func NewCreateSessionUseCase(
 	sessionService *service.SessionService,
 	participantService *participantService.ParticipantService,
+	txManager TransactionManager,
 	eventPublisher EventPublisher,
 ) *CreateSessionUseCase {
 	return &CreateSessionUseCase{
 		sessionService:     sessionService,
 		participantService: participantService,
+		txManager:          txManager,
 		eventPublisher:     eventPublisher,
 	}
 }
 
 func (uc *CreateSessionUseCase) Execute(ctx context.Context, input dto.CreateSessionInput) (*dto.CreateSessionOutput, error) {
-	session, err := uc.sessionService.CreateSession(
-		ctx,
-		input.ReservationID,
-		input.HostID,
-		input.SportType,
-		input.SkillLevel,
-		input.MaxParticipants,
-		input.MinParticipants,
-		input.PricePerParticipant,
-		input.Visibility,
-		input.Description,
-	)
-	if err != nil {
-		return nil, err
-	}
+	var session *entity.Session
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		session, err = uc.sessionService.CreateSession(
+			ctx,
+			input.ReservationID,
+			input.HostID,
+			input.SportType,
+			input.SkillLevel,
+			input.MaxParticipants,
+			input.MinParticipants,
+			input.PricePerParticipant,
+			input.Visibility,
+			input.Description,
+		)
+		if err != nil {
+			return err
+		}
 
-	_, err = uc.participantService.AddParticipant(ctx, session.ID, input.HostID, participantEntity.ParticipantRoleHost)
+		if _, err := uc.participantService.AddParticipant(ctx, session.ID, input.HostID, participantEntity.ParticipantRoleHost); err != nil {
+			return err
+		}
+
+		if uc.eventPublisher != nil {
+			return uc.eventPublisher.PublishSessionCreated(ctx, session.ID, session.ReservationID, session.HostID, session.StartTime, session.EndTime)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if uc.eventPublisher != nil {
-		_ = uc.eventPublisher.PublishSessionCreated(ctx, session.ID, session.ReservationID, session.HostID)
-	}
-
 	return &dto.CreateSessionOutput{
 		SessionID: session.ID,
 	}, nil
@@ -2,12 +2,13 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type EventPublisher interface {
-	PublishSessionCreated(ctx context.Context, sessionID, reservationID, hostID uuid.UUID) error
+	PublishSessionCreated(ctx context.Context, sessionID, reservationID, hostID uuid.UUID, startTime, endTime time.Time) error
 	PublishSessionJoined(ctx context.Context, sessionID, userID uuid.UUID, currentParticipants int) error
 	PublishSessionFull(ctx context.Context, sessionID uuid.UUID) error
 	PublishSessionCancelled(ctx context.Context, sessionID uuid.UUID) error
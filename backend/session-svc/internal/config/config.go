@@ -3,14 +3,28 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	GRPCPort   string
-	DBConfig   DatabaseConfig
-	NATSConfig NATSConfig
+	GRPCPort              string
+	DBConfig              DatabaseConfig
+	NATSConfig            NATSConfig
+	OutboxConfig          OutboxConfig
+	ReservationServiceURL string
+	JWT                   JWTConfig
+}
+
+// JWTConfig locates the public keys auth-svc signs access tokens with
+type JWTConfig struct {
+	JWKSURL     string
+	Issuer      string
+	KeyCacheTTL time.Duration
+	// ServiceKey is the shared internal key for calls made without a user's token, in both directions
+	ServiceKey string
 }
 
 type DatabaseConfig struct {
@@ -23,7 +37,17 @@ type DatabaseConfig struct {
 }
 
 type NATSConfig struct {
-	URL string
+	URL          string
+	Timeout      time.Duration
+	StreamMaxAge time.Duration
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxBackoff   time.Duration
+	Retention    time.Duration
+	ClaimTimeout time.Duration
 }
 
 func Load() (*Config, error) {
@@ -40,7 +64,23 @@ func Load() (*Config, error) {
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
 		},
 		NATSConfig: NATSConfig{
-			URL: getEnv("NATS_URL", "nats://localhost:4222"),
+			URL:          getEnv("NATS_URL", "nats://localhost:4222"),
+			Timeout:      getEnvAsDuration("NATS_TIMEOUT", 5*time.Second),
+			StreamMaxAge: getEnvAsDuration("EVENT_STREAM_MAX_AGE", 7*24*time.Hour),
+		},
+		OutboxConfig: OutboxConfig{
+			PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", 1*time.Second),
+			BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+			MaxBackoff:   getEnvAsDuration("OUTBOX_MAX_BACKOFF", 1*time.Minute),
+			Retention:    getEnvAsDuration("OUTBOX_RETENTION", 24*time.Hour),
+			ClaimTimeout: getEnvAsDuration("OUTBOX_CLAIM_TIMEOUT", 5*time.Minute),
+		},
+		ReservationServiceURL: getEnv("RESERVATION_SERVICE_URL", "localhost:50052"),
+		JWT: JWTConfig{
+			JWKSURL:     getEnv("JWKS_URL", "http://localhost:8080/.well-known/jwks.json"),
+			Issuer:      getEnv("JWT_ISSUER", "auth-svc"),
+			KeyCacheTTL: getEnvAsDuration("JWT_KEY_CACHE_TTL", 10*time.Minute),
+			ServiceKey:  getEnv("INTERNAL_SERVICE_KEY", ""),
 		},
 	}
 
@@ -66,3 +106,18 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsInt(key string, defaultValue int) int {
+	valueStr := os.Getenv(key)
+	if value, err := strconv.Atoi(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if value, err := time.ParseDuration(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
@@ -25,20 +25,22 @@ const (
 )
 
 type Session struct {
-	ID                   uuid.UUID
-	ReservationID        uuid.UUID
-	HostID               uuid.UUID
-	SportType            string
-	SkillLevel           string // beginner, intermediate, advanced
-	MaxParticipants      int
-	MinParticipants      int
-	CurrentParticipants  int
-	PricePerParticipant  float64
-	Visibility           SessionVisibility
-	Status               SessionStatus
-	Description          string
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
+	ID                  uuid.UUID
+	ReservationID       uuid.UUID
+	HostID              uuid.UUID
+	SportType           string
+	SkillLevel          string // beginner, intermediate, advanced
+	MaxParticipants     int
+	MinParticipants     int
+	CurrentParticipants int
+	PricePerParticipant float64
+	Visibility          SessionVisibility
+	Status              SessionStatus
+	Description         string
+	StartTime           time.Time
+	EndTime             time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 func (s *Session) IsValid() error {
@@ -63,6 +65,9 @@ func (s *Session) IsValid() error {
 	if s.PricePerParticipant < 0 {
 		return pkgerrors.NewInvalidArgumentError("price_per_participant must be non-negative")
 	}
+	if s.StartTime.IsZero() || !s.EndTime.After(s.StartTime) {
+		return pkgerrors.NewInvalidArgumentError("session must have a valid start and end time")
+	}
 	return nil
 }
 
@@ -176,4 +181,3 @@ func (s *Session) IsFull() bool {
 func (s *Session) IsOpen() bool {
 	return s.Status == SessionStatusOpen || s.Status == SessionStatusFull
 }
-
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReservationWindow struct {
+	Status    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type ReservationProvider interface {
+	GetReservationWindow(ctx context.Context, reservationID uuid.UUID) (*ReservationWindow, error)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	participantPort "github.com/diploma/session-svc/internal/domain/participant/port"
 	"github.com/diploma/session-svc/internal/domain/session/entity"
@@ -14,12 +15,14 @@ import (
 type SessionService struct {
 	sessionRepo     port.SessionRepository
 	participantRepo participantPort.ParticipantRepository
+	reservations    port.ReservationProvider
 }
 
-func NewSessionService(sessionRepo port.SessionRepository, participantRepo participantPort.ParticipantRepository) *SessionService {
+func NewSessionService(sessionRepo port.SessionRepository, participantRepo participantPort.ParticipantRepository, reservations port.ReservationProvider) *SessionService {
 	return &SessionService{
 		sessionRepo:     sessionRepo,
 		participantRepo: participantRepo,
+		reservations:    reservations,
 	}
 }
 
@@ -37,6 +40,17 @@ func (s *SessionService) CreateSession(
 		return nil, pkgerrors.NewAlreadyExistsError("session already exists for this reservation")
 	}
 
+	window, err := s.reservations.GetReservationWindow(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	if window.Status == "CANCELLED" || window.Status == "EXPIRED" {
+		return nil, pkgerrors.NewFailedPreconditionError("reservation is no longer active")
+	}
+	if !window.StartTime.After(time.Now()) {
+		return nil, pkgerrors.NewFailedPreconditionError("reservation has already started")
+	}
+
 	session := &entity.Session{
 		ID:                  uuid.New(),
 		ReservationID:       reservationID,
@@ -50,6 +64,8 @@ func (s *SessionService) CreateSession(
 		Visibility:          visibility,
 		Status:              entity.SessionStatusOpen,
 		Description:         description,
+		StartTime:           window.StartTime,
+		EndTime:             window.EndTime,
 	}
 
 	if err := session.IsValid(); err != nil {
@@ -0,0 +1,24 @@
+-- Transactional outbox: events are written with the session change and relayed to NATS afterwards
+
+CREATE TABLE IF NOT EXISTS session_outbox (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    subject VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+-- Supports the relay's "oldest due messages first" scan
+CREATE INDEX IF NOT EXISTS idx_session_outbox_pending
+    ON session_outbox(next_attempt_at)
+    WHERE sent_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_session_outbox_sent_at
+    ON session_outbox(sent_at)
+    WHERE sent_at IS NOT NULL;
+
+COMMENT ON TABLE session_outbox IS 'Session events awaiting delivery to NATS';
@@ -0,0 +1,21 @@
+-- Sessions carry the time window of their reservation so reminders can be scheduled
+
+ALTER TABLE sessions
+    ADD COLUMN start_time TIMESTAMPTZ,
+    ADD COLUMN end_time TIMESTAMPTZ;
+
+-- Legacy rows predate the copy; anchor them at creation so they never look upcoming
+UPDATE sessions
+SET start_time = created_at,
+    end_time = created_at + INTERVAL '1 hour'
+WHERE start_time IS NULL;
+
+ALTER TABLE sessions
+    ALTER COLUMN start_time SET NOT NULL,
+    ALTER COLUMN end_time SET NOT NULL,
+    ADD CONSTRAINT sessions_time_range_check CHECK (end_time > start_time);
+
+CREATE INDEX idx_sessions_start_time ON sessions(start_time);
+
+COMMENT ON COLUMN sessions.start_time IS 'Start of the reserved window, copied from reservation-svc';
+COMMENT ON COLUMN sessions.end_time IS 'End of the reserved window, copied from reservation-svc';
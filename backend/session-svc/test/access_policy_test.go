@@ -0,0 +1,53 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diploma/authz"
+	sessionv1 "github.com/diploma/session-svc/api/v1"
+	"github.com/diploma/session-svc/internal/adapters/inbound/grpc/handler"
+	sessionusecase "github.com/diploma/session-svc/internal/application/session/usecase"
+	"github.com/diploma/session-svc/internal/domain/session/service"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessPolicy_CoversEveryMethod(t *testing.T) {
+	for _, method := range sessionv1.SessionService_ServiceDesc.Methods {
+		fullMethod := "/" + sessionv1.SessionService_ServiceDesc.ServiceName + "/" + method.MethodName
+		if _, ok := handler.AccessPolicy[fullMethod]; !ok {
+			t.Errorf("Expected an access rule for %s", fullMethod)
+		}
+	}
+
+	// Hosting and joining are open to every role
+	for _, role := range []authz.Role{authz.RolePlayer, authz.RoleVenueOwner, authz.RoleAdmin} {
+		if !role.Can(authz.PermSessionHost) || !role.Can(authz.PermSessionJoin) {
+			t.Errorf("Expected %s to host and join sessions", role)
+		}
+	}
+}
+
+func TestListUserSessions_RejectsOtherUsers(t *testing.T) {
+	svc := service.NewSessionService(NewMockSessionRepo(), NewMockParticipantRepo(), NewStubReservationProvider(time.Now().Add(48*time.Hour)))
+	h := handler.NewSessionGRPCHandler(nil, nil, nil, sessionusecase.NewListUserSessionsUseCase(svc), nil, nil, nil, nil)
+	userID := uuid.NewString()
+	req := &sessionv1.ListUserSessionsRequest{UserId: userID}
+
+	other := authz.NewContext(context.Background(), authz.Principal{UserID: uuid.NewString(), Role: authz.RolePlayer})
+	if _, err := h.ListUserSessions(other, req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+
+	self := authz.NewContext(context.Background(), authz.Principal{UserID: userID, Role: authz.RolePlayer})
+	if _, err := h.ListUserSessions(self, req); err != nil {
+		t.Errorf("Expected a user to list their own sessions, got %v", err)
+	}
+	internal := authz.NewContext(context.Background(), authz.Principal{UserID: authz.ServiceUserID, Role: authz.RoleService})
+	if _, err := h.ListUserSessions(internal, req); err != nil {
+		t.Errorf("Expected the calendar feed to list a user's sessions, got %v", err)
+	}
+}
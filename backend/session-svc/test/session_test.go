@@ -113,13 +113,30 @@ func (m *MockParticipantRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+type StubReservationProvider struct {
+	window *port.ReservationWindow
+}
+
+func NewStubReservationProvider(start time.Time) *StubReservationProvider {
+	return &StubReservationProvider{window: &port.ReservationWindow{
+		Status:    "CONFIRMED",
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+	}}
+}
+
+func (s *StubReservationProvider) GetReservationWindow(ctx context.Context, reservationID uuid.UUID) (*port.ReservationWindow, error) {
+	return s.window, nil
+}
+
 var _ port.SessionRepository = (*MockSessionRepo)(nil)
 var _ participantPort.ParticipantRepository = (*MockParticipantRepo)(nil)
+var _ port.ReservationProvider = (*StubReservationProvider)(nil)
 
 func TestCreateSession(t *testing.T) {
 	sessionRepo := NewMockSessionRepo()
 	participantRepo := NewMockParticipantRepo()
-	svc := service.NewSessionService(sessionRepo, participantRepo)
+	svc := service.NewSessionService(sessionRepo, participantRepo, NewStubReservationProvider(time.Now().Add(48*time.Hour)))
 
 	ctx := context.Background()
 	session, err := svc.CreateSession(
@@ -146,12 +163,32 @@ func TestCreateSession(t *testing.T) {
 	if session.Status != sessionEntity.SessionStatusOpen {
 		t.Errorf("Expected status OPEN, got %v", session.Status)
 	}
+
+	if session.StartTime.IsZero() || !session.EndTime.After(session.StartTime) {
+		t.Errorf("Expected start/end time from the reservation, got %v - %v", session.StartTime, session.EndTime)
+	}
+}
+
+func TestCreateSessionRejectsStartedReservation(t *testing.T) {
+	reservations := NewStubReservationProvider(time.Now().Add(-time.Minute))
+	svc := service.NewSessionService(NewMockSessionRepo(), NewMockParticipantRepo(), reservations)
+
+	_, err := svc.CreateSession(context.Background(), uuid.New(), uuid.New(), "tennis", "", 4, 2, 0, sessionEntity.SessionVisibilityPublic, "")
+	if err == nil {
+		t.Fatal("Expected error for a reservation that has already started")
+	}
+
+	reservations.window = &port.ReservationWindow{Status: "CANCELLED", StartTime: time.Now().Add(time.Hour), EndTime: time.Now().Add(2 * time.Hour)}
+	_, err = svc.CreateSession(context.Background(), uuid.New(), uuid.New(), "tennis", "", 4, 2, 0, sessionEntity.SessionVisibilityPublic, "")
+	if err == nil {
+		t.Fatal("Expected error for a cancelled reservation")
+	}
 }
 
 func TestGetSession(t *testing.T) {
 	sessionRepo := NewMockSessionRepo()
 	participantRepo := NewMockParticipantRepo()
-	svc := service.NewSessionService(sessionRepo, participantRepo)
+	svc := service.NewSessionService(sessionRepo, participantRepo, NewStubReservationProvider(time.Now().Add(48*time.Hour)))
 
 	ctx := context.Background()
 	session := &sessionEntity.Session{
@@ -1500,6 +1500,194 @@ func (x *GetResourceScheduleResponse) GetSlots() []*ScheduleSlot {
 	return nil
 }
 
+type GetResourceAvailabilityRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId         string                 `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DateFrom           string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                                // YYYY-MM-DD
+	DateTo             string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                                      // YYYY-MM-DD, inclusive
+	GranularityMinutes int32                  `protobuf:"varint,4,opt,name=granularity_minutes,json=granularityMinutes,proto3" json:"granularity_minutes,omitempty"` // Cell length, defaults to 60
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetResourceAvailabilityRequest) Reset() {
+	*x = GetResourceAvailabilityRequest{}
+	mi := &file_api_v1_venue_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetResourceAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_venue_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_venue_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetResourceAvailabilityRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *GetResourceAvailabilityRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetResourceAvailabilityRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetResourceAvailabilityRequest) GetGranularityMinutes() int32 {
+	if x != nil {
+		return x.GranularityMinutes
+	}
+	return 0
+}
+
+type AvailabilityCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // RFC3339
+	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // RFC3339
+	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityCell) Reset() {
+	*x = AvailabilityCell{}
+	mi := &file_api_v1_venue_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityCell) ProtoMessage() {}
+
+func (x *AvailabilityCell) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_venue_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityCell.ProtoReflect.Descriptor instead.
+func (*AvailabilityCell) Descriptor() ([]byte, []int) {
+	return file_api_v1_venue_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AvailabilityCell) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *AvailabilityCell) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *AvailabilityCell) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *AvailabilityCell) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type GetResourceAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    string                 `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Cells         []*AvailabilityCell    `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceAvailabilityResponse) Reset() {
+	*x = GetResourceAvailabilityResponse{}
+	mi := &file_api_v1_venue_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetResourceAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_venue_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_venue_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetResourceAvailabilityResponse) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *GetResourceAvailabilityResponse) GetCells() []*AvailabilityCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
 var File_api_v1_venue_proto protoreflect.FileDescriptor
 
 const file_api_v1_venue_proto_rawDesc = "" +
@@ -1619,7 +1807,23 @@ const file_api_v1_venue_proto_rawDesc = "" +
 	"\vresource_id\x18\x01 \x01(\tR\n" +
 	"resourceId\"K\n" +
 	"\x1bGetResourceScheduleResponse\x12,\n" +
-	"\x05slots\x18\x01 \x03(\v2\x16.venue.v1.ScheduleSlotR\x05slots2\xf8\a\n" +
+	"\x05slots\x18\x01 \x03(\v2\x16.venue.v1.ScheduleSlotR\x05slots\"\xa8\x01\n" +
+	"\x1eGetResourceAvailabilityRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\tR\n" +
+	"resourceId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12/\n" +
+	"\x13granularity_minutes\x18\x04 \x01(\x05R\x12granularityMinutes\"\x80\x01\n" +
+	"\x10AvailabilityCell\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x02 \x01(\tR\aendTime\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\"t\n" +
+	"\x1fGetResourceAvailabilityResponse\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\tR\n" +
+	"resourceId\x120\n" +
+	"\x05cells\x18\x02 \x03(\v2\x1a.venue.v1.AvailabilityCellR\x05cells2\xe8\b\n" +
 	"\fVenueService\x12J\n" +
 	"\vCreateVenue\x12\x1c.venue.v1.CreateVenueRequest\x1a\x1d.venue.v1.CreateVenueResponse\x12A\n" +
 	"\bGetVenue\x12\x19.venue.v1.GetVenueRequest\x1a\x1a.venue.v1.GetVenueResponse\x12G\n" +
@@ -1633,7 +1837,8 @@ const file_api_v1_venue_proto_rawDesc = "" +
 	"\x0eUpdateResource\x12\x1f.venue.v1.UpdateResourceRequest\x1a .venue.v1.UpdateResourceResponse\x12S\n" +
 	"\x0eDeleteResource\x12\x1f.venue.v1.DeleteResourceRequest\x1a .venue.v1.DeleteResourceResponse\x12b\n" +
 	"\x13SetResourceSchedule\x12$.venue.v1.SetResourceScheduleRequest\x1a%.venue.v1.SetResourceScheduleResponse\x12b\n" +
-	"\x13GetResourceSchedule\x12$.venue.v1.GetResourceScheduleRequest\x1a%.venue.v1.GetResourceScheduleResponseB-Z+github.com/diploma/venue-svc/api/v1;venuev1b\x06proto3"
+	"\x13GetResourceSchedule\x12$.venue.v1.GetResourceScheduleRequest\x1a%.venue.v1.GetResourceScheduleResponse\x12n\n" +
+	"\x17GetResourceAvailability\x12(.venue.v1.GetResourceAvailabilityRequest\x1a).venue.v1.GetResourceAvailabilityResponseB-Z+github.com/diploma/venue-svc/api/v1;venuev1b\x06proto3"
 
 var (
 	file_api_v1_venue_proto_rawDescOnce sync.Once
@@ -1647,68 +1852,74 @@ func file_api_v1_venue_proto_rawDescGZIP() []byte {
 	return file_api_v1_venue_proto_rawDescData
 }
 
-var file_api_v1_venue_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_api_v1_venue_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_api_v1_venue_proto_goTypes = []any{
-	(*CreateVenueRequest)(nil),           // 0: venue.v1.CreateVenueRequest
-	(*CreateVenueResponse)(nil),          // 1: venue.v1.CreateVenueResponse
-	(*GetVenueRequest)(nil),              // 2: venue.v1.GetVenueRequest
-	(*GetVenueResponse)(nil),             // 3: venue.v1.GetVenueResponse
-	(*ListVenuesRequest)(nil),            // 4: venue.v1.ListVenuesRequest
-	(*ListVenuesResponse)(nil),           // 5: venue.v1.ListVenuesResponse
-	(*UpdateVenueRequest)(nil),           // 6: venue.v1.UpdateVenueRequest
-	(*UpdateVenueResponse)(nil),          // 7: venue.v1.UpdateVenueResponse
-	(*DeleteVenueRequest)(nil),           // 8: venue.v1.DeleteVenueRequest
-	(*DeleteVenueResponse)(nil),          // 9: venue.v1.DeleteVenueResponse
-	(*CreateResourceRequest)(nil),        // 10: venue.v1.CreateResourceRequest
-	(*CreateResourceResponse)(nil),       // 11: venue.v1.CreateResourceResponse
-	(*GetResourceRequest)(nil),           // 12: venue.v1.GetResourceRequest
-	(*GetResourceResponse)(nil),          // 13: venue.v1.GetResourceResponse
-	(*ListResourcesByVenueRequest)(nil),  // 14: venue.v1.ListResourcesByVenueRequest
-	(*ListResourcesByVenueResponse)(nil), // 15: venue.v1.ListResourcesByVenueResponse
-	(*UpdateResourceRequest)(nil),        // 16: venue.v1.UpdateResourceRequest
-	(*UpdateResourceResponse)(nil),       // 17: venue.v1.UpdateResourceResponse
-	(*DeleteResourceRequest)(nil),        // 18: venue.v1.DeleteResourceRequest
-	(*DeleteResourceResponse)(nil),       // 19: venue.v1.DeleteResourceResponse
-	(*ScheduleSlot)(nil),                 // 20: venue.v1.ScheduleSlot
-	(*SetResourceScheduleRequest)(nil),   // 21: venue.v1.SetResourceScheduleRequest
-	(*SetResourceScheduleResponse)(nil),  // 22: venue.v1.SetResourceScheduleResponse
-	(*GetResourceScheduleRequest)(nil),   // 23: venue.v1.GetResourceScheduleRequest
-	(*GetResourceScheduleResponse)(nil),  // 24: venue.v1.GetResourceScheduleResponse
+	(*CreateVenueRequest)(nil),              // 0: venue.v1.CreateVenueRequest
+	(*CreateVenueResponse)(nil),             // 1: venue.v1.CreateVenueResponse
+	(*GetVenueRequest)(nil),                 // 2: venue.v1.GetVenueRequest
+	(*GetVenueResponse)(nil),                // 3: venue.v1.GetVenueResponse
+	(*ListVenuesRequest)(nil),               // 4: venue.v1.ListVenuesRequest
+	(*ListVenuesResponse)(nil),              // 5: venue.v1.ListVenuesResponse
+	(*UpdateVenueRequest)(nil),              // 6: venue.v1.UpdateVenueRequest
+	(*UpdateVenueResponse)(nil),             // 7: venue.v1.UpdateVenueResponse
+	(*DeleteVenueRequest)(nil),              // 8: venue.v1.DeleteVenueRequest
+	(*DeleteVenueResponse)(nil),             // 9: venue.v1.DeleteVenueResponse
+	(*CreateResourceRequest)(nil),           // 10: venue.v1.CreateResourceRequest
+	(*CreateResourceResponse)(nil),          // 11: venue.v1.CreateResourceResponse
+	(*GetResourceRequest)(nil),              // 12: venue.v1.GetResourceRequest
+	(*GetResourceResponse)(nil),             // 13: venue.v1.GetResourceResponse
+	(*ListResourcesByVenueRequest)(nil),     // 14: venue.v1.ListResourcesByVenueRequest
+	(*ListResourcesByVenueResponse)(nil),    // 15: venue.v1.ListResourcesByVenueResponse
+	(*UpdateResourceRequest)(nil),           // 16: venue.v1.UpdateResourceRequest
+	(*UpdateResourceResponse)(nil),          // 17: venue.v1.UpdateResourceResponse
+	(*DeleteResourceRequest)(nil),           // 18: venue.v1.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),          // 19: venue.v1.DeleteResourceResponse
+	(*ScheduleSlot)(nil),                    // 20: venue.v1.ScheduleSlot
+	(*SetResourceScheduleRequest)(nil),      // 21: venue.v1.SetResourceScheduleRequest
+	(*SetResourceScheduleResponse)(nil),     // 22: venue.v1.SetResourceScheduleResponse
+	(*GetResourceScheduleRequest)(nil),      // 23: venue.v1.GetResourceScheduleRequest
+	(*GetResourceScheduleResponse)(nil),     // 24: venue.v1.GetResourceScheduleResponse
+	(*GetResourceAvailabilityRequest)(nil),  // 25: venue.v1.GetResourceAvailabilityRequest
+	(*AvailabilityCell)(nil),                // 26: venue.v1.AvailabilityCell
+	(*GetResourceAvailabilityResponse)(nil), // 27: venue.v1.GetResourceAvailabilityResponse
 }
 var file_api_v1_venue_proto_depIdxs = []int32{
 	3,  // 0: venue.v1.ListVenuesResponse.items:type_name -> venue.v1.GetVenueResponse
 	13, // 1: venue.v1.ListResourcesByVenueResponse.items:type_name -> venue.v1.GetResourceResponse
 	20, // 2: venue.v1.SetResourceScheduleRequest.slots:type_name -> venue.v1.ScheduleSlot
 	20, // 3: venue.v1.GetResourceScheduleResponse.slots:type_name -> venue.v1.ScheduleSlot
-	0,  // 4: venue.v1.VenueService.CreateVenue:input_type -> venue.v1.CreateVenueRequest
-	2,  // 5: venue.v1.VenueService.GetVenue:input_type -> venue.v1.GetVenueRequest
-	4,  // 6: venue.v1.VenueService.ListVenues:input_type -> venue.v1.ListVenuesRequest
-	6,  // 7: venue.v1.VenueService.UpdateVenue:input_type -> venue.v1.UpdateVenueRequest
-	8,  // 8: venue.v1.VenueService.DeleteVenue:input_type -> venue.v1.DeleteVenueRequest
-	10, // 9: venue.v1.VenueService.CreateResource:input_type -> venue.v1.CreateResourceRequest
-	12, // 10: venue.v1.VenueService.GetResource:input_type -> venue.v1.GetResourceRequest
-	14, // 11: venue.v1.VenueService.ListResourcesByVenue:input_type -> venue.v1.ListResourcesByVenueRequest
-	16, // 12: venue.v1.VenueService.UpdateResource:input_type -> venue.v1.UpdateResourceRequest
-	18, // 13: venue.v1.VenueService.DeleteResource:input_type -> venue.v1.DeleteResourceRequest
-	21, // 14: venue.v1.VenueService.SetResourceSchedule:input_type -> venue.v1.SetResourceScheduleRequest
-	23, // 15: venue.v1.VenueService.GetResourceSchedule:input_type -> venue.v1.GetResourceScheduleRequest
-	1,  // 16: venue.v1.VenueService.CreateVenue:output_type -> venue.v1.CreateVenueResponse
-	3,  // 17: venue.v1.VenueService.GetVenue:output_type -> venue.v1.GetVenueResponse
-	5,  // 18: venue.v1.VenueService.ListVenues:output_type -> venue.v1.ListVenuesResponse
-	7,  // 19: venue.v1.VenueService.UpdateVenue:output_type -> venue.v1.UpdateVenueResponse
-	9,  // 20: venue.v1.VenueService.DeleteVenue:output_type -> venue.v1.DeleteVenueResponse
-	11, // 21: venue.v1.VenueService.CreateResource:output_type -> venue.v1.CreateResourceResponse
-	13, // 22: venue.v1.VenueService.GetResource:output_type -> venue.v1.GetResourceResponse
-	15, // 23: venue.v1.VenueService.ListResourcesByVenue:output_type -> venue.v1.ListResourcesByVenueResponse
-	17, // 24: venue.v1.VenueService.UpdateResource:output_type -> venue.v1.UpdateResourceResponse
-	19, // 25: venue.v1.VenueService.DeleteResource:output_type -> venue.v1.DeleteResourceResponse
-	22, // 26: venue.v1.VenueService.SetResourceSchedule:output_type -> venue.v1.SetResourceScheduleResponse
-	24, // 27: venue.v1.VenueService.GetResourceSchedule:output_type -> venue.v1.GetResourceScheduleResponse
-	16, // [16:28] is the sub-list for method output_type
-	4,  // [4:16] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	26, // 4: venue.v1.GetResourceAvailabilityResponse.cells:type_name -> venue.v1.AvailabilityCell
+	0,  // 5: venue.v1.VenueService.CreateVenue:input_type -> venue.v1.CreateVenueRequest
+	2,  // 6: venue.v1.VenueService.GetVenue:input_type -> venue.v1.GetVenueRequest
+	4,  // 7: venue.v1.VenueService.ListVenues:input_type -> venue.v1.ListVenuesRequest
+	6,  // 8: venue.v1.VenueService.UpdateVenue:input_type -> venue.v1.UpdateVenueRequest
+	8,  // 9: venue.v1.VenueService.DeleteVenue:input_type -> venue.v1.DeleteVenueRequest
+	10, // 10: venue.v1.VenueService.CreateResource:input_type -> venue.v1.CreateResourceRequest
+	12, // 11: venue.v1.VenueService.GetResource:input_type -> venue.v1.GetResourceRequest
+	14, // 12: venue.v1.VenueService.ListResourcesByVenue:input_type -> venue.v1.ListResourcesByVenueRequest
+	16, // 13: venue.v1.VenueService.UpdateResource:input_type -> venue.v1.UpdateResourceRequest
+	18, // 14: venue.v1.VenueService.DeleteResource:input_type -> venue.v1.DeleteResourceRequest
+	21, // 15: venue.v1.VenueService.SetResourceSchedule:input_type -> venue.v1.SetResourceScheduleRequest
+	23, // 16: venue.v1.VenueService.GetResourceSchedule:input_type -> venue.v1.GetResourceScheduleRequest
+	25, // 17: venue.v1.VenueService.GetResourceAvailability:input_type -> venue.v1.GetResourceAvailabilityRequest
+	1,  // 18: venue.v1.VenueService.CreateVenue:output_type -> venue.v1.CreateVenueResponse
+	3,  // 19: venue.v1.VenueService.GetVenue:output_type -> venue.v1.GetVenueResponse
+	5,  // 20: venue.v1.VenueService.ListVenues:output_type -> venue.v1.ListVenuesResponse
+	7,  // 21: venue.v1.VenueService.UpdateVenue:output_type -> venue.v1.UpdateVenueResponse
+	9,  // 22: venue.v1.VenueService.DeleteVenue:output_type -> venue.v1.DeleteVenueResponse
+	11, // 23: venue.v1.VenueService.CreateResource:output_type -> venue.v1.CreateResourceResponse
+	13, // 24: venue.v1.VenueService.GetResource:output_type -> venue.v1.GetResourceResponse
+	15, // 25: venue.v1.VenueService.ListResourcesByVenue:output_type -> venue.v1.ListResourcesByVenueResponse
+	17, // 26: venue.v1.VenueService.UpdateResource:output_type -> venue.v1.UpdateResourceResponse
+	19, // 27: venue.v1.VenueService.DeleteResource:output_type -> venue.v1.DeleteResourceResponse
+	22, // 28: venue.v1.VenueService.SetResourceSchedule:output_type -> venue.v1.SetResourceScheduleResponse
+	24, // 29: venue.v1.VenueService.GetResourceSchedule:output_type -> venue.v1.GetResourceScheduleResponse
+	27, // 30: venue.v1.VenueService.GetResourceAvailability:output_type -> venue.v1.GetResourceAvailabilityResponse
+	18, // [18:31] is the sub-list for method output_type
+	5,  // [5:18] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_venue_proto_init() }
@@ -1722,7 +1933,7 @@ func file_api_v1_venue_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_venue_proto_rawDesc), len(file_api_v1_venue_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
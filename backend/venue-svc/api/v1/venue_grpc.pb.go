@@ -19,18 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VenueService_CreateVenue_FullMethodName          = "/venue.v1.VenueService/CreateVenue"
-	VenueService_GetVenue_FullMethodName             = "/venue.v1.VenueService/GetVenue"
-	VenueService_ListVenues_FullMethodName           = "/venue.v1.VenueService/ListVenues"
-	VenueService_UpdateVenue_FullMethodName          = "/venue.v1.VenueService/UpdateVenue"
-	VenueService_DeleteVenue_FullMethodName          = "/venue.v1.VenueService/DeleteVenue"
-	VenueService_CreateResource_FullMethodName       = "/venue.v1.VenueService/CreateResource"
-	VenueService_GetResource_FullMethodName          = "/venue.v1.VenueService/GetResource"
-	VenueService_ListResourcesByVenue_FullMethodName = "/venue.v1.VenueService/ListResourcesByVenue"
-	VenueService_UpdateResource_FullMethodName       = "/venue.v1.VenueService/UpdateResource"
-	VenueService_DeleteResource_FullMethodName       = "/venue.v1.VenueService/DeleteResource"
-	VenueService_SetResourceSchedule_FullMethodName  = "/venue.v1.VenueService/SetResourceSchedule"
-	VenueService_GetResourceSchedule_FullMethodName  = "/venue.v1.VenueService/GetResourceSchedule"
+	VenueService_CreateVenue_FullMethodName             = "/venue.v1.VenueService/CreateVenue"
+	VenueService_GetVenue_FullMethodName                = "/venue.v1.VenueService/GetVenue"
+	VenueService_ListVenues_FullMethodName              = "/venue.v1.VenueService/ListVenues"
+	VenueService_UpdateVenue_FullMethodName             = "/venue.v1.VenueService/UpdateVenue"
+	VenueService_DeleteVenue_FullMethodName             = "/venue.v1.VenueService/DeleteVenue"
+	VenueService_CreateResource_FullMethodName          = "/venue.v1.VenueService/CreateResource"
+	VenueService_GetResource_FullMethodName             = "/venue.v1.VenueService/GetResource"
+	VenueService_ListResourcesByVenue_FullMethodName    = "/venue.v1.VenueService/ListResourcesByVenue"
+	VenueService_UpdateResource_FullMethodName          = "/venue.v1.VenueService/UpdateResource"
+	VenueService_DeleteResource_FullMethodName          = "/venue.v1.VenueService/DeleteResource"
+	VenueService_SetResourceSchedule_FullMethodName     = "/venue.v1.VenueService/SetResourceSchedule"
+	VenueService_GetResourceSchedule_FullMethodName     = "/venue.v1.VenueService/GetResourceSchedule"
+	VenueService_GetResourceAvailability_FullMethodName = "/venue.v1.VenueService/GetResourceAvailability"
 )
 
 // VenueServiceClient is the client API for VenueService service.
@@ -54,6 +55,7 @@ type VenueServiceClient interface {
 	// Schedule management
 	SetResourceSchedule(ctx context.Context, in *SetResourceScheduleRequest, opts ...grpc.CallOption) (*SetResourceScheduleResponse, error)
 	GetResourceSchedule(ctx context.Context, in *GetResourceScheduleRequest, opts ...grpc.CallOption) (*GetResourceScheduleResponse, error)
+	GetResourceAvailability(ctx context.Context, in *GetResourceAvailabilityRequest, opts ...grpc.CallOption) (*GetResourceAvailabilityResponse, error)
 }
 
 type venueServiceClient struct {
@@ -184,6 +186,16 @@ func (c *venueServiceClient) GetResourceSchedule(ctx context.Context, in *GetRes
 	return out, nil
 }
 
+func (c *venueServiceClient) GetResourceAvailability(ctx context.Context, in *GetResourceAvailabilityRequest, opts ...grpc.CallOption) (*GetResourceAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceAvailabilityResponse)
+	err := c.cc.Invoke(ctx, VenueService_GetResourceAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VenueServiceServer is the server API for VenueService service.
 // All implementations must embed UnimplementedVenueServiceServer
 // for forward compatibility.
@@ -205,6 +217,7 @@ type VenueServiceServer interface {
 	// Schedule management
 	SetResourceSchedule(context.Context, *SetResourceScheduleRequest) (*SetResourceScheduleResponse, error)
 	GetResourceSchedule(context.Context, *GetResourceScheduleRequest) (*GetResourceScheduleResponse, error)
+	GetResourceAvailability(context.Context, *GetResourceAvailabilityRequest) (*GetResourceAvailabilityResponse, error)
 	mustEmbedUnimplementedVenueServiceServer()
 }
 
@@ -251,6 +264,9 @@ func (UnimplementedVenueServiceServer) SetResourceSchedule(context.Context, *Set
 func (UnimplementedVenueServiceServer) GetResourceSchedule(context.Context, *GetResourceScheduleRequest) (*GetResourceScheduleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetResourceSchedule not implemented")
 }
+func (UnimplementedVenueServiceServer) GetResourceAvailability(context.Context, *GetResourceAvailabilityRequest) (*GetResourceAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResourceAvailability not implemented")
+}
 func (UnimplementedVenueServiceServer) mustEmbedUnimplementedVenueServiceServer() {}
 func (UnimplementedVenueServiceServer) testEmbeddedByValue()                      {}
 
@@ -488,6 +504,24 @@ func _VenueService_GetResourceSchedule_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueService_GetResourceAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetResourceAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetResourceAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetResourceAvailability(ctx, req.(*GetResourceAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VenueService_ServiceDesc is the grpc.ServiceDesc for VenueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -543,6 +577,10 @@ var VenueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResourceSchedule",
 			Handler:    _VenueService_GetResourceSchedule_Handler,
 		},
+		{
+			MethodName: "GetResourceAvailability",
+			Handler:    _VenueService_GetResourceAvailability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/venue.proto",
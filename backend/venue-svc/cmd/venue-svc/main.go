@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/diploma/authz"
+	venuev1 "github.com/diploma/venue-svc/api/v1"
+	"github.com/diploma/venue-svc/internal/adapters/inbound/grpc/handler"
+	"github.com/diploma/venue-svc/internal/adapters/outbound/database/repository"
+	"github.com/diploma/venue-svc/internal/adapters/outbound/external/reservation"
+	resourceusecase "github.com/diploma/venue-svc/internal/application/resource/usecase"
+	scheduleusecase "github.com/diploma/venue-svc/internal/application/schedule/usecase"
+	venueusecase "github.com/diploma/venue-svc/internal/application/venue/usecase"
+	"github.com/diploma/venue-svc/internal/config"
+	resourceservice "github.com/diploma/venue-svc/internal/domain/resource/service"
+	scheduleservice "github.com/diploma/venue-svc/internal/domain/schedule/service"
+	venueservice "github.com/diploma/venue-svc/internal/domain/venue/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	db, err := gorm.Open(postgres.Open(cfg.DBConfig.ConnectionString()), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database instance: %v", err)
+	}
+	defer sqlDB.Close()
+
+	if err := sqlDB.Ping(); err != nil {
+		log.Fatalf("Failed to ping database: %v", err)
+	}
+
+	reservationClient, err := reservation.NewReservationClient(cfg.ReservationServiceURL)
+	if err != nil {
+		log.Fatalf("Failed to create reservation client: %v", err)
+	}
+	defer reservationClient.Close()
+
+	venueRepo := repository.NewVenueRepository(db)
+	resourceRepo := repository.NewResourceRepository(db)
+	scheduleRepo := repository.NewScheduleRepository(db)
+
+	venueService := venueservice.NewVenueService(venueRepo)
+	resourceService := resourceservice.NewResourceService(resourceRepo)
+	scheduleService := scheduleservice.NewScheduleService(scheduleRepo)
+	availabilityService := scheduleservice.NewAvailabilityService(resourceRepo, scheduleRepo, reservationClient)
+
+	venueHandler := handler.NewVenueServiceServer(
+		venueusecase.NewCreateVenueUseCase(venueService),
+		venueusecase.NewGetVenueUseCase(venueService),
+		venueusecase.NewListVenuesUseCase(venueService),
+		venueusecase.NewUpdateVenueUseCase(venueService),
+		venueusecase.NewDeleteVenueUseCase(venueService),
+		resourceusecase.NewCreateResourceUseCase(resourceService, venueService),
+		resourceusecase.NewGetResourceUseCase(resourceService),
+		resourceusecase.NewListResourcesByVenueUseCase(resourceService),
+		resourceusecase.NewUpdateResourceUseCase(resourceService, venueService),
+		resourceusecase.NewDeleteResourceUseCase(resourceService, venueService),
+		scheduleusecase.NewSetResourceScheduleUseCase(scheduleService, resourceService, venueService),
+		scheduleusecase.NewGetResourceScheduleUseCase(scheduleService),
+		scheduleusecase.NewGetResourceAvailabilityUseCase(availabilityService),
+	)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authz.UnaryServerInterceptor(
+		authz.NewJWKSVerifier(authz.NewHTTPKeySource(cfg.JWT.JWKSURL), cfg.JWT.Issuer, cfg.JWT.KeyCacheTTL),
+		handler.AccessPolicy,
+	)))
+
+	venuev1.RegisterVenueServiceServer(grpcServer, venueHandler)
+
+	reflection.Register(grpcServer)
+
+	addr := fmt.Sprintf(":%s", cfg.GRPCPort)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", addr, err)
+	}
+
+	log.Printf("Starting gRPC server on %s", addr)
+
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("Failed to serve: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down server...")
+	grpcServer.GracefulStop()
+	log.Println("Server stopped")
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"github.com/diploma/authz"
+	venuev1 "github.com/diploma/venue-svc/api/v1"
+)
+
+// AccessPolicy gates each RPC by permission; ownership of the venue is checked by the use cases
+var AccessPolicy = authz.Policy{
+	venuev1.VenueService_GetVenue_FullMethodName:                authz.Public(),
+	venuev1.VenueService_ListVenues_FullMethodName:              authz.Public(),
+	venuev1.VenueService_GetResource_FullMethodName:             authz.Public(),
+	venuev1.VenueService_ListResourcesByVenue_FullMethodName:    authz.Public(),
+	venuev1.VenueService_GetResourceSchedule_FullMethodName:     authz.Public(),
+	venuev1.VenueService_GetResourceAvailability_FullMethodName: authz.Public(),
+
+	venuev1.VenueService_CreateVenue_FullMethodName:         authz.Require(authz.PermVenueCreate),
+	venuev1.VenueService_UpdateVenue_FullMethodName:         authz.Require(authz.PermVenueManage),
+	venuev1.VenueService_DeleteVenue_FullMethodName:         authz.Require(authz.PermVenueManage),
+	venuev1.VenueService_CreateResource_FullMethodName:      authz.Require(authz.PermVenueManage),
+	venuev1.VenueService_UpdateResource_FullMethodName:      authz.Require(authz.PermVenueManage),
+	venuev1.VenueService_DeleteResource_FullMethodName:      authz.Require(authz.PermVenueManage),
+	venuev1.VenueService_SetResourceSchedule_FullMethodName: authz.Require(authz.PermVenueManage),
+}
@@ -3,7 +3,9 @@ package handler
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/diploma/authz"
 	venuev1 "github.com/diploma/venue-svc/api/v1"
 	resourceDto "github.com/diploma/venue-svc/internal/application/resource/dto"
 	resourceUsecase "github.com/diploma/venue-svc/internal/application/resource/usecase"
@@ -34,6 +36,8 @@ type VenueServiceServer struct {
 
 	setResourceScheduleUC *scheduleUsecase.SetResourceScheduleUseCase
 	getResourceScheduleUC *scheduleUsecase.GetResourceScheduleUseCase
+
+	getResourceAvailabilityUC *scheduleUsecase.GetResourceAvailabilityUseCase
 }
 
 func NewVenueServiceServer(
@@ -49,20 +53,22 @@ func NewVenueServiceServer(
 	deleteResourceUC *resourceUsecase.DeleteResourceUseCase,
 	setResourceScheduleUC *scheduleUsecase.SetResourceScheduleUseCase,
 	getResourceScheduleUC *scheduleUsecase.GetResourceScheduleUseCase,
+	getResourceAvailabilityUC *scheduleUsecase.GetResourceAvailabilityUseCase,
 ) *VenueServiceServer {
 	return &VenueServiceServer{
-		createVenueUC:          createVenueUC,
-		getVenueUC:             getVenueUC,
-		listVenuesUC:           listVenuesUC,
-		updateVenueUC:          updateVenueUC,
-		deleteVenueUC:          deleteVenueUC,
-		createResourceUC:       createResourceUC,
-		getResourceUC:          getResourceUC,
-		listResourcesByVenueUC: listResourcesByVenueUC,
-		updateResourceUC:       updateResourceUC,
-		deleteResourceUC:       deleteResourceUC,
-		setResourceScheduleUC:  setResourceScheduleUC,
-		getResourceScheduleUC:  getResourceScheduleUC,
+		createVenueUC:             createVenueUC,
+		getVenueUC:                getVenueUC,
+		listVenuesUC:              listVenuesUC,
+		updateVenueUC:             updateVenueUC,
+		deleteVenueUC:             deleteVenueUC,
+		createResourceUC:          createResourceUC,
+		getResourceUC:             getResourceUC,
+		listResourcesByVenueUC:    listResourcesByVenueUC,
+		updateResourceUC:          updateResourceUC,
+		deleteResourceUC:          deleteResourceUC,
+		setResourceScheduleUC:     setResourceScheduleUC,
+		getResourceScheduleUC:     getResourceScheduleUC,
+		getResourceAvailabilityUC: getResourceAvailabilityUC,
 	}
 }
 
@@ -70,15 +76,15 @@ func mapErrorToGRPCStatus(err error) error {
 	if domainErr, ok := err.(*pkgerrors.DomainError); ok {
 		switch domainErr.Code {
 		case pkgerrors.CodeNotFound:
-			return status.Errorf(codes.NotFound, domainErr.Message)
+			return status.Error(codes.NotFound, domainErr.Message)
 		case pkgerrors.CodeAlreadyExists:
-			return status.Errorf(codes.AlreadyExists, domainErr.Message)
+			return status.Error(codes.AlreadyExists, domainErr.Message)
 		case pkgerrors.CodeInvalidArgument:
-			return status.Errorf(codes.InvalidArgument, domainErr.Message)
+			return status.Error(codes.InvalidArgument, domainErr.Message)
 		case pkgerrors.CodeFailedPrecondition:
-			return status.Errorf(codes.FailedPrecondition, domainErr.Message)
+			return status.Error(codes.FailedPrecondition, domainErr.Message)
 		case pkgerrors.CodePermissionDenied:
-			return status.Errorf(codes.PermissionDenied, domainErr.Message)
+			return status.Error(codes.PermissionDenied, domainErr.Message)
 		default:
 			return status.Errorf(codes.Internal, "internal error: %v", domainErr.Message)
 		}
@@ -86,8 +92,14 @@ func mapErrorToGRPCStatus(err error) error {
 	return status.Errorf(codes.Internal, "internal error: %v", err.Error())
 }
 
-
 func (s *VenueServiceServer) CreateVenue(ctx context.Context, req *venuev1.CreateVenueRequest) (*venuev1.CreateVenueResponse, error) {
+	// Owners create venues for themselves unless stated otherwise
+	if req.OwnerId == "" {
+		if principal, ok := authz.FromContext(ctx); ok {
+			req.OwnerId = principal.UserID
+		}
+	}
+
 	ownerID, err := uuid.Parse(req.OwnerId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid owner_id: %v", err)
@@ -222,7 +234,6 @@ func (s *VenueServiceServer) DeleteVenue(ctx context.Context, req *venuev1.Delet
 	}, nil
 }
 
-
 func (s *VenueServiceServer) CreateResource(ctx context.Context, req *venuev1.CreateResourceRequest) (*venuev1.CreateResourceResponse, error) {
 	venueID, err := uuid.Parse(req.VenueId)
 	if err != nil {
@@ -357,7 +368,6 @@ func (s *VenueServiceServer) DeleteResource(ctx context.Context, req *venuev1.De
 	}, nil
 }
 
-
 func (s *VenueServiceServer) SetResourceSchedule(ctx context.Context, req *venuev1.SetResourceScheduleRequest) (*venuev1.SetResourceScheduleResponse, error) {
 	resourceID, err := uuid.Parse(req.ResourceId)
 	if err != nil {
@@ -426,6 +436,54 @@ func (s *VenueServiceServer) GetResourceSchedule(ctx context.Context, req *venue
 	}, nil
 }
 
+func (s *VenueServiceServer) GetResourceAvailability(ctx context.Context, req *venuev1.GetResourceAvailabilityRequest) (*venuev1.GetResourceAvailabilityResponse, error) {
+	resourceID, err := uuid.Parse(req.ResourceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource_id: %v", err)
+	}
+
+	dateFrom, err := time.Parse(time.DateOnly, req.DateFrom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date_from, expected YYYY-MM-DD: %v", err)
+	}
+
+	dateTo, err := time.Parse(time.DateOnly, req.DateTo)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date_to, expected YYYY-MM-DD: %v", err)
+	}
+
+	if req.GranularityMinutes < 0 {
+		return nil, status.Error(codes.InvalidArgument, "granularity_minutes must be positive")
+	}
+
+	input := scheduleDto.GetResourceAvailabilityInput{
+		ResourceID:  resourceID,
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		Granularity: time.Duration(req.GranularityMinutes) * time.Minute,
+	}
+
+	output, err := s.getResourceAvailabilityUC.Execute(ctx, input)
+	if err != nil {
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	cells := make([]*venuev1.AvailabilityCell, len(output.Cells))
+	for i, cell := range output.Cells {
+		cells[i] = &venuev1.AvailabilityCell{
+			StartTime: cell.StartTime.Format(time.RFC3339),
+			EndTime:   cell.EndTime.Format(time.RFC3339),
+			Available: cell.Available,
+			Price:     cell.Price,
+		}
+	}
+
+	return &venuev1.GetResourceAvailabilityResponse{
+		ResourceId: output.ResourceID.String(),
+		Cells:      cells,
+	}, nil
+}
+
 func validateTimeFormat(timeStr string) error {
 	if len(timeStr) != 5 {
 		return fmt.Errorf("expected format HH:MM, got %s", timeStr)
@@ -435,4 +493,3 @@ func validateTimeFormat(timeStr string) error {
 	}
 	return nil
 }
-
@@ -0,0 +1,68 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	reservationv1 "github.com/diploma/venue-svc/api/proto/reservation/v1"
+	"github.com/diploma/venue-svc/internal/domain/schedule/port"
+	pkgerrors "github.com/diploma/venue-svc/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type ReservationClient struct {
+	client reservationv1.ReservationServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewReservationClient(address string) (*ReservationClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservationClient{
+		client: reservationv1.NewReservationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *ReservationClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *ReservationClient) ListBookings(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]port.Booking, error) {
+	resp, err := c.client.ListReservationsByResource(ctx, &reservationv1.ListReservationsByResourceRequest{
+		ResourceId: resourceID.String(),
+		From:       from.UTC().Format(time.RFC3339),
+		To:         to.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, pkgerrors.NewInvalidArgumentError(status.Convert(err).Message())
+		}
+		return nil, pkgerrors.NewInternalError("failed to list reservations", err)
+	}
+
+	bookings := make([]port.Booking, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		start, err := time.Parse(time.RFC3339, item.StartTime)
+		if err != nil {
+			return nil, pkgerrors.NewInternalError("invalid reservation start_time", err)
+		}
+		end, err := time.Parse(time.RFC3339, item.EndTime)
+		if err != nil {
+			return nil, pkgerrors.NewInternalError("invalid reservation end_time", err)
+		}
+		bookings = append(bookings, port.Booking{
+			StartTime: start,
+			EndTime:   end,
+		})
+	}
+
+	return bookings, nil
+}
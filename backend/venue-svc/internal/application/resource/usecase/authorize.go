@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/venue-svc/internal/domain/resource/service"
+	venueservice "github.com/diploma/venue-svc/internal/domain/venue/service"
+	"github.com/google/uuid"
+)
+
+// authorizeResource checks that the caller may modify the venue the resource belongs to
+func authorizeResource(ctx context.Context, resourceService *service.ResourceService, venueService *venueservice.VenueService, resourceID uuid.UUID) error {
+	resource, err := resourceService.GetResource(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+	return venueService.AuthorizeManage(ctx, resource.VenueID)
+}
@@ -5,19 +5,26 @@ import (
 
 	"github.com/diploma/venue-svc/internal/application/resource/dto"
 	"github.com/diploma/venue-svc/internal/domain/resource/service"
+	venueservice "github.com/diploma/venue-svc/internal/domain/venue/service"
 )
 
 type CreateResourceUseCase struct {
 	resourceService *service.ResourceService
+	venueService    *venueservice.VenueService
 }
 
-func NewCreateResourceUseCase(resourceService *service.ResourceService) *CreateResourceUseCase {
+func NewCreateResourceUseCase(resourceService *service.ResourceService, venueService *venueservice.VenueService) *CreateResourceUseCase {
 	return &CreateResourceUseCase{
 		resourceService: resourceService,
+		venueService:    venueService,
 	}
 }
 
 func (uc *CreateResourceUseCase) Execute(ctx context.Context, input dto.CreateResourceInput) (*dto.CreateResourceOutput, error) {
+	if err := uc.venueService.AuthorizeManage(ctx, input.VenueID); err != nil {
+		return nil, err
+	}
+
 	resource, err := uc.resourceService.CreateResource(
 		ctx,
 		input.VenueID,
@@ -5,19 +5,26 @@ import (
 
 	"github.com/diploma/venue-svc/internal/application/resource/dto"
 	"github.com/diploma/venue-svc/internal/domain/resource/service"
+	venueservice "github.com/diploma/venue-svc/internal/domain/venue/service"
 )
 
 type DeleteResourceUseCase struct {
 	resourceService *service.ResourceService
+	venueService    *venueservice.VenueService
 }
 
-func NewDeleteResourceUseCase(resourceService *service.ResourceService) *DeleteResourceUseCase {
+func NewDeleteResourceUseCase(resourceService *service.ResourceService, venueService *venueservice.VenueService) *DeleteResourceUseCase {
 	return &DeleteResourceUseCase{
 		resourceService: resourceService,
+		venueService:    venueService,
 	}
 }
 
 func (uc *DeleteResourceUseCase) Execute(ctx context.Context, input dto.DeleteResourceInput) (*dto.DeleteResourceOutput, error) {
+	if err := authorizeResource(ctx, uc.resourceService, uc.venueService, input.ResourceID); err != nil {
+		return nil, err
+	}
+
 	err := uc.resourceService.DeleteResource(ctx, input.ResourceID)
 	if err != nil {
 		return nil, err
@@ -5,19 +5,26 @@ import (
 
 	"github.com/diploma/venue-svc/internal/application/resource/dto"
 	"github.com/diploma/venue-svc/internal/domain/resource/service"
+	venueservice "github.com/diploma/venue-svc/internal/domain/venue/service"
 )
 
 type UpdateResourceUseCase struct {
 	resourceService *service.ResourceService
+	venueService    *venueservice.VenueService
 }
 
-func NewUpdateResourceUseCase(resourceService *service.ResourceService) *UpdateResourceUseCase {
+func NewUpdateResourceUseCase(resourceService *service.ResourceService, venueService *venueservice.VenueService) *UpdateResourceUseCase {
 	return &UpdateResourceUseCase{
 		resourceService: resourceService,
+		venueService:    venueService,
 	}
 }
 
 func (uc *UpdateResourceUseCase) Execute(ctx context.Context, input dto.UpdateResourceInput) (*dto.UpdateResourceOutput, error) {
+	if err := authorizeResource(ctx, uc.resourceService, uc.venueService, input.ResourceID); err != nil {
+		return nil, err
+	}
+
 	_, err := uc.resourceService.UpdateResource(
 		ctx,
 		input.ResourceID,
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	scheduleEntity "github.com/diploma/venue-svc/internal/domain/schedule/entity"
 	"github.com/google/uuid"
 )
@@ -49,3 +51,30 @@ func ToScheduleSlotEntity(dto ScheduleSlotDTO, resourceID uuid.UUID) *scheduleEn
 	}
 }
 
+type GetResourceAvailabilityInput struct {
+	ResourceID  uuid.UUID
+	DateFrom    time.Time
+	DateTo      time.Time
+	Granularity time.Duration
+}
+
+type AvailabilityCellDTO struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Available bool
+	Price     float64
+}
+
+type GetResourceAvailabilityOutput struct {
+	ResourceID uuid.UUID
+	Cells      []AvailabilityCellDTO
+}
+
+func ToAvailabilityCellDTO(cell *scheduleEntity.AvailabilityCell) AvailabilityCellDTO {
+	return AvailabilityCellDTO{
+		StartTime: cell.StartTime,
+		EndTime:   cell.EndTime,
+		Available: cell.Available,
+		Price:     cell.Price,
+	}
+}
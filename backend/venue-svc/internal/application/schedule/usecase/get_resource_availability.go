@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diploma/venue-svc/internal/application/schedule/dto"
+	"github.com/diploma/venue-svc/internal/domain/schedule/service"
+)
+
+type GetResourceAvailabilityUseCase struct {
+	availabilityService *service.AvailabilityService
+}
+
+func NewGetResourceAvailabilityUseCase(availabilityService *service.AvailabilityService) *GetResourceAvailabilityUseCase {
+	return &GetResourceAvailabilityUseCase{
+		availabilityService: availabilityService,
+	}
+}
+
+func (uc *GetResourceAvailabilityUseCase) Execute(ctx context.Context, input dto.GetResourceAvailabilityInput) (*dto.GetResourceAvailabilityOutput, error) {
+	cells, err := uc.availabilityService.GetResourceAvailability(ctx, input.ResourceID, input.DateFrom, input.DateTo, input.Granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	cellDTOs := make([]dto.AvailabilityCellDTO, len(cells))
+	for i, cell := range cells {
+		cellDTOs[i] = dto.ToAvailabilityCellDTO(cell)
+	}
+
+	return &dto.GetResourceAvailabilityOutput{
+		ResourceID: input.ResourceID,
+		Cells:      cellDTOs,
+	}, nil
+}
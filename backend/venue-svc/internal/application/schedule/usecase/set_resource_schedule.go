@@ -4,27 +4,45 @@ import (
 	"context"
 
 	"github.com/diploma/venue-svc/internal/application/schedule/dto"
+	resourceservice "github.com/diploma/venue-svc/internal/domain/resource/service"
 	"github.com/diploma/venue-svc/internal/domain/schedule/entity"
 	"github.com/diploma/venue-svc/internal/domain/schedule/service"
+	venueservice "github.com/diploma/venue-svc/internal/domain/venue/service"
 )
 
 type SetResourceScheduleUseCase struct {
 	scheduleService *service.ScheduleService
+	resourceService *resourceservice.ResourceService
+	venueService    *venueservice.VenueService
 }
 
-func NewSetResourceScheduleUseCase(scheduleService *service.ScheduleService) *SetResourceScheduleUseCase {
+func NewSetResourceScheduleUseCase(
+	scheduleService *service.ScheduleService,
+	resourceService *resourceservice.ResourceService,
+	venueService *venueservice.VenueService,
+) *SetResourceScheduleUseCase {
 	return &SetResourceScheduleUseCase{
 		scheduleService: scheduleService,
+		resourceService: resourceService,
+		venueService:    venueService,
 	}
 }
 
 func (uc *SetResourceScheduleUseCase) Execute(ctx context.Context, input dto.SetResourceScheduleInput) (*dto.SetResourceScheduleOutput, error) {
+	resource, err := uc.resourceService.GetResource(ctx, input.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.venueService.AuthorizeManage(ctx, resource.VenueID); err != nil {
+		return nil, err
+	}
+
 	slots := make([]*entity.ScheduleSlot, len(input.Slots))
 	for i, slotDTO := range input.Slots {
 		slots[i] = dto.ToScheduleSlotEntity(slotDTO, input.ResourceID)
 	}
 
-	err := uc.scheduleService.SetResourceSchedule(ctx, input.ResourceID, slots)
+	err = uc.scheduleService.SetResourceSchedule(ctx, input.ResourceID, slots)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,10 @@ func NewCreateVenueUseCase(venueService *service.VenueService) *CreateVenueUseCa
 }
 
 func (uc *CreateVenueUseCase) Execute(ctx context.Context, input dto.CreateVenueInput) (*dto.CreateVenueOutput, error) {
+	if err := uc.venueService.AuthorizeOwner(ctx, input.OwnerID); err != nil {
+		return nil, err
+	}
+
 	venue, err := uc.venueService.CreateVenue(
 		ctx,
 		input.OwnerID,
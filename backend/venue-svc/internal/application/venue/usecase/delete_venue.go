@@ -18,6 +18,10 @@ func NewDeleteVenueUseCase(venueService *service.VenueService) *DeleteVenueUseCa
 }
 
 func (uc *DeleteVenueUseCase) Execute(ctx context.Context, input dto.DeleteVenueInput) (*dto.DeleteVenueOutput, error) {
+	if err := uc.venueService.AuthorizeManage(ctx, input.VenueID); err != nil {
+		return nil, err
+	}
+
 	err := uc.venueService.DeleteVenue(ctx, input.VenueID)
 	if err != nil {
 		return nil, err
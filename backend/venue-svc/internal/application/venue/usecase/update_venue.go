@@ -18,6 +18,10 @@ func NewUpdateVenueUseCase(venueService *service.VenueService) *UpdateVenueUseCa
 }
 
 func (uc *UpdateVenueUseCase) Execute(ctx context.Context, input dto.UpdateVenueInput) (*dto.UpdateVenueOutput, error) {
+	if err := uc.venueService.AuthorizeManage(ctx, input.VenueID); err != nil {
+		return nil, err
+	}
+
 	_, err := uc.venueService.UpdateVenue(
 		ctx,
 		input.VenueID,
@@ -3,13 +3,23 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	GRPCPort string
-	DBConfig DatabaseConfig
+	GRPCPort              string
+	DBConfig              DatabaseConfig
+	ReservationServiceURL string
+	JWT                   JWTConfig
+}
+
+// JWTConfig locates the public keys auth-svc signs access tokens with
+type JWTConfig struct {
+	JWKSURL     string
+	Issuer      string
+	KeyCacheTTL time.Duration
 }
 
 type DatabaseConfig struct {
@@ -34,6 +44,12 @@ func Load() (*Config, error) {
 			DBName:   getEnv("DB_NAME", "venue_db"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
 		},
+		ReservationServiceURL: getEnv("RESERVATION_SERVICE_URL", "localhost:50052"),
+		JWT: JWTConfig{
+			JWKSURL:     getEnv("JWKS_URL", "http://localhost:8080/.well-known/jwks.json"),
+			Issuer:      getEnv("JWT_ISSUER", "auth-svc"),
+			KeyCacheTTL: getEnvAsDuration("JWT_KEY_CACHE_TTL", 10*time.Minute),
+		},
 	}
 
 	return cfg, nil
@@ -58,3 +74,10 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if value, err := time.ParseDuration(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
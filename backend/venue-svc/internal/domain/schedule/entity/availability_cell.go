@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type AvailabilityCell struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Available bool
+	Price     float64
+}
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"fmt"
+
+	"github.com/diploma/clock"
 	pkgerrors "github.com/diploma/venue-svc/pkg/errors"
 	"github.com/google/uuid"
 )
@@ -27,6 +30,18 @@ func (s *ScheduleSlot) IsValid() error {
 	if s.EndTime == "" {
 		return pkgerrors.NewInvalidArgumentError("end_time is required")
 	}
+	start, err := clock.Parse(s.StartTime)
+	if err != nil {
+		return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("start_time: %v", err))
+	}
+	end, err := clock.Parse(s.EndTime)
+	if err != nil {
+		return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("end_time: %v", err))
+	}
+	// Slots cannot wrap past midnight; a night opening is split into one slot per day
+	if end <= start {
+		return pkgerrors.NewInvalidArgumentError("end_time must be after start_time")
+	}
 	if s.BasePrice < 0 {
 		return pkgerrors.NewInvalidArgumentError("base_price must be non-negative")
 	}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Booking struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type BookingProvider interface {
+	ListBookings(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]Booking, error)
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/diploma/clock"
+	resourcePort "github.com/diploma/venue-svc/internal/domain/resource/port"
+	"github.com/diploma/venue-svc/internal/domain/schedule/entity"
+	"github.com/diploma/venue-svc/internal/domain/schedule/port"
+	pkgerrors "github.com/diploma/venue-svc/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultGranularity  = 60 * time.Minute
+	MaxAvailabilityDays = 31
+)
+
+type AvailabilityService struct {
+	resources resourcePort.ResourceRepository
+	schedules port.ScheduleRepository
+	bookings  port.BookingProvider
+}
+
+func NewAvailabilityService(
+	resources resourcePort.ResourceRepository,
+	schedules port.ScheduleRepository,
+	bookings port.BookingProvider,
+) *AvailabilityService {
+	return &AvailabilityService{
+		resources: resources,
+		schedules: schedules,
+		bookings:  bookings,
+	}
+}
+
+func (s *AvailabilityService) GetResourceAvailability(ctx context.Context, resourceID uuid.UUID, dateFrom, dateTo time.Time, granularity time.Duration) ([]*entity.AvailabilityCell, error) {
+	if resourceID == uuid.Nil {
+		return nil, pkgerrors.NewInvalidArgumentError("resource_id is required")
+	}
+	if granularity == 0 {
+		granularity = DefaultGranularity
+	}
+	if granularity < time.Minute || granularity > 24*time.Hour {
+		return nil, pkgerrors.NewInvalidArgumentError("granularity must be between 1 minute and 24 hours")
+	}
+
+	from := truncateToDate(dateFrom)
+	to := truncateToDate(dateTo).AddDate(0, 0, 1)
+	if !to.After(from) {
+		return nil, pkgerrors.NewInvalidArgumentError("date_to must not be before date_from")
+	}
+	if to.Sub(from) > MaxAvailabilityDays*24*time.Hour {
+		return nil, pkgerrors.NewInvalidArgumentError(fmt.Sprintf("date range must not exceed %d days", MaxAvailabilityDays))
+	}
+
+	resource, err := s.resources.GetByID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if !resource.IsActive {
+		return nil, pkgerrors.NewFailedPreconditionError("resource is not active")
+	}
+
+	slots, err := s.schedules.GetByResourceID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.bookings.ListBookings(ctx, resourceID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	cells := make([]*entity.AvailabilityCell, 0)
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, slot := range slots {
+			if slot.DayOfWeek != int(day.Weekday()) {
+				continue
+			}
+			slotStart, errStart := clock.Parse(slot.StartTime)
+			slotEnd, errEnd := clock.Parse(slot.EndTime)
+			if errStart != nil || errEnd != nil || slotEnd <= slotStart {
+				continue
+			}
+
+			end := day.Add(time.Duration(slotEnd) * time.Minute)
+			for start := day.Add(time.Duration(slotStart) * time.Minute); !start.Add(granularity).After(end); start = start.Add(granularity) {
+				cellEnd := start.Add(granularity)
+				cells = append(cells, &entity.AvailabilityCell{
+					StartTime: start,
+					EndTime:   cellEnd,
+					Available: !start.Before(now) && !isBooked(bookings, start, cellEnd),
+					Price:     math.Round(slot.BasePrice*granularity.Hours()*100) / 100,
+				})
+			}
+		}
+	}
+
+	sort.Slice(cells, func(i, j int) bool {
+		return cells[i].StartTime.Before(cells[j].StartTime)
+	})
+
+	return cells, nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func isBooked(bookings []port.Booking, start, end time.Time) bool {
+	for _, booking := range bookings {
+		if booking.StartTime.Before(end) && booking.EndTime.After(start) {
+			return true
+		}
+	}
+	return false
+}
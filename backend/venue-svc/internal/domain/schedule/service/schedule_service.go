@@ -31,7 +31,8 @@ func (s *ScheduleService) SetResourceSchedule(ctx context.Context, resourceID uu
 			slot.ID = uuid.New()
 		}
 		if err := slot.IsValid(); err != nil {
-			return fmt.Errorf("slot %d invalid: %w", i, err)
+			// Kept a DomainError so the caller gets InvalidArgument rather than Internal
+			return pkgerrors.NewInvalidArgumentError(fmt.Sprintf("slot %d invalid: %v", i, err))
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/diploma/authz"
 	"github.com/diploma/venue-svc/internal/domain/venue/entity"
 	"github.com/diploma/venue-svc/internal/domain/venue/port"
 	pkgerrors "github.com/diploma/venue-svc/pkg/errors"
@@ -89,3 +90,26 @@ func (s *VenueService) DeleteVenue(ctx context.Context, id uuid.UUID) error {
 	return s.repo.Delete(ctx, id)
 }
 
+// AuthorizeOwner checks that the caller may hold venues of ownerID: owners act for themselves, admins for anyone
+func (s *VenueService) AuthorizeOwner(ctx context.Context, ownerID uuid.UUID) error {
+	principal, ok := authz.FromContext(ctx)
+	if !ok {
+		return pkgerrors.NewPermissionDeniedError("authentication required")
+	}
+	if principal.Can(authz.PermVenueManageAny) {
+		return nil
+	}
+	if !principal.Can(authz.PermVenueManage) || principal.UserID != ownerID.String() {
+		return pkgerrors.NewPermissionDeniedError("venue belongs to another owner")
+	}
+	return nil
+}
+
+// AuthorizeManage checks that the caller may modify the venue and everything under it
+func (s *VenueService) AuthorizeManage(ctx context.Context, venueID uuid.UUID) error {
+	venue, err := s.GetVenue(ctx, venueID)
+	if err != nil {
+		return err
+	}
+	return s.AuthorizeOwner(ctx, venue.OwnerID)
+}
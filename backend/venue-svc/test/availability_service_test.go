@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	resourceEntity "github.com/diploma/venue-svc/internal/domain/resource/entity"
+	"github.com/diploma/venue-svc/internal/domain/schedule/entity"
+	"github.com/diploma/venue-svc/internal/domain/schedule/port"
+	"github.com/diploma/venue-svc/internal/domain/schedule/service"
+	pkgerrors "github.com/diploma/venue-svc/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockBookingProvider struct {
+	bookings    []port.Booking
+	shouldError bool
+}
+
+func (m *MockBookingProvider) ListBookings(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]port.Booking, error) {
+	if m.shouldError {
+		return nil, pkgerrors.NewInternalError("mock error", nil)
+	}
+	return m.bookings, nil
+}
+
+func nextMonday() time.Time {
+	day := time.Now().UTC().AddDate(0, 0, 7)
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	for day.Weekday() != time.Monday {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+func setupAvailability(t *testing.T, isActive bool, bookings []port.Booking) (*service.AvailabilityService, uuid.UUID) {
+	resourceRepo := NewMockResourceRepository()
+	scheduleRepo := NewMockScheduleRepository()
+
+	resourceID := uuid.New()
+	require.NoError(t, resourceRepo.Create(context.Background(), &resourceEntity.Resource{
+		ID:        resourceID,
+		VenueID:   uuid.New(),
+		Name:      "Court 1",
+		SportType: "tennis",
+		Capacity:  4,
+		IsActive:  isActive,
+	}))
+	require.NoError(t, scheduleRepo.CreateBatch(context.Background(), []*entity.ScheduleSlot{
+		{ID: uuid.New(), ResourceID: resourceID, DayOfWeek: 1, StartTime: "09:00", EndTime: "12:00", BasePrice: 40.0},
+		{ID: uuid.New(), ResourceID: resourceID, DayOfWeek: 1, StartTime: "18:00", EndTime: "20:00", BasePrice: 60.0},
+		{ID: uuid.New(), ResourceID: resourceID, DayOfWeek: 2, StartTime: "10:00", EndTime: "11:00", BasePrice: 40.0},
+	}))
+
+	return service.NewAvailabilityService(resourceRepo, scheduleRepo, &MockBookingProvider{bookings: bookings}), resourceID
+}
+
+func TestAvailabilityService_ExpandsScheduleIntoCells(t *testing.T) {
+	svc, resourceID := setupAvailability(t, true, nil)
+	monday := nextMonday()
+
+	cells, err := svc.GetResourceAvailability(context.Background(), resourceID, monday, monday.AddDate(0, 0, 1), time.Hour)
+
+	require.NoError(t, err)
+	require.Len(t, cells, 6)
+	assert.Equal(t, monday.Add(9*time.Hour), cells[0].StartTime)
+	assert.Equal(t, monday.Add(10*time.Hour), cells[0].EndTime)
+	assert.Equal(t, 40.0, cells[0].Price)
+	assert.Equal(t, monday.Add(18*time.Hour), cells[3].StartTime)
+	assert.Equal(t, 60.0, cells[3].Price)
+	assert.Equal(t, monday.AddDate(0, 0, 1).Add(10*time.Hour), cells[5].StartTime)
+	for _, cell := range cells {
+		assert.True(t, cell.Available)
+	}
+}
+
+func TestAvailabilityService_MarksBookedCellsBusy(t *testing.T) {
+	monday := nextMonday()
+	svc, resourceID := setupAvailability(t, true, []port.Booking{
+		{StartTime: monday.Add(9*time.Hour + 30*time.Minute), EndTime: monday.Add(10*time.Hour + 30*time.Minute)},
+	})
+
+	cells, err := svc.GetResourceAvailability(context.Background(), resourceID, monday, monday, 30*time.Minute)
+
+	require.NoError(t, err)
+	require.Len(t, cells, 10)
+	assert.Equal(t, 20.0, cells[0].Price)
+	assert.True(t, cells[0].Available)
+	assert.False(t, cells[1].Available)
+	assert.False(t, cells[2].Available)
+	assert.True(t, cells[3].Available)
+}
+
+func TestAvailabilityService_DefaultGranularity(t *testing.T) {
+	svc, resourceID := setupAvailability(t, true, nil)
+	monday := nextMonday()
+
+	cells, err := svc.GetResourceAvailability(context.Background(), resourceID, monday, monday, 0)
+
+	require.NoError(t, err)
+	assert.Len(t, cells, 5)
+}
+
+func TestAvailabilityService_InactiveResource(t *testing.T) {
+	svc, resourceID := setupAvailability(t, false, nil)
+	monday := nextMonday()
+
+	_, err := svc.GetResourceAvailability(context.Background(), resourceID, monday, monday, time.Hour)
+
+	require.Error(t, err)
+	domainErr, ok := err.(*pkgerrors.DomainError)
+	require.True(t, ok)
+	assert.Equal(t, pkgerrors.CodeFailedPrecondition, domainErr.Code)
+}
+
+func TestAvailabilityService_InvalidRange(t *testing.T) {
+	svc, resourceID := setupAvailability(t, true, nil)
+	monday := nextMonday()
+
+	_, err := svc.GetResourceAvailability(context.Background(), resourceID, monday, monday.AddDate(0, 0, -1), time.Hour)
+	require.Error(t, err)
+
+	_, err = svc.GetResourceAvailability(context.Background(), resourceID, monday, monday.AddDate(0, 0, service.MaxAvailabilityDays), time.Hour)
+	require.Error(t, err)
+	domainErr, ok := err.(*pkgerrors.DomainError)
+	require.True(t, ok)
+	assert.Equal(t, pkgerrors.CodeInvalidArgument, domainErr.Code)
+}
+
+func TestAvailabilityService_ResourceNotFound(t *testing.T) {
+	svc, _ := setupAvailability(t, true, nil)
+	monday := nextMonday()
+
+	_, err := svc.GetResourceAvailability(context.Background(), uuid.New(), monday, monday, time.Hour)
+
+	require.Error(t, err)
+	domainErr, ok := err.(*pkgerrors.DomainError)
+	require.True(t, ok)
+	assert.Equal(t, pkgerrors.CodeNotFound, domainErr.Code)
+}
@@ -163,6 +163,78 @@ func TestScheduleService_SetResourceSchedule_InvalidSlot(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "malformed start_time",
+			slot: &entity.ScheduleSlot{
+				ID:         uuid.New(),
+				ResourceID: resourceID,
+				DayOfWeek:  1,
+				StartTime:  "9am",
+				EndTime:    "12:00",
+				BasePrice:  50.0,
+			},
+			expectError: true,
+		},
+		{
+			name: "malformed end_time",
+			slot: &entity.ScheduleSlot{
+				ID:         uuid.New(),
+				ResourceID: resourceID,
+				DayOfWeek:  1,
+				StartTime:  "09:00",
+				EndTime:    "12:0",
+				BasePrice:  50.0,
+			},
+			expectError: true,
+		},
+		{
+			name: "start_time past midnight",
+			slot: &entity.ScheduleSlot{
+				ID:         uuid.New(),
+				ResourceID: resourceID,
+				DayOfWeek:  1,
+				StartTime:  "24:30",
+				EndTime:    "23:00",
+				BasePrice:  50.0,
+			},
+			expectError: true,
+		},
+		{
+			name: "end_time equal to start_time",
+			slot: &entity.ScheduleSlot{
+				ID:         uuid.New(),
+				ResourceID: resourceID,
+				DayOfWeek:  1,
+				StartTime:  "09:00",
+				EndTime:    "09:00",
+				BasePrice:  50.0,
+			},
+			expectError: true,
+		},
+		{
+			name: "end_time before start_time",
+			slot: &entity.ScheduleSlot{
+				ID:         uuid.New(),
+				ResourceID: resourceID,
+				DayOfWeek:  1,
+				StartTime:  "18:00",
+				EndTime:    "09:00",
+				BasePrice:  50.0,
+			},
+			expectError: true,
+		},
+		{
+			name: "slot until midnight",
+			slot: &entity.ScheduleSlot{
+				ID:         uuid.New(),
+				ResourceID: resourceID,
+				DayOfWeek:  1,
+				StartTime:  "18:00",
+				EndTime:    "24:00",
+				BasePrice:  50.0,
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -170,7 +242,7 @@ func TestScheduleService_SetResourceSchedule_InvalidSlot(t *testing.T) {
 			err := svc.SetResourceSchedule(context.Background(), resourceID, []*entity.ScheduleSlot{tt.slot})
 
 			if tt.expectError {
-				assert.Error(t, err)
+				assert.Equal(t, pkgerrors.CodeInvalidArgument, pkgerrors.GetErrorCode(err), "got %v", err)
 			} else {
 				assert.NoError(t, err)
 			}
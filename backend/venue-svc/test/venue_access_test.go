@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diploma/authz"
+	venuev1 "github.com/diploma/venue-svc/api/v1"
+	"github.com/diploma/venue-svc/internal/adapters/inbound/grpc/handler"
+	resourceDto "github.com/diploma/venue-svc/internal/application/resource/dto"
+	resourceUsecase "github.com/diploma/venue-svc/internal/application/resource/usecase"
+	scheduleDto "github.com/diploma/venue-svc/internal/application/schedule/dto"
+	scheduleUsecase "github.com/diploma/venue-svc/internal/application/schedule/usecase"
+	venueDto "github.com/diploma/venue-svc/internal/application/venue/dto"
+	venueUsecase "github.com/diploma/venue-svc/internal/application/venue/usecase"
+	resourceService "github.com/diploma/venue-svc/internal/domain/resource/service"
+	scheduleService "github.com/diploma/venue-svc/internal/domain/schedule/service"
+	venueService "github.com/diploma/venue-svc/internal/domain/venue/service"
+	pkgerrors "github.com/diploma/venue-svc/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type venueAccessFixture struct {
+	createVenue    *venueUsecase.CreateVenueUseCase
+	updateVenue    *venueUsecase.UpdateVenueUseCase
+	deleteVenue    *venueUsecase.DeleteVenueUseCase
+	createResource *resourceUsecase.CreateResourceUseCase
+	updateResource *resourceUsecase.UpdateResourceUseCase
+	deleteResource *resourceUsecase.DeleteResourceUseCase
+	setSchedule    *scheduleUsecase.SetResourceScheduleUseCase
+}
+
+func newVenueAccessFixture() *venueAccessFixture {
+	venues := venueService.NewVenueService(NewMockVenueRepository())
+	resources := resourceService.NewResourceService(NewMockResourceRepository())
+	schedules := scheduleService.NewScheduleService(NewMockScheduleRepository())
+
+	return &venueAccessFixture{
+		createVenue:    venueUsecase.NewCreateVenueUseCase(venues),
+		updateVenue:    venueUsecase.NewUpdateVenueUseCase(venues),
+		deleteVenue:    venueUsecase.NewDeleteVenueUseCase(venues),
+		createResource: resourceUsecase.NewCreateResourceUseCase(resources, venues),
+		updateResource: resourceUsecase.NewUpdateResourceUseCase(resources, venues),
+		deleteResource: resourceUsecase.NewDeleteResourceUseCase(resources, venues),
+		setSchedule:    scheduleUsecase.NewSetResourceScheduleUseCase(schedules, resources, venues),
+	}
+}
+
+func actingAs(userID uuid.UUID, role authz.Role) context.Context {
+	return authz.NewContext(context.Background(), authz.Principal{UserID: userID.String(), Role: role})
+}
+
+// seed creates a venue with one resource owned by ownerID
+func (f *venueAccessFixture) seed(t *testing.T, ownerID uuid.UUID) (uuid.UUID, uuid.UUID) {
+	t.Helper()
+	ctx := actingAs(ownerID, authz.RoleVenueOwner)
+
+	venue, err := f.createVenue.Execute(ctx, venueDto.CreateVenueInput{
+		OwnerID: ownerID,
+		Name:    "Central Sports Park",
+		City:    "Almaty",
+		Address: "12 Abay Ave",
+	})
+	require.NoError(t, err)
+
+	resource, err := f.createResource.Execute(ctx, resourceDto.CreateResourceInput{
+		VenueID:   venue.VenueID,
+		Name:      "Court 1",
+		SportType: "tennis",
+		Capacity:  4,
+		IsActive:  true,
+	})
+	require.NoError(t, err)
+
+	return venue.VenueID, resource.ResourceID
+}
+
+// mutations runs every venue-modifying use case against the venue and resource as the caller in ctx
+func (f *venueAccessFixture) mutations(ctx context.Context, venueID, resourceID uuid.UUID) map[string]error {
+	errs := map[string]error{}
+	_, errs["UpdateVenue"] = f.updateVenue.Execute(ctx, venueDto.UpdateVenueInput{VenueID: venueID, Name: "Renamed"})
+	_, errs["CreateResource"] = f.createResource.Execute(ctx, resourceDto.CreateResourceInput{
+		VenueID: venueID, Name: "Court 2", SportType: "tennis", Capacity: 4,
+	})
+	_, errs["UpdateResource"] = f.updateResource.Execute(ctx, resourceDto.UpdateResourceInput{ResourceID: resourceID, Name: "Centre Court"})
+	_, errs["SetResourceSchedule"] = f.setSchedule.Execute(ctx, scheduleDto.SetResourceScheduleInput{
+		ResourceID: resourceID,
+		Slots:      []scheduleDto.ScheduleSlotDTO{{DayOfWeek: 1, StartTime: "09:00", EndTime: "12:00", BasePrice: 50}},
+	})
+	_, errs["DeleteResource"] = f.deleteResource.Execute(ctx, resourceDto.DeleteResourceInput{ResourceID: resourceID})
+	_, errs["DeleteVenue"] = f.deleteVenue.Execute(ctx, venueDto.DeleteVenueInput{VenueID: venueID})
+	return errs
+}
+
+func TestVenueAccess_OwnerManagesOwnVenue(t *testing.T) {
+	f := newVenueAccessFixture()
+	ownerID := uuid.New()
+	venueID, resourceID := f.seed(t, ownerID)
+
+	for name, err := range f.mutations(actingAs(ownerID, authz.RoleVenueOwner), venueID, resourceID) {
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestVenueAccess_OtherOwnerDenied(t *testing.T) {
+	f := newVenueAccessFixture()
+	venueID, resourceID := f.seed(t, uuid.New())
+
+	for name, err := range f.mutations(actingAs(uuid.New(), authz.RoleVenueOwner), venueID, resourceID) {
+		assert.Equal(t, pkgerrors.CodePermissionDenied, pkgerrors.GetErrorCode(err), name)
+	}
+}
+
+func TestVenueAccess_PlayerDeniedEvenAsOwner(t *testing.T) {
+	f := newVenueAccessFixture()
+	ownerID := uuid.New()
+	venueID, resourceID := f.seed(t, ownerID)
+
+	// A demoted owner keeps the owner_id on their venues but loses the right to manage them
+	for name, err := range f.mutations(actingAs(ownerID, authz.RolePlayer), venueID, resourceID) {
+		assert.Equal(t, pkgerrors.CodePermissionDenied, pkgerrors.GetErrorCode(err), name)
+	}
+}
+
+func TestVenueAccess_AdminManagesAnyVenue(t *testing.T) {
+	f := newVenueAccessFixture()
+	venueID, resourceID := f.seed(t, uuid.New())
+
+	for name, err := range f.mutations(actingAs(uuid.New(), authz.RoleAdmin), venueID, resourceID) {
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestVenueAccess_CreateVenueForAnotherOwner(t *testing.T) {
+	f := newVenueAccessFixture()
+	input := venueDto.CreateVenueInput{
+		OwnerID: uuid.New(),
+		Name:    "Central Sports Park",
+		City:    "Almaty",
+		Address: "12 Abay Ave",
+	}
+
+	_, err := f.createVenue.Execute(actingAs(uuid.New(), authz.RoleVenueOwner), input)
+	assert.Equal(t, pkgerrors.CodePermissionDenied, pkgerrors.GetErrorCode(err))
+
+	_, err = f.createVenue.Execute(context.Background(), input)
+	assert.Equal(t, pkgerrors.CodePermissionDenied, pkgerrors.GetErrorCode(err))
+
+	_, err = f.createVenue.Execute(actingAs(uuid.New(), authz.RoleAdmin), input)
+	assert.NoError(t, err)
+}
+
+func TestVenueAccess_PolicyCoversEveryMethod(t *testing.T) {
+	for _, method := range venuev1.VenueService_ServiceDesc.Methods {
+		fullMethod := "/" + venuev1.VenueService_ServiceDesc.ServiceName + "/" + method.MethodName
+		_, ok := handler.AccessPolicy[fullMethod]
+		assert.True(t, ok, "missing access rule for %s", fullMethod)
+	}
+}
@@ -1,32 +1,70 @@
+-- A reservation's window is checked for overlaps with a GiST exclusion constraint
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY,
     full_name VARCHAR(255) NOT NULL,
     email VARCHAR(255) UNIQUE NOT NULL,
     phone VARCHAR(50),
     password_hash VARCHAR(255) NOT NULL,
+    role TEXT NOT NULL DEFAULT 'player' CHECK (role IN ('player', 'venue_owner', 'admin')),
+    email_verified_at TIMESTAMPTZ,
+    calendar_feed_version INT NOT NULL DEFAULT 0,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Refresh tokens are stored as SHA-256 hashes and grouped into rotation families
 CREATE TABLE IF NOT EXISTS user_tokens (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-    refresh_token TEXT NOT NULL,
-    expires_at TIMESTAMP NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    family_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    rotated_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_tokens_token_hash ON user_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_user_tokens_family_id ON user_tokens(family_id);
+
+-- Single-use email verification and password reset tokens, stored as SHA-256 hashes
+CREATE TABLE IF NOT EXISTS user_action_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL CHECK (purpose IN ('email_verification', 'password_reset')),
+    token_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_action_tokens_token_hash ON user_action_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_user_action_tokens_user_purpose ON user_action_tokens(user_id, purpose);
+
 CREATE TABLE IF NOT EXISTS reservations (
     id UUID PRIMARY KEY,
     user_id UUID NOT NULL,
-    apartment_id UUID NOT NULL,
+    resource_id UUID NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
     status VARCHAR(50) NOT NULL,
     comment TEXT,
     reserved_at TIMESTAMP,
+    expires_at TIMESTAMPTZ,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT reservations_time_range_check CHECK (end_time > start_time),
+    -- A resource can never hold two active bookings for intersecting windows
+    CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        resource_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (status IN ('PENDING', 'CONFIRMED'))
 );
 
+CREATE INDEX IF NOT EXISTS idx_reservations_resource_time ON reservations(resource_id, start_time, end_time);
+
 CREATE TABLE IF NOT EXISTS venues (
     id UUID PRIMARY KEY,
     owner_id UUID NOT NULL,
@@ -65,10 +103,18 @@ CREATE TABLE IF NOT EXISTS sessions (
     visibility VARCHAR(50) NOT NULL,
     status VARCHAR(50) NOT NULL,
     description TEXT,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT sessions_time_range_check CHECK (end_time > start_time)
 );
 
+CREATE INDEX IF NOT EXISTS idx_sessions_start_time ON sessions(start_time);
+
+COMMENT ON COLUMN sessions.start_time IS 'Start of the reserved window, copied from reservation-svc';
+COMMENT ON COLUMN sessions.end_time IS 'End of the reserved window, copied from reservation-svc';
+
 CREATE TABLE IF NOT EXISTS participants (
     id UUID PRIMARY KEY,
     session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
@@ -85,9 +131,150 @@ CREATE TABLE IF NOT EXISTS payments (
     user_id UUID NOT NULL,
     amount DECIMAL(10,2) NOT NULL,
     currency VARCHAR(10) NOT NULL DEFAULT 'USD',
-    stripe_payment_intent_id VARCHAR(255),
+    stripe_payment_intent_id VARCHAR(255) UNIQUE,
     status VARCHAR(50) NOT NULL,
+    failure_reason TEXT,
+    refund_id VARCHAR(255),
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payments_active_session_user
+    ON payments(session_id, user_id) WHERE status NOT IN ('FAILED', 'REFUNDED');
+
+CREATE TABLE IF NOT EXISTS stripe_webhook_events (
+    event_id VARCHAR(255) PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    processed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS reservation_outbox (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    subject VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS session_outbox (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    subject VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS payment_outbox (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    subject VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created
+    ON notifications(user_id, created_at DESC);
+
+-- Keeps unread badge counts cheap
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread
+    ON notifications(user_id)
+    WHERE read_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    quiet_hours_start VARCHAR(5),
+    quiet_hours_end VARCHAR(5),
+    digest_hour INT NOT NULL DEFAULT 8 CHECK (digest_hour BETWEEN 0 AND 23),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Overrides of the default (enabled, immediate) rule for one category on one channel
+CREATE TABLE IF NOT EXISTS notification_channel_preferences (
+    user_id UUID NOT NULL REFERENCES notification_preferences(user_id) ON DELETE CASCADE,
+    category VARCHAR(32) NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    delivery VARCHAR(16) NOT NULL DEFAULT 'immediate',
+    PRIMARY KEY (user_id, category, channel)
+);
+
+-- Rendered messages held back by quiet hours or waiting for the next digest
+CREATE TABLE IF NOT EXISTS deferred_deliveries (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    short TEXT NOT NULL,
+    text TEXT NOT NULL,
+    html TEXT NOT NULL,
+    digest BOOLEAN NOT NULL DEFAULT FALSE,
+    deliver_after TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    -- The event that produced the message, so the flush is logged under the same key
+    event_id VARCHAR(128) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_deferred_deliveries_due
+    ON deferred_deliveries(deliver_after);
+
+CREATE TABLE IF NOT EXISTS scheduled_reminders (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL,
+    host_id UUID NOT NULL,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('participants', 'host_underfilled')),
+    lead_seconds BIGINT NOT NULL CHECK (lead_seconds > 0),
+    session_start TIMESTAMPTZ NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (session_id, kind, lead_seconds)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_reminders_due
+    ON scheduled_reminders(due_at) WHERE sent_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS delivery_log (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(128) NOT NULL,
+    user_id VARCHAR(64) NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('pending', 'sent', 'failed', 'deferred')),
+    attempts INT NOT NULL DEFAULT 0,
+    provider_message_id VARCHAR(255) NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, user_id, channel, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_delivery_log_user
+    ON delivery_log(user_id, created_at DESC);